@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/intezya/auth_service/internal/adapters/config"
+	"github.com/intezya/auth_service/internal/application/usecase"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/infrastructure/persistence"
+	"github.com/intezya/auth_service/pkg/clock"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+// Exports personal data of account (subject access request) into signed bundle.
+func main() {
+	accountID := flag.Int("account-id", 0, "account to export")
+	format := flag.String("format", string(usecase.ExportFormatJSON), "bundle format: json / zip")
+	output := flag.String("output", "", "output file (default is generated bundle name, \"-\" = stdout)")
+
+	if err := run(accountID, format, output); err != nil {
+		logger.Log.Fatalf("Account data export failed: %v", err)
+	}
+}
+
+func run(accountID *int, format, output *string) error {
+	config := config.LoadConfig()
+
+	_, err := logger.New(
+		logger.WithCaller(config.Logger.CallerEnabled),
+		logger.WithDebug(config.Logger.Debug),
+		logger.WithEnvironment(config.Logger.Environment),
+		logger.WithTimeZone(config.Logger.TimeZone),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+
+	if *accountID <= 0 {
+		return errors.New("--account-id is required")
+	}
+
+	if config.AccountExport.SigningKey == "" {
+		return usecase.ErrExportNotConfigured
+	}
+
+	entClient := persistence.SetupEnt(config.Ent, logger.Log)
+	defer entClient.Close()
+
+	repositories := persistence.NewProvider(entClient, config.Ent)
+	exportUseCase := usecase.NewExportUseCase(
+		repositories.AccountRepository,
+		repositories.DeviceRepository,
+		repositories.AuditRepository,
+		repositories.UsernameChangeRepository,
+		repositories.LoginAttemptRepository,
+		repositories.TxManager,
+		nil, // operator export doesn't authorize by token
+		config.AccountExport,
+		clock.NewRealClock(),
+	)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	result, err := exportUseCase.ExportAccountData(
+		ctx, &usecase.ExportAccountDataCommand{
+			AccountID: *accountID,
+			Format:    usecase.ExportFormat(*format),
+			Actor:     entity.AuditActorOperator,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if *output == "-" {
+		_, err = os.Stdout.Write(result.Bundle)
+
+		return err
+	}
+
+	path := *output
+	if path == "" {
+		path = result.FileName
+	}
+
+	// bundle holds personal data, keep it readable by owner only
+	if err := os.WriteFile(path, result.Bundle, 0o600); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	logger.Log.Infof("Account %d data exported to %s", *accountID, path)
+
+	return nil
+}
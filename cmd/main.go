@@ -4,14 +4,20 @@ import (
 	"context"
 	"fmt"
 	"github.com/intezya/auth_service/internal/adapters/config"
+	"github.com/intezya/auth_service/internal/adapters/gateway"
 	"github.com/intezya/auth_service/internal/adapters/grpc"
+	"github.com/intezya/auth_service/internal/adapters/health"
+	"github.com/intezya/auth_service/internal/adapters/http"
 	"github.com/intezya/auth_service/internal/application/usecase"
 	"github.com/intezya/auth_service/internal/domain/service"
 	"github.com/intezya/auth_service/internal/infrastructure/ent"
 	"github.com/intezya/auth_service/internal/infrastructure/persistence"
 	"github.com/intezya/auth_service/internal/pkg/crypto"
+	"github.com/intezya/auth_service/internal/pkg/geoip"
 	"github.com/intezya/auth_service/internal/pkg/jwt"
+	"github.com/intezya/auth_service/internal/pkg/mailer"
 	domainvalidator "github.com/intezya/auth_service/internal/pkg/validator"
+	"github.com/intezya/auth_service/pkg/clock"
 	"github.com/intezya/auth_service/pkg/tracer"
 	"os"
 	"os/signal"
@@ -52,20 +58,154 @@ func run() error {
 	errorz.SetValidator(validator.New())
 	validators := domainvalidator.NewProvider()
 	tokenManager := jwt.NewTokenManager(config.JWT)
-	passwordEncoder := crypto.NewPasswordEncoder(config.Crypto)
+	keyProvider, err := crypto.NewKeyProvider(config.Crypto.KeyProvider)
+	if err != nil {
+		return fmt.Errorf("failed to initialize key provider: %w", err)
+	}
+
+	passwordEncoder := crypto.NewPasswordEncoder(config.Crypto, keyProvider)
+
+	// probes are served before migrations, the service is alive but not ready while they run
+	healthChecker := health.NewChecker(config.Health)
+	http.SetupMetricsServer(config.Server.MetricsPort, healthChecker)
+
 	entClient := persistence.SetupEnt(config.Ent, logger.Log)
+	healthChecker.SetMigrated()
+
+	repositories := persistence.NewProvider(entClient, config.Ent)
+	hardwareIDManager := service.NewHardwareIDManager(
+		repositories.AccountRepository,
+		repositories.DeviceRepository,
+		repositories.TxManager,
+		passwordEncoder,
+		config.Devices,
+		clock.NewRealClock(),
+	)
+	usernameManager := service.NewUsernameManager(
+		repositories.AccountRepository,
+		repositories.UsernameChangeRepository,
+		config.Usernames,
+		clock.NewRealClock(),
+	)
+	mailSender, err := mailer.NewMailer(config.Mailer, logger.Log)
+	if err != nil {
+		return fmt.Errorf("failed to initialize mailer: %w", err)
+	}
 
-	repositories := persistence.NewProvider(entClient)
-	hardwareIDManager := service.NewHardwareIDManager(repositories.AccountRepository, passwordEncoder)
-	services := usecase.NewProvider(repositories, validators, passwordEncoder, tokenManager, hardwareIDManager)
-	controllers := grpc.NewProvider(services)
-	grpcApp := grpc.NewGRPCApp(controllers, config.Server)
+	geoIPResolver, err := geoip.NewResolver(config.GeoIP)
+	if err != nil {
+		return fmt.Errorf("failed to initialize geoip resolver: %w", err)
+	}
+	defer geoIPResolver.Close()
+
+	riskEngine := service.NewRiskEngine(
+		repositories.LoginAttemptRepository,
+		hardwareIDManager,
+		geoIPResolver,
+		config.Risk,
+		clock.NewRealClock(),
+	)
+	loginHistoryRecorder := usecase.NewLoginHistoryRecorder(
+		repositories.AccountRepository,
+		repositories.LoginAttemptRepository,
+		repositories.TxManager,
+		config.LoginHistory,
+	)
+
+	if config.AccountExport.SigningKey == "" {
+		logger.Log.Warnf("ACCOUNT_EXPORT_SIGNING_KEY is not set, account data export is disabled")
+	}
+
+	services := usecase.NewProvider(
+		repositories,
+		validators,
+		passwordEncoder,
+		tokenManager,
+		hardwareIDManager,
+		usernameManager,
+		loginHistoryRecorder,
+		riskEngine,
+		config.AccountExport,
+		config.AccountLookup,
+		mailSender,
+		config.EmailVerification,
+	)
+	controllers := grpc.NewProvider(services, clock.NewRealClock())
+	grpcApp, err := grpc.NewGRPCApp(
+		controllers,
+		config.Server,
+		healthChecker,
+		grpc.ContextInterceptor(persistence.WithSession),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize gRPC server: %w", err)
+	}
+
+	var gatewayServer *gateway.Server
+	if config.Gateway.Enabled {
+		conn, err := grpcApp.DialInProcess()
+		if err != nil {
+			return fmt.Errorf("failed to connect HTTP gateway: %w", err)
+		}
+
+		gatewayServer, err = gateway.NewServer(config.Gateway, conn, grpcApp.InProcessServer())
+		if err != nil {
+			return fmt.Errorf("failed to initialize HTTP gateway: %w", err)
+		}
+	}
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	go healthChecker.RunDBCheck(
+		ctx, func(ctx context.Context) error {
+			return persistence.Ping(ctx, entClient)
+		},
+	)
+
+	go persistence.BackfillHardwareIDDigests(ctx, entClient, passwordEncoder, logger.Log)
+
+	accountErasureJob := usecase.NewAccountErasureJob(
+		repositories.AccountRepository,
+		repositories.DeviceRepository,
+		repositories.AuditRepository,
+		repositories.UsernameChangeRepository,
+		repositories.EmailVerificationRepository,
+		repositories.LoginAttemptRepository,
+		repositories.TxManager,
+		config.AccountErasure,
+		clock.NewRealClock(),
+	)
+	go accountErasureJob.Run(ctx)
+
+	loginHistoryPruneJob := usecase.NewLoginHistoryPruneJob(
+		repositories.LoginAttemptRepository,
+		config.LoginHistory,
+		clock.NewRealClock(),
+	)
+	go loginHistoryPruneJob.Run(ctx)
+
+	if config.Crypto.ReencryptOnStart {
+		go func() {
+			err := persistence.ReencryptHardwareIDs(
+				ctx,
+				entClient,
+				passwordEncoder,
+				persistence.HardwareIDReencryptionOptions{},
+				logger.Log,
+			)
+			if err != nil {
+				logger.Log.Warnf("Hardware id re-encryption failed: %v", err)
+			}
+		}()
+	}
+
 	var wg sync.WaitGroup
-	wg.Add(1)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		loginHistoryRecorder.Run(ctx)
+	}()
 	go func() {
 		defer wg.Done()
 		if err := grpcApp.Start(ctx); err != nil {
@@ -74,24 +214,51 @@ func run() error {
 		}
 	}()
 
+	if gatewayServer != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := gatewayServer.Start(); err != nil {
+				logger.Log.Errorf("HTTP gateway error: %v", err)
+				stop()
+			}
+		}()
+	}
+
 	logger.Log.Info("Application started successfully")
 
 	<-ctx.Done()
 	logger.Log.Info("Shutdown signal received")
 
-	return gracefulShutdown(grpcApp, entClient, &wg)
+	return gracefulShutdown(gatewayServer, grpcApp, entClient, loginHistoryRecorder, &wg)
 }
 
-func gracefulShutdown(grpcApp *grpc.App, entClient *ent.Client, wg *sync.WaitGroup) error {
+func gracefulShutdown(
+	gatewayServer *gateway.Server,
+	grpcApp *grpc.App,
+	entClient *ent.Client,
+	loginHistoryRecorder *usecase.LoginHistoryRecorder,
+	wg *sync.WaitGroup,
+) error {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
 	defer cancel()
 
+	// gateway requests are proxied to gRPC server, so it is stopped first
+	if gatewayServer != nil {
+		if err := gatewayServer.Shutdown(shutdownCtx); err != nil {
+			logger.Log.Errorf("HTTP gateway shutdown error: %v", err)
+		}
+	}
+
 	if err := grpcApp.Shutdown(shutdownCtx); err != nil {
 		logger.Log.Errorf("Shutdown error: %v", err)
 	}
 
 	wg.Wait()
 
+	// attempts of logins finished during shutdown
+	loginHistoryRecorder.Flush(shutdownCtx)
+
 	if err := tracer.Shutdown(shutdownCtx); err != nil {
 		logger.Log.Warnf("Tracer shutdown error: %v", err)
 	}
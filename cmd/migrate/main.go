@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/intezya/auth_service/internal/adapters/config"
+	"github.com/intezya/auth_service/internal/infrastructure/persistence"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+const usage = `Usage: migrate [--env-file=FILE] COMMAND
+
+Commands:
+  up                   apply all pending migrations
+  down [N]             revert last N migrations (default 1)
+  status               print current and latest schema version
+  force VERSION        set schema version without running migrations (0 = none)
+  dry-run [down [N]]   print SQL which up (or down) would execute`
+
+var errUsage = errors.New(usage)
+
+func main() {
+	if err := run(); err != nil {
+		logger.Log.Fatalf("Migration failed: %v", err)
+	}
+}
+
+func run() error {
+	config := config.LoadConfig()
+
+	_, err := logger.New(
+		logger.WithCaller(config.Logger.CallerEnabled),
+		logger.WithDebug(config.Logger.Debug),
+		logger.WithEnvironment(config.Logger.Environment),
+		logger.WithTimeZone(config.Logger.TimeZone),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+
+	args := flag.Args()
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	db, err := persistence.OpenDB(config.Ent)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := persistence.NewMigrator(db, config.Ent.Driver)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch args[0] {
+	case "up":
+		err = migrator.Up(ctx)
+	case "down":
+		err = migrator.Down(ctx, stepsArg(args, 1))
+	case "force":
+		if len(args) != 2 { //nolint:mnd
+			return errUsage
+		}
+
+		version, parseErr := strconv.ParseUint(args[1], 10, 64)
+		if parseErr != nil {
+			return errUsage
+		}
+
+		err = migrator.Force(ctx, version)
+	case "dry-run":
+		down := len(args) > 1 && args[1] == "down"
+
+		return migrator.DryRun(ctx, os.Stdout, down, stepsArg(args, 2)) //nolint:mnd
+	case "status":
+	default:
+		return errUsage
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return printStatus(ctx, migrator)
+}
+
+func printStatus(ctx context.Context, migrator *persistence.Migrator) error {
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("version: %d\ndirty: %t\nlatest: %d\n", status.Version, status.Dirty, status.Latest)
+
+	for _, pending := range status.Pending {
+		fmt.Printf("pending: %s\n", pending)
+	}
+
+	return nil
+}
+
+// stepsArg parses optional step count at args[index].
+func stepsArg(args []string, index int) int {
+	if len(args) <= index {
+		return 1
+	}
+
+	steps, err := strconv.Atoi(args[index])
+	if err != nil || steps < 1 {
+		return 1
+	}
+
+	return steps
+}
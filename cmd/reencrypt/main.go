@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/intezya/auth_service/internal/adapters/config"
+	"github.com/intezya/auth_service/internal/infrastructure/persistence"
+	"github.com/intezya/auth_service/internal/pkg/crypto"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+// Re-encrypts stored hardware ids with HARDWARE_ID_ENCRYPTION_KEY_ID key after key rotation.
+func main() {
+	batchSize := flag.Int("batch-size", 0, "rows per batch")
+	accountsFromID := flag.Int("accounts-from-id", 0, "resume accounts after given id")
+	devicesFromID := flag.Int("devices-from-id", 0, "resume devices after given id")
+
+	if err := run(batchSize, accountsFromID, devicesFromID); err != nil {
+		logger.Log.Fatalf("Hardware id re-encryption failed: %v", err)
+	}
+}
+
+func run(batchSize, accountsFromID, devicesFromID *int) error {
+	config := config.LoadConfig()
+
+	_, err := logger.New(
+		logger.WithCaller(config.Logger.CallerEnabled),
+		logger.WithDebug(config.Logger.Debug),
+		logger.WithEnvironment(config.Logger.Environment),
+		logger.WithTimeZone(config.Logger.TimeZone),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+
+	keyProvider, err := crypto.NewKeyProvider(config.Crypto.KeyProvider)
+	if err != nil {
+		return fmt.Errorf("failed to initialize key provider: %w", err)
+	}
+
+	passwordEncoder := crypto.NewPasswordEncoder(config.Crypto, keyProvider)
+	entClient := persistence.SetupEnt(config.Ent, logger.Log)
+	defer entClient.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return persistence.ReencryptHardwareIDs(
+		ctx,
+		entClient,
+		passwordEncoder,
+		persistence.HardwareIDReencryptionOptions{
+			BatchSize:      *batchSize,
+			AccountsFromID: *accountsFromID,
+			DevicesFromID:  *devicesFromID,
+		},
+		logger.Log,
+	)
+}
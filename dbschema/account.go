@@ -5,7 +5,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type Account struct {
@@ -16,10 +19,21 @@ func (Account) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id").Unique().Immutable(),
 
-		field.String("username").NotEmpty().Unique(),
-		//field.String("email").Nillable().Optional().Unique(),
-		field.String("password").NotEmpty().Sensitive(),
-		field.String("hardware_id").Nillable().Optional().Unique().Sensitive(),
+		// username and password are nil only for erased accounts
+		field.String("username").NotEmpty().Optional().Nillable(),
+		// NFKC casefolded username (see domain.Username.Canonical), enforces case-insensitive uniqueness
+		field.String("username_canonical").NotEmpty().Optional().Nillable().Unique(),
+		// verified email, lower-cased email_canonical enforces case-insensitive uniqueness
+		field.String("email").NotEmpty().Optional().Nillable(),
+		field.String("email_canonical").NotEmpty().Optional().Nillable().Unique(),
+		field.Time("email_verified_at").Optional().Nillable(),
+		// last username change, limits how often username can be changed
+		field.Time("username_changed_at").Optional().Nillable(),
+		field.String("password").NotEmpty().Optional().Nillable().Sensitive(),
+		field.String("hardware_id").Nillable().Optional().Sensitive(),
+		// HMAC-SHA256 blind index of the raw hardware id: hardware_id itself is encrypted
+		// with a random nonce, so uniqueness can only be enforced on the digest
+		field.String("hardware_id_digest").Nillable().Optional().Unique().Sensitive(),
 
 		field.String("access_level").
 			GoType(domain.AccessLevel(0)).
@@ -30,12 +44,50 @@ func (Account) Fields() []ent.Field {
 			),
 
 		//field.String("avatar_url").Optional().Nillable(),
-		//
-		//field.Time("last_login_at").Default(time.Now),
+
+		// time of the last successful login, written in batches by login history recorder
+		field.Time("last_login_at").Optional().Nillable(),
 
 		field.Time("created_at").Default(time.Now).Immutable(),
 
 		field.Time("banned_until").Optional().Nillable(),
 		field.String("ban_reason").Optional().Nillable(),
+
+		// soft delete: deleted account can be restored until it is erased after grace period,
+		// erased account keeps only id and timestamps as a tombstone
+		field.Time("deleted_at").Optional().Nillable(),
+		field.Time("erased_at").Optional().Nillable(),
+
+		// optimistic locking, incremented by every update
+		field.Int("version").Default(0),
+	}
+}
+
+func (Account) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
+
+		// account search (see AccountRepository.Search), id breaks ties of keyset pagination
+		index.Fields("created_at", "id"),
+		index.Fields("access_level", "id"),
+		index.Fields("banned_until"),
+		// username prefix search, postgres can't use default collation index for LIKE 'prefix%'
+		index.Fields("username_canonical").
+			Annotations(entsql.OpClass("varchar_pattern_ops")),
+	}
+}
+
+func (Account) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("devices", Device.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("audit_entries", AuditEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("username_changes", UsernameChange.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("email_verifications", EmailVerification.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("login_attempts", LoginAttempt.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,47 @@
+package dbschema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEntry records actions done to account (bans, deletion, data exports, ...).
+type AuditEntry struct {
+	ent.Schema
+}
+
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("account_id").Immutable(),
+
+		field.String("action").NotEmpty().Immutable(),
+		// who performed the action: account owner, operator or system job
+		field.String("actor").NotEmpty().Immutable(),
+		// action parameters, must not hold secrets
+		field.JSON("details", map[string]string{}).Optional().Immutable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "created_at"),
+	}
+}
+
+func (AuditEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("audit_entries").
+			Field("account_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
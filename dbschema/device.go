@@ -0,0 +1,47 @@
+package dbschema
+
+import (
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Device struct {
+	ent.Schema
+}
+
+func (Device) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("account_id").Immutable(),
+
+		field.String("hardware_id").NotEmpty().Sensitive(),
+		field.String("hardware_id_digest").NotEmpty().Unique().Sensitive(),
+
+		field.String("status").
+			GoType(domain.DeviceStatus(0)).
+			DefaultFunc(
+				func() domain.DeviceStatus {
+					return domain.DeviceStatusPending
+				},
+			),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("approved_at").Optional().Nillable(),
+		field.Time("last_seen_at").Optional().Nillable(),
+	}
+}
+
+func (Device) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("devices").
+			Field("account_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
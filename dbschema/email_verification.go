@@ -0,0 +1,47 @@
+package dbschema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmailVerification is pending email of account waiting for confirmation with code sent to it.
+type EmailVerification struct {
+	ent.Schema
+}
+
+func (EmailVerification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("account_id").Immutable(),
+
+		field.String("email").NotEmpty().Immutable(),
+		field.String("code_digest").NotEmpty().Immutable().Sensitive(),
+		// failed confirmation attempts
+		field.Int("attempts").Default(0),
+
+		field.Time("expires_at").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (EmailVerification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "created_at"),
+	}
+}
+
+func (EmailVerification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("email_verifications").
+			Field("account_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
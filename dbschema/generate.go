@@ -1,4 +1,4 @@
 package dbschema
 
 //go:generate go install entgo.io/ent/cmd/ent@latest
-//go:generate ent generate --target=../internal/infrastructure/ent .
+//go:generate ent generate --feature sql/versioned-migration --target=../internal/infrastructure/ent .
@@ -0,0 +1,63 @@
+package dbschema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginAttempt is entry of account login history, entries are pruned after retention period.
+type LoginAttempt struct {
+	ent.Schema
+}
+
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("account_id").Immutable(),
+
+		field.String("ip").Optional().Nillable().Immutable(),
+		// result of hardware id check, see domain.HardwareIDMatch
+		field.String("hardware_id_match").Immutable(),
+		field.Bool("success").Immutable(),
+		// nil for successful login, see domain.LoginFailureReason
+		field.String("failure_reason").Optional().Nillable().Immutable(),
+
+		// GeoIP data of ip, nil if it is unknown
+		field.Uint("asn").Optional().Nillable().Immutable(),
+		field.String("country").Optional().Nillable().Immutable(),
+		field.Float("latitude").Optional().Nillable().Immutable(),
+		field.Float("longitude").Optional().Nillable().Immutable(),
+
+		// risk assessment, nil if login failed before it (e.g. wrong password)
+		field.Int("risk_score").Optional().Nillable().Immutable(),
+		field.String("risk_decision").Optional().Nillable().Immutable(),
+		field.Strings("risk_signals").Optional().Immutable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (LoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "created_at", "id"),
+		// retention pruning
+		index.Fields("created_at"),
+		// failures from one ip across accounts
+		index.Fields("ip", "created_at"),
+	}
+}
+
+func (LoginAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("login_attempts").
+			Field("account_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -0,0 +1,34 @@
+package dbschema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UnknownLoginAttempt is failed login to account which doesn't exist, it is kept only for ip reputation
+// and pruned together with login history.
+type UnknownLoginAttempt struct {
+	ent.Schema
+}
+
+func (UnknownLoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.String("ip").Immutable(),
+		// digest of canonical login, logins are often passwords typed into a wrong field
+		field.String("login_digest").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (UnknownLoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		// failures from one ip
+		index.Fields("ip", "created_at"),
+		// retention pruning
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,46 @@
+package dbschema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UsernameChange is username history: previous username of account and until when it stays reserved for the account.
+type UsernameChange struct {
+	ent.Schema
+}
+
+func (UsernameChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("account_id").Immutable(),
+
+		field.String("username").NotEmpty().Immutable(),
+		field.String("username_canonical").NotEmpty().Immutable(),
+
+		field.Time("changed_at").Default(time.Now).Immutable(),
+		field.Time("reserved_until").Immutable(),
+	}
+}
+
+func (UsernameChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("username_canonical", "changed_at"),
+		index.Fields("account_id", "changed_at"),
+	}
+}
+
+func (UsernameChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("username_changes").
+			Field("account_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -5,10 +5,16 @@ import (
 	"flag"
 	"fmt"
 	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/intezya/auth_service/internal/adapters/gateway"
 	"github.com/intezya/auth_service/internal/adapters/grpc"
+	"github.com/intezya/auth_service/internal/adapters/health"
+	"github.com/intezya/auth_service/internal/application/usecase"
+	"github.com/intezya/auth_service/internal/domain/service"
 	"github.com/intezya/auth_service/internal/infrastructure/persistence"
 	"github.com/intezya/auth_service/internal/pkg/crypto"
+	"github.com/intezya/auth_service/internal/pkg/geoip"
 	"github.com/intezya/auth_service/internal/pkg/jwt"
+	"github.com/intezya/auth_service/internal/pkg/mailer"
 	"github.com/intezya/auth_service/pkg/tracer"
 	"log/slog"
 	"os"
@@ -16,12 +22,26 @@ import (
 )
 
 type Config struct {
-	Logger LoggerConfig
-	Server grpc.Config
-	Tracer tracer.Config
-	JWT    jwt.Config
-	Crypto crypto.Config
-	Ent    persistence.EntConfig
+	Logger    LoggerConfig
+	Server    grpc.Config
+	Gateway   gateway.Config
+	Health    health.Config
+	Tracer    tracer.Config
+	JWT       jwt.Config
+	Crypto    crypto.Config
+	Ent       persistence.EntConfig
+	Devices   service.DeviceConfig
+	Usernames service.UsernameConfig
+	Mailer    mailer.Config
+	Risk      service.RiskConfig
+	GeoIP     geoip.Config
+
+	AccountErasure usecase.AccountErasureConfig
+	AccountExport  usecase.AccountExportConfig
+	AccountLookup  usecase.AccountLookupConfig
+
+	EmailVerification usecase.EmailVerificationConfig
+	LoginHistory      usecase.LoginHistoryConfig
 
 	EnvType string `env:"ENV" env-default:"dev"` // dev / prod
 }
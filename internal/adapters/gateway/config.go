@@ -0,0 +1,18 @@
+package gateway
+
+import "time"
+
+type Config struct {
+	Enabled bool `env:"GATEWAY_ENABLED" env-default:"false"`
+	Port    int  `env:"GATEWAY_PORT" env-default:"8080"`
+	// TLS is enabled when certificate and key are set.
+	TLSCertFile string `env:"GATEWAY_TLS_CERT_FILE"`
+	TLSKeyFile  string `env:"GATEWAY_TLS_KEY_FILE"`
+	// ConnectEnabled serves AuthService over Connect and gRPC-Web protocols at /auth.AuthService/.
+	ConnectEnabled bool `env:"GATEWAY_CONNECT_ENABLED" env-default:"true"`
+
+	// CORSAllowedOrigins are origins of browser clients, "*" allows any origin, empty disables CORS.
+	CORSAllowedOrigins []string      `env:"GATEWAY_CORS_ALLOWED_ORIGINS" env-separator:","`
+	CORSAllowedHeaders []string      `env:"GATEWAY_CORS_ALLOWED_HEADERS" env-separator:"," env-default:"Content-Type,Authorization"`
+	CORSMaxAge         time.Duration `env:"GATEWAY_CORS_MAX_AGE" env-default:"10m"`
+}
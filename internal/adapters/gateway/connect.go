@@ -0,0 +1,84 @@
+package gateway
+
+import (
+	"connectrpc.com/vanguard"
+	"connectrpc.com/vanguard/vanguardgrpc"
+	"fmt"
+	authpb "github.com/intezya/auth_service/protos/go/auth"
+	"google.golang.org/grpc"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// connectHeaders are request headers of Connect and gRPC-Web protocols, they are allowed by CORS.
+var connectHeaders = []string{
+	"Connect-Protocol-Version",
+	"Connect-Timeout-Ms",
+	"Grpc-Timeout",
+	"X-Grpc-Web",
+	"X-User-Agent",
+}
+
+// connectExposedHeaders are response headers carrying status of gRPC-Web calls.
+var connectExposedHeaders = []string{
+	"Grpc-Status",
+	"Grpc-Message",
+	"Grpc-Status-Details-Bin",
+}
+
+// connectMethods are AuthService methods served over Connect and gRPC-Web, each authenticates its caller
+// by token or password. Operator-only BanAccount and GetAccount / GetAccounts of downstream services
+// are reachable only on gRPC port.
+var connectMethods = []string{
+	"Register",
+	"Login",
+	"VerifyToken",
+	"DeleteAccount",
+	"RestoreAccount",
+	"ExportAccountData",
+	"SearchAccounts",
+	"ChangeUsername",
+	"AddEmail",
+	"VerifyEmail",
+	"ListLoginHistory",
+	"ListDevices",
+	"ApproveDevice",
+	"RemoveDevice",
+}
+
+// newConnectHandler serves connectMethods of server over Connect and gRPC-Web protocols.
+// Requests are transcoded to gRPC and handled by server directly, so they pass the same interceptors
+// as requests on gRPC port. Native gRPC is not accepted here, it has its own port.
+func newConnectHandler(server *grpc.Server) (http.Handler, error) {
+	transcoder, err := vanguardgrpc.NewTranscoder(
+		server,
+		vanguard.WithDefaultServiceOptions(vanguard.WithNoTargetCompression()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Connect transcoder: %w", err)
+	}
+
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			method, _ := strings.CutPrefix(r.URL.Path, "/"+authpb.AuthService_ServiceDesc.ServiceName+"/")
+			if !slices.Contains(connectMethods, method) {
+				http.NotFound(w, r) // Connect clients get Unimplemented
+
+				return
+			}
+
+			if isNativeGRPC(r.Header.Get("Content-Type")) {
+				http.Error(w, "gRPC clients must use gRPC port", http.StatusUnsupportedMediaType)
+
+				return
+			}
+
+			transcoder.ServeHTTP(w, r)
+		},
+	), nil
+}
+
+func isNativeGRPC(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/grpc") && !strings.HasPrefix(contentType, "application/grpc-web")
+}
@@ -0,0 +1,59 @@
+package gateway
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// withCORS answers preflight requests and marks responses readable by allowed origins.
+func withCORS(config Config, next http.Handler) http.Handler {
+	if len(config.CORSAllowedOrigins) == 0 {
+		return next
+	}
+
+	anyOrigin := slices.Contains(config.CORSAllowedOrigins, "*")
+	allowedHeaders := config.CORSAllowedHeaders
+	exposedHeaders := []string{requestIDHeader}
+	if config.ConnectEnabled {
+		allowedHeaders = append(slices.Clone(allowedHeaders), connectHeaders...)
+		exposedHeaders = append(exposedHeaders, connectExposedHeaders...)
+	}
+	maxAge := strconv.Itoa(int(config.CORSMaxAge.Seconds()))
+
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			w.Header().Add("Vary", "Origin")
+
+			if !anyOrigin && !slices.Contains(config.CORSAllowedOrigins, origin) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Expose-Headers", strings.Join(exposedHeaders, ", "))
+
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.Header().Add("Vary", "Access-Control-Request-Method")
+				w.Header().Add("Vary", "Access-Control-Request-Headers")
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST")
+				w.Header().Set("Access-Control-Allow-Headers", strings.Join(allowedHeaders, ", "))
+				w.Header().Set("Access-Control-Max-Age", maxAge)
+				w.WriteHeader(http.StatusNoContent)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		},
+	)
+}
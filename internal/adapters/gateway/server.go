@@ -0,0 +1,129 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	authpb "github.com/intezya/auth_service/protos/go/auth"
+	"github.com/intezya/auth_service/protos/openapi"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+const (
+	readHeaderTimeout = 5 * time.Second
+	readTimeout       = 10 * time.Second
+	writeTimeout      = 30 * time.Second // account export bundles may be large
+	idleTimeout       = 60 * time.Second
+
+	requestIDHeader = "X-Request-Id"
+)
+
+// Server is HTTP/JSON facade of AuthService, requests are proxied to gRPC server over conn.
+// gRPC status codes of errors are mapped to HTTP status codes.
+// When enabled, user-facing methods of AuthService are also served over Connect and gRPC-Web protocols by grpcServer.
+type Server struct {
+	server *http.Server
+	conn   *grpc.ClientConn
+	config Config
+}
+
+func NewServer(config Config, conn *grpc.ClientConn, grpcServer *grpc.Server) (*Server, error) {
+	gatewayMux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(
+			runtime.MIMEWildcard, &runtime.JSONPb{
+				MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+				UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+			},
+		),
+		runtime.WithIncomingHeaderMatcher(requestIDHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(requestIDHeaderMatcher),
+	)
+
+	if err := authpb.RegisterAuthServiceHandler(context.Background(), gatewayMux, conn); err != nil {
+		return nil, fmt.Errorf("failed to register gateway handler: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", gatewayMux)
+	mux.HandleFunc("GET /openapi/v2.json", serveSpec("application/json", openapi.V2))
+	mux.HandleFunc("GET /openapi/v3.yaml", serveSpec("application/yaml", openapi.V3))
+
+	if config.ConnectEnabled {
+		connectHandler, err := newConnectHandler(grpcServer)
+		if err != nil {
+			return nil, err
+		}
+
+		mux.Handle("/"+authpb.AuthService_ServiceDesc.ServiceName+"/", connectHandler)
+	}
+
+	// Connect clients may use HTTP/2 without TLS
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+
+	return &Server{
+		server: &http.Server{
+			//nolint:exhaustruct
+			Addr:              fmt.Sprintf(":%d", config.Port),
+			Handler:           withCORS(config, mux),
+			ReadHeaderTimeout: readHeaderTimeout,
+			ReadTimeout:       readTimeout,
+			WriteTimeout:      writeTimeout,
+			IdleTimeout:       idleTimeout,
+			Protocols:         protocols,
+		},
+		conn:   conn,
+		config: config,
+	}, nil
+}
+
+// Start serves until Shutdown is called.
+func (s *Server) Start() error {
+	logger.Log.Infof("Starting HTTP gateway on port %d", s.config.Port)
+
+	var err error
+	if s.config.TLSCertFile != "" || s.config.TLSKeyFile != "" {
+		err = s.server.ListenAndServeTLS(s.config.TLSCertFile, s.config.TLSKeyFile)
+	} else {
+		err = s.server.ListenAndServe()
+	}
+
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("HTTP gateway serve error: %w", err)
+	}
+
+	return nil
+}
+
+// Shutdown waits for in-flight requests and closes connection to gRPC server.
+func (s *Server) Shutdown(ctx context.Context) error {
+	logger.Log.Infof("Shutting down HTTP gateway on port %d...", s.config.Port)
+
+	return errors.Join(s.server.Shutdown(ctx), s.conn.Close())
+}
+
+// requestIDHeaderMatcher passes request ID header between HTTP and gRPC as is,
+// other headers are matched as by default.
+func requestIDHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, requestIDHeader) {
+		return requestIDHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func serveSpec(contentType string, spec []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write(spec)
+	}
+}
@@ -0,0 +1,367 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	grpcadapter "github.com/intezya/auth_service/internal/adapters/grpc"
+	"github.com/intezya/auth_service/internal/adapters/health"
+	authpb "github.com/intezya/auth_service/protos/go/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+const allowedOrigin = "https://app.example.com"
+
+func TestMain(m *testing.M) {
+	if _, err := logger.New(); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+// fakeAuthController answers Login with InvalidArgument for empty username, NotFound for "missing"
+// and token otherwise, BanAccount always succeeds.
+type fakeAuthController struct {
+	authpb.UnimplementedAuthServiceServer
+}
+
+func (fakeAuthController) Login(_ context.Context, request *authpb.AuthenticationRequest) (*authpb.TokenResponse, error) {
+	switch request.GetUsername() {
+	case "":
+		return nil, status.Error(codes.InvalidArgument, "invalid username")
+	case "missing":
+		return nil, status.Error(codes.NotFound, "account not found")
+	}
+
+	return &authpb.TokenResponse{Token: "token-of-" + request.GetUsername()}, nil
+}
+
+func (fakeAuthController) BanAccount(context.Context, *authpb.BanAccountRequest) (*authpb.Empty, error) {
+	return &authpb.Empty{}, nil
+}
+
+// methodRecorder is an app interceptor collecting called methods.
+type methodRecorder struct {
+	mu      sync.Mutex
+	methods []string
+}
+
+func (r *methodRecorder) intercept(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	r.mu.Lock()
+	r.methods = append(r.methods, info.FullMethod)
+	r.mu.Unlock()
+
+	return handler(ctx, req)
+}
+
+func (r *methodRecorder) called() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.methods)
+}
+
+// newTestGateway serves gateway over httptest, requests reach the fake controller through in-process gRPC server.
+func newTestGateway(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server, _ := newRecordingTestGateway(t)
+
+	return server
+}
+
+// newRecordingTestGateway is newTestGateway which also returns methods seen by interceptors of the app.
+func newRecordingTestGateway(t *testing.T) (*httptest.Server, *methodRecorder) {
+	t.Helper()
+
+	recorder := &methodRecorder{}
+
+	app, err := grpcadapter.NewGRPCApp(
+		&grpcadapter.Provider{AuthController: fakeAuthController{}},
+		grpcadapter.Config{GRPCServerPort: 0},
+		health.NewChecker(health.Config{}),
+		recorder.intercept,
+	)
+	if err != nil {
+		t.Fatalf("NewGRPCApp() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { _ = app.Start(ctx) }()
+
+	t.Cleanup(
+		func() {
+			cancel()
+
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+
+			_ = app.Shutdown(shutdownCtx)
+		},
+	)
+
+	conn, err := app.DialInProcess()
+	if err != nil {
+		t.Fatalf("DialInProcess() error = %v", err)
+	}
+
+	server, err := NewServer(
+		Config{
+			ConnectEnabled:     true,
+			CORSAllowedOrigins: []string{allowedOrigin},
+			CORSAllowedHeaders: []string{"Content-Type", "Authorization"},
+			CORSMaxAge:         10 * time.Minute,
+		},
+		conn, app.InProcessServer(),
+	)
+	if err != nil {
+		t.Fatalf("NewServer() error = %v", err)
+	}
+
+	httpServer := httptest.NewServer(server.server.Handler)
+	t.Cleanup(
+		func() {
+			httpServer.Close()
+			_ = conn.Close()
+		},
+	)
+
+	return httpServer, recorder
+}
+
+func TestServer_MapsGRPCStatusToHTTP(t *testing.T) {
+	server := newTestGateway(t)
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{name: "ok", body: `{"username": "alice"}`, want: http.StatusOK},
+		{name: "not found", body: `{"username": "missing"}`, want: http.StatusNotFound},
+		{name: "invalid argument", body: `{}`, want: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				response, err := server.Client().Post(
+					server.URL+"/v1/auth/login", "application/json", strings.NewReader(tt.body),
+				)
+				if err != nil {
+					t.Fatalf("POST /v1/auth/login error = %v", err)
+				}
+				defer response.Body.Close()
+
+				if response.StatusCode != tt.want {
+					t.Fatalf("POST /v1/auth/login status = %d, want %d", response.StatusCode, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestServer_CORSPreflight(t *testing.T) {
+	server := newTestGateway(t)
+
+	tests := []struct {
+		name        string
+		origin      string
+		allowOrigin string
+	}{
+		{name: "allowed origin", origin: allowedOrigin, allowOrigin: allowedOrigin},
+		{name: "disallowed origin", origin: "https://evil.example.com", allowOrigin: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				request, err := http.NewRequest(http.MethodOptions, server.URL+"/v1/auth/login", nil)
+				if err != nil {
+					t.Fatalf("NewRequest() error = %v", err)
+				}
+
+				request.Header.Set("Origin", tt.origin)
+				request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				request.Header.Set("Access-Control-Request-Headers", "Content-Type")
+
+				response, err := server.Client().Do(request)
+				if err != nil {
+					t.Fatalf("OPTIONS error = %v", err)
+				}
+				defer response.Body.Close()
+
+				if got := response.Header.Get("Access-Control-Allow-Origin"); got != tt.allowOrigin {
+					t.Fatalf("Access-Control-Allow-Origin = %q, want %q", got, tt.allowOrigin)
+				}
+
+				if tt.allowOrigin != "" && response.StatusCode != http.StatusNoContent {
+					t.Fatalf("preflight status = %d, want %d", response.StatusCode, http.StatusNoContent)
+				}
+			},
+		)
+	}
+}
+
+func TestServer_ServesOpenAPISpec(t *testing.T) {
+	server := newTestGateway(t)
+
+	response, err := server.Client().Get(server.URL + "/openapi/v2.json")
+	if err != nil {
+		t.Fatalf("GET /openapi/v2.json error = %v", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("GET /openapi/v2.json status = %d, want %d", response.StatusCode, http.StatusOK)
+	}
+
+	if got := response.Header.Get("Content-Type"); got != "application/json" {
+		t.Fatalf("Content-Type = %q, want %q", got, "application/json")
+	}
+}
+
+func TestServer_ConnectUnaryCall(t *testing.T) {
+	server, recorder := newRecordingTestGateway(t)
+
+	request, err := http.NewRequest(
+		http.MethodPost, server.URL+"/auth.AuthService/Login", strings.NewReader(`{"username": "alice"}`),
+	)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Connect-Protocol-Version", "1")
+	request.Header.Set(requestIDHeader, "request-1")
+
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatalf("Connect Login error = %v", err)
+	}
+	defer response.Body.Close()
+
+	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("Connect Login status = %d (%s), want %d", response.StatusCode, body, http.StatusOK)
+	}
+
+	var token authpb.TokenResponse
+	if err := protojson.Unmarshal(body, &token); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if token.GetToken() != "token-of-alice" {
+		t.Fatalf("token = %q, want %q", token.GetToken(), "token-of-alice")
+	}
+
+	// request ID interceptor of the server echoes the ID, app interceptors see the call
+	if got := response.Header.Get(requestIDHeader); got != "request-1" {
+		t.Fatalf("%s = %q, want %q", requestIDHeader, got, "request-1")
+	}
+
+	if got := recorder.called(); !slices.Equal(got, []string{authpb.AuthService_Login_FullMethodName}) {
+		t.Fatalf("intercepted methods = %v, want [%s]", got, authpb.AuthService_Login_FullMethodName)
+	}
+}
+
+func TestServer_GRPCWebUnaryCall(t *testing.T) {
+	server, recorder := newRecordingTestGateway(t)
+
+	message, err := proto.Marshal(&authpb.AuthenticationRequest{Username: "alice"})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	// uncompressed message frame: flags byte and big-endian length
+	frame := binary.BigEndian.AppendUint32([]byte{0}, uint32(len(message)))
+
+	request, err := http.NewRequest(
+		http.MethodPost, server.URL+"/auth.AuthService/Login", bytes.NewReader(append(frame, message...)),
+	)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	request.Header.Set("Content-Type", "application/grpc-web+proto")
+	request.Header.Set("X-Grpc-Web", "1")
+
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatalf("gRPC-Web Login error = %v", err)
+	}
+	defer response.Body.Close()
+
+	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("gRPC-Web Login status = %d, want %d", response.StatusCode, http.StatusOK)
+	}
+
+	// trailers are sent in the last frame of body
+	if !bytes.Contains(body, []byte("token-of-alice")) || !bytes.Contains(bytes.ToLower(body), []byte("grpc-status: 0")) {
+		t.Fatalf("gRPC-Web Login body = %q, want token and OK status", body)
+	}
+
+	if response.Header.Get(requestIDHeader) == "" {
+		t.Fatalf("%s is not set", requestIDHeader)
+	}
+
+	if got := recorder.called(); !slices.Equal(got, []string{authpb.AuthService_Login_FullMethodName}) {
+		t.Fatalf("intercepted methods = %v, want [%s]", got, authpb.AuthService_Login_FullMethodName)
+	}
+}
+
+func TestServer_ConnectRejectsMethodsOutsideGateway(t *testing.T) {
+	server, recorder := newRecordingTestGateway(t)
+
+	for _, method := range []string{"BanAccount", "GetAccount", "GetAccounts"} {
+		t.Run(
+			method, func(t *testing.T) {
+				request, err := http.NewRequest(
+					http.MethodPost, server.URL+"/auth.AuthService/"+method, strings.NewReader(`{"subject": 1}`),
+				)
+				if err != nil {
+					t.Fatalf("NewRequest() error = %v", err)
+				}
+
+				request.Header.Set("Content-Type", "application/json")
+				request.Header.Set("Connect-Protocol-Version", "1")
+
+				response, err := server.Client().Do(request)
+				if err != nil {
+					t.Fatalf("Connect %s error = %v", method, err)
+				}
+				defer response.Body.Close()
+
+				if response.StatusCode != http.StatusNotFound {
+					t.Fatalf("Connect %s status = %d, want %d", method, response.StatusCode, http.StatusNotFound)
+				}
+			},
+		)
+	}
+
+	if got := recorder.called(); len(got) != 0 {
+		t.Fatalf("rejected methods reached server: %v", got)
+	}
+}
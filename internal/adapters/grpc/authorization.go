@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	authpb "github.com/intezya/auth_service/protos/go/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"slices"
+	"strings"
+)
+
+var ErrClientNotAllowed = status.Error(codes.PermissionDenied, "client is not allowed to call the method")
+
+// methodSANs maps full method name to SANs of client certificates allowed to call it.
+type methodSANs map[string][]string
+
+// parseMethodSANs parses entries like "BanAccount=admin-panel.internal,spiffe://corp/admin",
+// short method names refer to AuthService.
+func parseMethodSANs(entries []string) (methodSANs, error) {
+	rules := make(methodSANs, len(entries))
+
+	for _, entry := range entries {
+		method, sans, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || method == "" || sans == "" {
+			return nil, fmt.Errorf("invalid method SAN rule %q", entry)
+		}
+
+		if !strings.HasPrefix(method, "/") {
+			if !isAuthServiceMethod(method) {
+				return nil, fmt.Errorf("unknown method %q in SAN rule", method)
+			}
+
+			method = "/" + authpb.AuthService_ServiceDesc.ServiceName + "/" + method
+		}
+
+		parsed := 0
+
+		for _, san := range strings.Split(sans, ",") {
+			if san = strings.TrimSpace(san); san != "" {
+				rules[method] = append(rules[method], san)
+				parsed++
+			}
+		}
+
+		// rule without SANs would leave the method unrestricted instead of closing it
+		if parsed == 0 {
+			return nil, fmt.Errorf("no SANs in method SAN rule %q", entry)
+		}
+	}
+
+	return rules, nil
+}
+
+func isAuthServiceMethod(name string) bool {
+	return slices.ContainsFunc(
+		authpb.AuthService_ServiceDesc.Methods, func(method grpc.MethodDesc) bool {
+			return method.MethodName == name
+		},
+	)
+}
+
+// sanAuthorizationInterceptor lets methods with rules be called only by clients with verified certificate
+// having one of allowed SANs, other methods are not restricted.
+func sanAuthorizationInterceptor(rules methodSANs) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		allowed, ok := rules[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		sans, err := clientSANs(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		for _, san := range sans {
+			if slices.Contains(allowed, san) {
+				return handler(ctx, req)
+			}
+		}
+
+		return nil, ErrClientNotAllowed
+	}
+}
+
+// clientSANs returns DNS names, URIs, IP addresses and emails of verified client certificate.
+func clientSANs(ctx context.Context) ([]string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("no peer")
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil, errors.New("verified client certificate is required")
+	}
+
+	certificate := tlsInfo.State.PeerCertificates[0]
+
+	sans := slices.Clone(certificate.DNSNames)
+	for _, uri := range certificate.URIs {
+		sans = append(sans, uri.String())
+	}
+
+	for _, ip := range certificate.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	return append(sans, certificate.EmailAddresses...), nil
+}
@@ -0,0 +1,243 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	authpb "github.com/intezya/auth_service/protos/go/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"maps"
+	"net"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseMethodSANs(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []string
+		want    methodSANs
+		wantErr bool
+	}{
+		{
+			name:    "short method name",
+			entries: []string{"BanAccount=admin-panel.internal,spiffe://corp/admin"},
+			want: methodSANs{
+				authpb.AuthService_BanAccount_FullMethodName: {"admin-panel.internal", "spiffe://corp/admin"},
+			},
+		},
+		{
+			name:    "full method name of any service",
+			entries: []string{"/grpc.health.v1.Health/Check=monitoring.internal"},
+			want:    methodSANs{"/grpc.health.v1.Health/Check": {"monitoring.internal"}},
+		},
+		{
+			name:    "blanks are trimmed",
+			entries: []string{" GetAccount=a.internal, ,b.internal ", "GetAccounts=a.internal"},
+			want: methodSANs{
+				authpb.AuthService_GetAccount_FullMethodName:  {"a.internal", "b.internal"},
+				authpb.AuthService_GetAccounts_FullMethodName: {"a.internal"},
+			},
+		},
+		{
+			name:    "rules of the same method are merged",
+			entries: []string{"BanAccount=a.internal", "BanAccount=b.internal"},
+			want:    methodSANs{authpb.AuthService_BanAccount_FullMethodName: {"a.internal", "b.internal"}},
+		},
+		{name: "no rules", want: methodSANs{}},
+		{name: "no separator", entries: []string{"BanAccount"}, wantErr: true},
+		{name: "no method", entries: []string{"=a.internal"}, wantErr: true},
+		{name: "no SANs", entries: []string{"BanAccount="}, wantErr: true},
+		{name: "blank SANs", entries: []string{"BanAccount= , "}, wantErr: true},
+		{name: "unknown short method", entries: []string{"DropDatabase=a.internal"}, wantErr: true},
+		{name: "case of short method matters", entries: []string{"banaccount=a.internal"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := parseMethodSANs(tt.entries)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("parseMethodSANs() error = %v, wantErr %t", err, tt.wantErr)
+				}
+
+				if !maps.EqualFunc(got, tt.want, slices.Equal) {
+					t.Fatalf("parseMethodSANs() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+// newMutualTLSTestClient serves auth over mutual TLS with SAN rules and returns client presenting certificate.
+func newMutualTLSTestClient(
+	t *testing.T,
+	ca *testCA,
+	rules []string,
+	auth *fakeAuthController,
+	certificate tls.Certificate,
+) authpb.AuthServiceClient {
+	t.Helper()
+
+	files := newTLSFiles(t)
+	files.writeServerCertificate(t, ca, 1)
+	files.write(t, files.clientCA, ca.pem)
+
+	reloader, err := newCertReloader(
+		Config{TLSCertFile: files.cert, TLSKeyFile: files.key, TLSClientCAFile: files.clientCA},
+	)
+	if err != nil {
+		t.Fatalf("newCertReloader() error = %v", err)
+	}
+
+	parsed, err := parseMethodSANs(rules)
+	if err != nil {
+		t.Fatalf("parseMethodSANs() error = %v", err)
+	}
+
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(reloader.serverConfig())),
+		grpc.ChainUnaryInterceptor(sanAuthorizationInterceptor(parsed)),
+	)
+	authpb.RegisterAuthServiceServer(server, auth)
+
+	listener := bufconn.Listen(inProcessBufferSize)
+	go func() { _ = server.Serve(listener) }()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///auth.test",
+		grpc.WithContextDialer(
+			func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			},
+		),
+		grpc.WithTransportCredentials(
+			credentials.NewTLS(
+				&tls.Config{
+					MinVersion:   tls.VersionTLS12,
+					RootCAs:      ca.pool(),
+					ServerName:   "auth.test",
+					Certificates: []tls.Certificate{certificate},
+				},
+			),
+		),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	t.Cleanup(
+		func() {
+			_ = conn.Close()
+			server.Stop()
+		},
+	)
+
+	return authpb.NewAuthServiceClient(conn)
+}
+
+func TestSANAuthorizationInterceptor(t *testing.T) {
+	ca := newTestCA(t)
+	rules := []string{"Login=admin-panel.internal,spiffe://corp/admin"}
+
+	tests := []struct {
+		name        string
+		certificate tls.Certificate
+		want        codes.Code
+	}{
+		{
+			name:        "allowed DNS SAN",
+			certificate: ca.clientCertificate(t, []string{"admin-panel.internal"}),
+			want:        codes.OK,
+		},
+		{
+			name:        "allowed URI SAN among others",
+			certificate: ca.clientCertificate(t, []string{"game.internal"}, "spiffe://corp/admin"),
+			want:        codes.OK,
+		},
+		{
+			name:        "other SANs",
+			certificate: ca.clientCertificate(t, []string{"game.internal"}, "spiffe://corp/game"),
+			want:        codes.PermissionDenied,
+		},
+		{
+			name:        "SAN differing in case",
+			certificate: ca.clientCertificate(t, []string{"Admin-Panel.internal"}),
+			want:        codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				auth := &fakeAuthController{
+					login: func(context.Context) (*authpb.TokenResponse, error) {
+						return &authpb.TokenResponse{}, nil
+					},
+				}
+				client := newMutualTLSTestClient(t, ca, rules, auth, tt.certificate)
+
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				_, err := client.Login(ctx, &authpb.AuthenticationRequest{})
+				if status.Code(err) != tt.want {
+					t.Fatalf("Login() error = %v, want %v", err, tt.want)
+				}
+
+				// methods without rules are not restricted, unimplemented VerifyToken of the fake reaches the handler
+				_, err = client.VerifyToken(ctx, &authpb.VerifyTokenRequest{})
+				if status.Code(err) != codes.Unimplemented {
+					t.Fatalf("VerifyToken() error = %v, want %v", err, codes.Unimplemented)
+				}
+			},
+		)
+	}
+}
+
+func TestSANAuthorizationInterceptor_RequiresVerifiedCertificate(t *testing.T) {
+	rules, err := parseMethodSANs([]string{"Login=admin-panel.internal"})
+	if err != nil {
+		t.Fatalf("parseMethodSANs() error = %v", err)
+	}
+
+	interceptor := sanAuthorizationInterceptor(rules)
+	info := &grpc.UnaryServerInfo{FullMethod: authpb.AuthService_Login_FullMethodName}
+	handler := func(context.Context, any) (any, error) {
+		return &authpb.TokenResponse{}, nil
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{name: "no peer", ctx: context.Background()},
+		{
+			// e.g. in-process server of HTTP gateway
+			name: "connection without TLS",
+			ctx:  peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{}}),
+		},
+		{
+			name: "TLS without client certificate",
+			ctx: peer.NewContext(
+				context.Background(),
+				&peer.Peer{Addr: &net.TCPAddr{}, AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{}}},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if _, err := interceptor(tt.ctx, nil, info, handler); status.Code(err) != codes.Unauthenticated {
+					t.Fatalf("interceptor error = %v, want %v", err, codes.Unauthenticated)
+				}
+			},
+		)
+	}
+}
@@ -1,7 +1,29 @@
 package grpc
 
+import "time"
+
 type Config struct {
 	Debug          bool `env:"DEBUG" env-default:"true"`
 	MetricsPort    int  `env:"METRICS_SERVER_PORT" env-default:"8989"`
 	GRPCServerPort int  `env:"GRPC_SERVER_PORT" env-default:"50051"`
+	// Reflection registers server reflection service for grpcurl and similar tools.
+	Reflection bool `env:"GRPC_REFLECTION" env-default:"false"`
+	// AccessLog logs method, status code, latency and peer of every request.
+	AccessLog bool `env:"GRPC_ACCESS_LOG" env-default:"true"`
+	// MaxDeadline limits time of unary request handling, later client deadlines are shortened, 0 disables the limit.
+	MaxDeadline time.Duration `env:"GRPC_MAX_DEADLINE" env-default:"30s"`
+
+	// TLS is enabled when certificate and key are set, changed files are picked up without restart.
+	TLSCertFile       string        `env:"GRPC_TLS_CERT_FILE"`
+	TLSKeyFile        string        `env:"GRPC_TLS_KEY_FILE"`
+	TLSReloadInterval time.Duration `env:"GRPC_TLS_RELOAD_INTERVAL" env-default:"30s"`
+	// TLSClientCAFile enables mutual TLS, clients must present certificate signed by the CA.
+	TLSClientCAFile string `env:"GRPC_TLS_CLIENT_CA_FILE"`
+	// MethodAllowedSANs restricts methods to clients with given certificate SANs (requires mutual TLS),
+	// entries look like "BanAccount=admin-panel.internal,spiffe://corp/admin".
+	MethodAllowedSANs []string `env:"GRPC_METHOD_ALLOWED_SANS" env-separator:";"`
+}
+
+func (c Config) TLSEnabled() bool {
+	return c.TLSCertFile != "" || c.TLSKeyFile != ""
 }
@@ -3,20 +3,45 @@ package grpc
 import (
 	"context"
 	"github.com/intezya/auth_service/internal/application/usecase"
+	"github.com/intezya/auth_service/internal/domain/dto"
+	"github.com/intezya/auth_service/pkg/clock"
 	authpb "github.com/intezya/auth_service/protos/go/auth"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"net"
+	"strings"
+	"time"
 )
 
 type authController struct {
 	authpb.UnimplementedAuthServiceServer
 
-	authService usecase.AuthUseCase
+	authService    usecase.AuthUseCase
+	deviceService  usecase.DeviceUseCase
+	exportService  usecase.ExportUseCase
+	accountService usecase.AccountUseCase
+	emailService   usecase.EmailUseCase
+
+	clock clock.Clock
 }
 
-func NewAuthController(authService usecase.AuthUseCase) authpb.AuthServiceServer {
+func NewAuthController(
+	authService usecase.AuthUseCase,
+	deviceService usecase.DeviceUseCase,
+	exportService usecase.ExportUseCase,
+	accountService usecase.AccountUseCase,
+	emailService usecase.EmailUseCase,
+	clock clock.Clock,
+) authpb.AuthServiceServer {
 	return &authController{
-		authService: authService,
+		authService:    authService,
+		deviceService:  deviceService,
+		exportService:  exportService,
+		accountService: accountService,
+		emailService:   emailService,
+		clock:          clock,
 	}
 }
 
@@ -67,6 +92,7 @@ func (c *authController) Login(
 			Username:   request.Username,
 			Password:   request.Password,
 			HardwareID: request.HardwareId,
+			IP:         clientIP(ctx),
 		},
 	)
 	if err != nil {
@@ -133,3 +159,481 @@ func (c *authController) BanAccount(ctx context.Context, request *authpb.BanAcco
 
 	return &authpb.Empty{}, nil
 }
+
+func (c *authController) DeleteAccount(
+	ctx context.Context,
+	request *authpb.DeleteAccountRequest,
+) (*authpb.Empty, error) {
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if request.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "password is required")
+	}
+
+	err := c.authService.DeleteAccount(
+		ctx, &usecase.DeleteAccountCommand{
+			Token:    request.Token,
+			Password: request.Password,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authpb.Empty{}, nil
+}
+
+func (c *authController) RestoreAccount(
+	ctx context.Context,
+	request *authpb.RestoreAccountRequest,
+) (*authpb.Empty, error) {
+	if request.GetUsername() == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+	if request.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "password is required")
+	}
+
+	err := c.authService.RestoreAccount(
+		ctx, &usecase.RestoreAccountCommand{
+			Username: request.Username,
+			Password: request.Password,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authpb.Empty{}, nil
+}
+
+func (c *authController) ExportAccountData(
+	ctx context.Context,
+	request *authpb.ExportAccountDataRequest,
+) (*authpb.ExportAccountDataResponse, error) {
+	if request.GetSubject() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "subject is required")
+	}
+
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	result, err := c.exportService.ExportAccountData(
+		ctx, &usecase.ExportAccountDataCommand{
+			Token:     request.Token,
+			AccountID: int(request.Subject),
+			Format:    usecase.ExportFormat(request.Format),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authpb.ExportAccountDataResponse{
+		Bundle:      result.Bundle,
+		ContentType: result.ContentType,
+		FileName:    result.FileName,
+	}, nil
+}
+
+func (c *authController) SearchAccounts(
+	ctx context.Context,
+	request *authpb.SearchAccountsRequest,
+) (*authpb.SearchAccountsResponse, error) {
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	cmd := &usecase.SearchAccountsCommand{
+		Token:          request.Token,
+		UsernamePrefix: request.UsernamePrefix,
+		Banned:         request.Banned,
+		SortBy:         request.SortBy,
+		Descending:     request.Descending,
+		PageSize:       int(request.PageSize),
+		PageToken:      request.PageToken,
+	}
+
+	if request.AccessLevel != nil {
+		accessLevel := int(*request.AccessLevel)
+		cmd.AccessLevel = &accessLevel
+	}
+	if request.CreatedFromUnix != 0 {
+		createdFrom := time.Unix(request.CreatedFromUnix, 0)
+		cmd.CreatedFrom = &createdFrom
+	}
+	if request.CreatedToUnix != 0 {
+		createdTo := time.Unix(request.CreatedToUnix, 0)
+		cmd.CreatedTo = &createdTo
+	}
+
+	result, err := c.accountService.SearchAccounts(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*authpb.Account, 0, len(result.Accounts))
+	for _, account := range result.Accounts {
+		accounts = append(accounts, accountToProto(account))
+	}
+
+	return &authpb.SearchAccountsResponse{
+		Accounts:      accounts,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (c *authController) GetAccount(
+	ctx context.Context,
+	request *authpb.GetAccountRequest,
+) (*authpb.PublicAccount, error) {
+	if request.GetSubject() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "subject is required")
+	}
+
+	result, err := c.accountService.GetAccount(
+		ctx, &usecase.GetAccountCommand{
+			AccountID: int(request.Subject),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountToPublicProto(result, c.clock), nil
+}
+
+func (c *authController) GetAccounts(
+	ctx context.Context,
+	request *authpb.GetAccountsRequest,
+) (*authpb.GetAccountsResponse, error) {
+	ids := make([]int, 0, len(request.GetSubjects()))
+	for _, subject := range request.GetSubjects() {
+		ids = append(ids, int(subject))
+	}
+
+	result, err := c.accountService.GetAccounts(
+		ctx, &usecase.GetAccountsCommand{
+			AccountIDs: ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*authpb.PublicAccount, 0, len(result))
+	for _, account := range result {
+		accounts = append(accounts, accountToPublicProto(account, c.clock))
+	}
+
+	return &authpb.GetAccountsResponse{Accounts: accounts}, nil
+}
+
+func (c *authController) ChangeUsername(
+	ctx context.Context,
+	request *authpb.ChangeUsernameRequest,
+) (*authpb.Empty, error) {
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if request.GetUsername() == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+
+	err := c.accountService.ChangeUsername(
+		ctx, &usecase.ChangeUsernameCommand{
+			Token:    request.Token,
+			Username: request.Username,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authpb.Empty{}, nil
+}
+
+func (c *authController) AddEmail(
+	ctx context.Context,
+	request *authpb.AddEmailRequest,
+) (*authpb.Empty, error) {
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if request.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	err := c.emailService.AddEmail(
+		ctx, &usecase.AddEmailCommand{
+			Token: request.Token,
+			Email: request.Email,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authpb.Empty{}, nil
+}
+
+func (c *authController) VerifyEmail(
+	ctx context.Context,
+	request *authpb.VerifyEmailRequest,
+) (*authpb.Empty, error) {
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if request.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	err := c.emailService.VerifyEmail(
+		ctx, &usecase.VerifyEmailCommand{
+			Token: request.Token,
+			Code:  request.Code,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authpb.Empty{}, nil
+}
+
+func (c *authController) ListLoginHistory(
+	ctx context.Context,
+	request *authpb.ListLoginHistoryRequest,
+) (*authpb.ListLoginHistoryResponse, error) {
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if request.GetSubject() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "subject is required")
+	}
+
+	result, err := c.accountService.ListLoginHistory(
+		ctx, &usecase.ListLoginHistoryCommand{
+			Token:     request.Token,
+			AccountID: int(request.Subject),
+			PageSize:  int(request.PageSize),
+			PageToken: request.PageToken,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make([]*authpb.LoginAttempt, 0, len(result.Attempts))
+	for _, attempt := range result.Attempts {
+		attempts = append(attempts, loginAttemptToProto(attempt))
+	}
+
+	return &authpb.ListLoginHistoryResponse{
+		Attempts:      attempts,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (c *authController) ListDevices(
+	ctx context.Context,
+	request *authpb.ListDevicesRequest,
+) (*authpb.ListDevicesResponse, error) {
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	result, err := c.deviceService.ListDevices(
+		ctx, &usecase.ListDevicesCommand{
+			Token: request.Token,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]*authpb.Device, 0, len(result))
+	for _, device := range result {
+		var approvedAt, lastSeenAt int64 = 0, 0
+		if device.ApprovedAt != nil {
+			approvedAt = device.ApprovedAt.Unix()
+		}
+		if device.LastSeenAt != nil {
+			lastSeenAt = device.LastSeenAt.Unix()
+		}
+
+		devices = append(
+			devices, &authpb.Device{
+				Id:             int64(device.ID),
+				IsTrusted:      device.IsTrusted,
+				CreatedAtUnix:  device.CreatedAt.Unix(),
+				ApprovedAtUnix: approvedAt,
+				LastSeenAtUnix: lastSeenAt,
+			},
+		)
+	}
+
+	return &authpb.ListDevicesResponse{Devices: devices}, nil
+}
+
+func (c *authController) ApproveDevice(
+	ctx context.Context,
+	request *authpb.ApproveDeviceRequest,
+) (*authpb.Empty, error) {
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if request.GetHardwareId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "hardware_id is required")
+	}
+	if request.GetDeviceId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "device_id is required")
+	}
+
+	err := c.deviceService.ApproveDevice(
+		ctx, &usecase.ApproveDeviceCommand{
+			Token:      request.Token,
+			HardwareID: request.HardwareId,
+			DeviceID:   int(request.DeviceId),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authpb.Empty{}, nil
+}
+
+func (c *authController) RemoveDevice(
+	ctx context.Context,
+	request *authpb.RemoveDeviceRequest,
+) (*authpb.Empty, error) {
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if request.GetDeviceId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "device_id is required")
+	}
+
+	err := c.deviceService.RemoveDevice(
+		ctx, &usecase.RemoveDeviceCommand{
+			Token:    request.Token,
+			DeviceID: int(request.DeviceId),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authpb.Empty{}, nil
+}
+
+func accountToProto(account *dto.AccountDTO) *authpb.Account {
+	var bannedUntil int64 = 0
+	if account.BannedUntil != nil {
+		bannedUntil = account.BannedUntil.Unix()
+	}
+
+	var banReason string
+	if account.BanReason != nil {
+		banReason = *account.BanReason
+	}
+
+	var lastLoginAt int64 = 0
+	if account.LastLoginAt != nil {
+		lastLoginAt = account.LastLoginAt.Unix()
+	}
+
+	return &authpb.Account{
+		Id:              int64(account.ID),
+		Username:        account.Username,
+		AccessLevel:     int64(account.AccessLevel),
+		CreatedAtUnix:   account.CreatedAt.Unix(),
+		BannedUntilUnix: bannedUntil,
+		BanReason:       banReason,
+		LastLoginAtUnix: lastLoginAt,
+	}
+}
+
+func accountToPublicProto(account *dto.AccountDTO, clock clock.Clock) *authpb.PublicAccount {
+	var bannedUntil int64 = 0
+	if account.BannedUntil != nil && account.BannedUntil.After(clock.Now()) {
+		bannedUntil = account.BannedUntil.Unix()
+	}
+
+	return &authpb.PublicAccount{
+		Id:              int64(account.ID),
+		Username:        account.Username,
+		AccessLevel:     int64(account.AccessLevel),
+		IsBanned:        bannedUntil != 0,
+		BannedUntilUnix: bannedUntil,
+	}
+}
+
+func loginAttemptToProto(attempt *dto.LoginAttemptDTO) *authpb.LoginAttempt {
+	var ip string
+	if attempt.IP != nil {
+		ip = *attempt.IP
+	}
+
+	var failureReason string
+	if attempt.FailureReason != nil {
+		failureReason = *attempt.FailureReason
+	}
+
+	result := &authpb.LoginAttempt{
+		Id:              int64(attempt.ID),
+		Ip:              ip,
+		HardwareIdMatch: attempt.HardwareIDMatch,
+		Success:         attempt.Success,
+		FailureReason:   failureReason,
+		CreatedAtUnix:   attempt.CreatedAt.Unix(),
+		RiskSignals:     attempt.RiskSignals,
+	}
+
+	if attempt.Country != nil {
+		result.Country = *attempt.Country
+	}
+
+	if attempt.ASN != nil {
+		result.Asn = uint32(*attempt.ASN)
+	}
+
+	if attempt.RiskScore != nil {
+		result.RiskScore = int32(*attempt.RiskScore)
+	}
+
+	if attempt.RiskDecision != nil {
+		result.RiskDecision = *attempt.RiskDecision
+	}
+
+	return result
+}
+
+// clientIP returns address of the peer, nil if it is unknown. Requests of in-process HTTP gateway carry
+// address of HTTP client as the last x-forwarded-for entry, which is appended by the gateway itself.
+func clientIP(ctx context.Context) *string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+
+	address := p.Addr.String()
+	if p.Addr.Network() == inProcessNetwork {
+		forwarded := metadata.ValueFromIncomingContext(ctx, "x-forwarded-for")
+		if len(forwarded) == 0 {
+			return nil
+		}
+
+		entries := strings.Split(forwarded[len(forwarded)-1], ",")
+		address = strings.TrimSpace(entries[len(entries)-1])
+	}
+
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+
+	return &address
+}
@@ -5,6 +5,7 @@ package grpc
 import (
 	"context"
 	"github.com/intezya/auth_service/internal/application/usecase"
+	"github.com/intezya/auth_service/pkg/clock"
 	tracer "github.com/intezya/auth_service/pkg/tracer"
 	authpb "github.com/intezya/auth_service/protos/go/auth"
 )
@@ -14,8 +15,8 @@ type authControllerWithTracing struct {
 	authpb.UnimplementedAuthServiceServer
 }
 
-func NewAuthControllerWithTracing(authService usecase.AuthUseCase) authpb.AuthServiceServer {
-	wrapped := NewAuthController(authService)
+func NewAuthControllerWithTracing(authService usecase.AuthUseCase, deviceService usecase.DeviceUseCase, exportService usecase.ExportUseCase, accountService usecase.AccountUseCase, emailService usecase.EmailUseCase, clock clock.Clock) authpb.AuthServiceServer {
+	wrapped := NewAuthController(authService, deviceService, exportService, accountService, emailService, clock)
 	return &authControllerWithTracing{
 		wrapped: wrapped,
 	}
@@ -48,3 +49,94 @@ func (t *authControllerWithTracing) BanAccount(ctx context.Context, request *aut
 
 	return t.wrapped.BanAccount(ctx, request)
 }
+
+func (t *authControllerWithTracing) DeleteAccount(ctx context.Context, request *authpb.DeleteAccountRequest) (*authpb.Empty, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.DeleteAccount")
+	defer span.End()
+
+	return t.wrapped.DeleteAccount(ctx, request)
+}
+
+func (t *authControllerWithTracing) RestoreAccount(ctx context.Context, request *authpb.RestoreAccountRequest) (*authpb.Empty, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.RestoreAccount")
+	defer span.End()
+
+	return t.wrapped.RestoreAccount(ctx, request)
+}
+
+func (t *authControllerWithTracing) ExportAccountData(ctx context.Context, request *authpb.ExportAccountDataRequest) (*authpb.ExportAccountDataResponse, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.ExportAccountData")
+	defer span.End()
+
+	return t.wrapped.ExportAccountData(ctx, request)
+}
+
+func (t *authControllerWithTracing) SearchAccounts(ctx context.Context, request *authpb.SearchAccountsRequest) (*authpb.SearchAccountsResponse, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.SearchAccounts")
+	defer span.End()
+
+	return t.wrapped.SearchAccounts(ctx, request)
+}
+
+func (t *authControllerWithTracing) GetAccount(ctx context.Context, request *authpb.GetAccountRequest) (*authpb.PublicAccount, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.GetAccount")
+	defer span.End()
+
+	return t.wrapped.GetAccount(ctx, request)
+}
+
+func (t *authControllerWithTracing) GetAccounts(ctx context.Context, request *authpb.GetAccountsRequest) (*authpb.GetAccountsResponse, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.GetAccounts")
+	defer span.End()
+
+	return t.wrapped.GetAccounts(ctx, request)
+}
+
+func (t *authControllerWithTracing) ChangeUsername(ctx context.Context, request *authpb.ChangeUsernameRequest) (*authpb.Empty, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.ChangeUsername")
+	defer span.End()
+
+	return t.wrapped.ChangeUsername(ctx, request)
+}
+
+func (t *authControllerWithTracing) AddEmail(ctx context.Context, request *authpb.AddEmailRequest) (*authpb.Empty, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.AddEmail")
+	defer span.End()
+
+	return t.wrapped.AddEmail(ctx, request)
+}
+
+func (t *authControllerWithTracing) VerifyEmail(ctx context.Context, request *authpb.VerifyEmailRequest) (*authpb.Empty, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.VerifyEmail")
+	defer span.End()
+
+	return t.wrapped.VerifyEmail(ctx, request)
+}
+
+func (t *authControllerWithTracing) ListLoginHistory(ctx context.Context, request *authpb.ListLoginHistoryRequest) (*authpb.ListLoginHistoryResponse, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.ListLoginHistory")
+	defer span.End()
+
+	return t.wrapped.ListLoginHistory(ctx, request)
+}
+
+func (t *authControllerWithTracing) ListDevices(ctx context.Context, request *authpb.ListDevicesRequest) (*authpb.ListDevicesResponse, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.ListDevices")
+	defer span.End()
+
+	return t.wrapped.ListDevices(ctx, request)
+}
+
+func (t *authControllerWithTracing) ApproveDevice(ctx context.Context, request *authpb.ApproveDeviceRequest) (*authpb.Empty, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.ApproveDevice")
+	defer span.End()
+
+	return t.wrapped.ApproveDevice(ctx, request)
+}
+
+func (t *authControllerWithTracing) RemoveDevice(ctx context.Context, request *authpb.RemoveDeviceRequest) (*authpb.Empty, error) {
+	ctx, span := tracer.StartSpan(ctx, "AuthController.RemoveDevice")
+	defer span.End()
+
+	return t.wrapped.RemoveDevice(ctx, request)
+}
@@ -0,0 +1,241 @@
+package grpc
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"runtime/debug"
+	"time"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+// RequestIDHeader is metadata key carrying ID of request, it is generated when client doesn't send it.
+const RequestIDHeader = "x-request-id"
+
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns ID of request handled by server, empty outside of requests.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+
+	return requestID
+}
+
+// serverInterceptors returns standard chain which wraps interceptors of the app:
+// request ID, access log, panic recovery and deadline limit, outermost first.
+func serverInterceptors(config Config) []grpc.UnaryServerInterceptor {
+	interceptors := []grpc.UnaryServerInterceptor{requestIDInterceptor}
+
+	if config.AccessLog {
+		interceptors = append(interceptors, accessLogInterceptor)
+	}
+
+	interceptors = append(interceptors, recoveryInterceptor)
+
+	if config.MaxDeadline > 0 {
+		interceptors = append(interceptors, deadlineInterceptor(config.MaxDeadline))
+	}
+
+	return interceptors
+}
+
+// serverStreamInterceptors is serverInterceptors for streaming calls without deadline limit,
+// streams such as health Watch are meant to stay open.
+func serverStreamInterceptors(config Config) []grpc.StreamServerInterceptor {
+	interceptors := []grpc.StreamServerInterceptor{requestIDStreamInterceptor}
+
+	if config.AccessLog {
+		interceptors = append(interceptors, accessLogStreamInterceptor)
+	}
+
+	return append(interceptors, recoveryStreamInterceptor)
+}
+
+// contextServerStream replaces context of wrapped stream, stream interceptors can't pass context otherwise.
+type contextServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// requestIDInterceptor takes request ID from metadata or generates it, and returns it in response header.
+func requestIDInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(withRequestID(ctx), req)
+}
+
+func requestIDStreamInterceptor(
+	srv any,
+	stream grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &contextServerStream{ServerStream: stream, ctx: withRequestID(stream.Context())})
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	var requestID string
+	if values := metadata.ValueFromIncomingContext(ctx, RequestIDHeader); len(values) > 0 {
+		requestID = values[0]
+	}
+
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		requestID = uuid.NewString()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+func accessLogInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	logAccess(ctx, info.FullMethod, start, err)
+
+	return resp, err
+}
+
+func accessLogStreamInterceptor(
+	srv any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	err := handler(srv, stream)
+
+	logAccess(stream.Context(), info.FullMethod, start, err)
+
+	return err
+}
+
+func logAccess(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	if code == codes.Unknown {
+		// handlers may return context errors as is, gRPC sends them as Canceled and DeadlineExceeded
+		code = status.FromContextError(err).Code()
+	}
+
+	fields := []any{
+		"method", method,
+		"code", code.String(),
+		"latency", time.Since(start),
+		"peer", derefOrEmpty(clientIP(ctx)),
+		"request_id", RequestIDFromContext(ctx),
+	}
+
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+		logger.Log.Errorw("gRPC request failed", append(fields, "error", err)...)
+	default:
+		logger.Log.Infow("gRPC request", fields...)
+	}
+}
+
+// recoveryInterceptor turns panic of handler into Internal error, so it doesn't crash the server.
+func recoveryInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Log.Errorw(
+				"gRPC handler panicked",
+				"method", info.FullMethod,
+				"request_id", RequestIDFromContext(ctx),
+				"panic", r,
+				"stack", string(debug.Stack()),
+			)
+
+			resp, err = nil, status.Error(codes.Internal, "internal error")
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+func recoveryStreamInterceptor(
+	srv any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Log.Errorw(
+				"gRPC stream handler panicked",
+				"method", info.FullMethod,
+				"request_id", RequestIDFromContext(stream.Context()),
+				"panic", r,
+				"stack", string(debug.Stack()),
+			)
+
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+
+	return handler(srv, stream)
+}
+
+// ContextInterceptor derives context of every request with wrap, e.g. to start request scoped state
+// of lower layers.
+func ContextInterceptor(wrap func(ctx context.Context) context.Context) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		return handler(wrap(ctx), req)
+	}
+}
+
+// deadlineInterceptor limits deadline of requests without deadline or with later one.
+func deadlineInterceptor(maxDeadline time.Duration) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > maxDeadline {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, maxDeadline)
+
+			defer cancel()
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func derefOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
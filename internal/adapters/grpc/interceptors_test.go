@@ -0,0 +1,396 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	authpb "github.com/intezya/auth_service/protos/go/auth"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+func TestMain(m *testing.M) {
+	if _, err := logger.New(); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+// fakeAuthController handles Login with login func of the test.
+type fakeAuthController struct {
+	authpb.UnimplementedAuthServiceServer
+
+	login func(ctx context.Context) (*authpb.TokenResponse, error)
+}
+
+func (c *fakeAuthController) Login(ctx context.Context, _ *authpb.AuthenticationRequest) (*authpb.TokenResponse, error) {
+	return c.login(ctx)
+}
+
+// fakeHealthServer handles Watch with watch func of the test, it is the streaming method at hand.
+type fakeHealthServer struct {
+	healthpb.UnimplementedHealthServer
+
+	watch func(stream healthpb.Health_WatchServer) error
+}
+
+func (s *fakeHealthServer) Watch(_ *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	return s.watch(stream)
+}
+
+// newInterceptorTestClient serves auth and health with standard interceptor chains over bufconn.
+func newInterceptorTestClient(
+	t *testing.T,
+	config Config,
+	auth *fakeAuthController,
+	health *fakeHealthServer,
+) (authpb.AuthServiceClient, healthpb.HealthClient) {
+	t.Helper()
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(serverInterceptors(config)...),
+		grpc.ChainStreamInterceptor(serverStreamInterceptors(config)...),
+	)
+	authpb.RegisterAuthServiceServer(server, auth)
+	healthpb.RegisterHealthServer(server, health)
+
+	listener := bufconn.Listen(inProcessBufferSize)
+	go func() { _ = server.Serve(listener) }()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(
+			func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			},
+		),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	t.Cleanup(
+		func() {
+			_ = conn.Close()
+			server.Stop()
+		},
+	)
+
+	return authpb.NewAuthServiceClient(conn), healthpb.NewHealthClient(conn)
+}
+
+// observeLogs replaces global logger with in-memory one for the test.
+func observeLogs(t *testing.T) *observer.ObservedLogs {
+	t.Helper()
+
+	core, logs := observer.New(zap.InfoLevel)
+
+	previous := logger.Log
+	logger.Log = &logger.Logger{SugaredLogger: zap.New(core).Sugar()}
+
+	t.Cleanup(func() { logger.Log = previous })
+
+	return logs
+}
+
+func TestRecoveryInterceptor_PanicBecomesInternal(t *testing.T) {
+	logs := observeLogs(t)
+
+	auth := &fakeAuthController{
+		login: func(context.Context) (*authpb.TokenResponse, error) {
+			panic("boom")
+		},
+	}
+	client, _ := newInterceptorTestClient(t, Config{}, auth, &fakeHealthServer{})
+
+	for range 2 { // server survives the panic
+		_, err := client.Login(context.Background(), &authpb.AuthenticationRequest{})
+		if status.Code(err) != codes.Internal {
+			t.Fatalf("Login() error = %v, want %v", err, codes.Internal)
+		}
+
+		if strings.Contains(err.Error(), "boom") {
+			t.Fatalf("Login() error = %v exposes panic value", err)
+		}
+	}
+
+	if panics := logs.FilterMessage("gRPC handler panicked").Len(); panics != 2 { //nolint:mnd
+		t.Fatalf("%d panics logged, want 2", panics)
+	}
+}
+
+func TestRecoveryStreamInterceptor_PanicBecomesInternal(t *testing.T) {
+	logs := observeLogs(t)
+
+	health := &fakeHealthServer{
+		watch: func(healthpb.Health_WatchServer) error {
+			panic("boom")
+		},
+	}
+	_, client := newInterceptorTestClient(t, Config{}, &fakeAuthController{}, health)
+
+	stream, err := client.Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+
+	if _, err := stream.Recv(); status.Code(err) != codes.Internal {
+		t.Fatalf("Recv() error = %v, want %v", err, codes.Internal)
+	}
+
+	entries := logs.FilterMessage("gRPC stream handler panicked").All()
+	if len(entries) != 1 {
+		t.Fatalf("%d stream panics logged, want 1", len(entries))
+	}
+
+	if entries[0].ContextMap()["request_id"] == "" {
+		t.Fatal("stream panic is logged without request ID")
+	}
+}
+
+func TestDeadlineInterceptor(t *testing.T) {
+	const maxDeadline = time.Second
+
+	tests := []struct {
+		name          string
+		clientTimeout time.Duration // 0 sends no deadline
+		want          time.Duration
+	}{
+		{name: "no client deadline", want: maxDeadline},
+		{name: "later client deadline", clientTimeout: time.Hour, want: maxDeadline},
+		{name: "earlier client deadline", clientTimeout: maxDeadline / 2, want: maxDeadline / 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var remaining time.Duration
+
+				auth := &fakeAuthController{
+					login: func(ctx context.Context) (*authpb.TokenResponse, error) {
+						deadline, ok := ctx.Deadline()
+						if !ok {
+							return nil, status.Error(codes.FailedPrecondition, "no deadline")
+						}
+
+						remaining = time.Until(deadline)
+
+						return &authpb.TokenResponse{}, nil
+					},
+				}
+				client, _ := newInterceptorTestClient(t, Config{MaxDeadline: maxDeadline}, auth, &fakeHealthServer{})
+
+				ctx := context.Background()
+				if tt.clientTimeout > 0 {
+					var cancel context.CancelFunc
+					ctx, cancel = context.WithTimeout(ctx, tt.clientTimeout)
+
+					defer cancel()
+				}
+
+				if _, err := client.Login(ctx, &authpb.AuthenticationRequest{}); err != nil {
+					t.Fatalf("Login() error = %v", err)
+				}
+
+				// transport takes a bit of the deadline
+				if remaining > tt.want || remaining < tt.want-100*time.Millisecond {
+					t.Fatalf("handler deadline in %s, want about %s", remaining, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestDeadlineInterceptor_DoesNotLimitStreams(t *testing.T) {
+	hasDeadline := true
+
+	health := &fakeHealthServer{
+		watch: func(stream healthpb.Health_WatchServer) error {
+			_, hasDeadline = stream.Context().Deadline()
+
+			return nil
+		},
+	}
+	_, client := newInterceptorTestClient(t, Config{MaxDeadline: time.Second}, &fakeAuthController{}, health)
+
+	stream, err := client.Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+
+	if _, err := stream.Recv(); !errors.Is(err, io.EOF) {
+		t.Fatalf("Recv() error = %v, want EOF", err)
+	}
+
+	if hasDeadline {
+		t.Fatal("stream got deadline, long-lived streams must not be limited")
+	}
+}
+
+func TestRequestIDInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		sent     string // empty sends no request ID
+		wantSent bool   // sent ID is kept, otherwise new one is generated
+	}{
+		{name: "passed through", sent: "request-1", wantSent: true},
+		{name: "generated when missing"},
+		{name: "generated when too long", sent: strings.Repeat("x", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				ctx := context.Background()
+				if tt.sent != "" {
+					ctx = metadata.AppendToOutgoingContext(ctx, RequestIDHeader, tt.sent)
+				}
+
+				var unaryID, streamID string
+
+				auth := &fakeAuthController{
+					login: func(ctx context.Context) (*authpb.TokenResponse, error) {
+						unaryID = RequestIDFromContext(ctx)
+
+						return &authpb.TokenResponse{}, nil
+					},
+				}
+				health := &fakeHealthServer{
+					watch: func(stream healthpb.Health_WatchServer) error {
+						streamID = RequestIDFromContext(stream.Context())
+
+						return nil
+					},
+				}
+				authClient, healthClient := newInterceptorTestClient(t, Config{}, auth, health)
+
+				var header metadata.MD
+				if _, err := authClient.Login(ctx, &authpb.AuthenticationRequest{}, grpc.Header(&header)); err != nil {
+					t.Fatalf("Login() error = %v", err)
+				}
+
+				assertRequestID(t, "unary", unaryID, header, tt.sent, tt.wantSent)
+
+				stream, err := healthClient.Watch(ctx, &healthpb.HealthCheckRequest{})
+				if err != nil {
+					t.Fatalf("Watch() error = %v", err)
+				}
+
+				if _, err := stream.Recv(); !errors.Is(err, io.EOF) {
+					t.Fatalf("Recv() error = %v, want EOF", err)
+				}
+
+				streamHeader, err := stream.Header()
+				if err != nil {
+					t.Fatalf("Header() error = %v", err)
+				}
+
+				assertRequestID(t, "stream", streamID, streamHeader, tt.sent, tt.wantSent)
+			},
+		)
+	}
+}
+
+func assertRequestID(t *testing.T, call string, handlerID string, header metadata.MD, sent string, wantSent bool) {
+	t.Helper()
+
+	switch {
+	case handlerID == "":
+		t.Fatalf("%s handler got no request ID", call)
+	case wantSent && handlerID != sent:
+		t.Fatalf("%s handler got request ID %q, want %q", call, handlerID, sent)
+	case !wantSent && handlerID == sent:
+		t.Fatalf("%s handler got request ID %q sent by client, want generated one", call, handlerID)
+	}
+
+	if got := header.Get(RequestIDHeader); len(got) != 1 || got[0] != handlerID {
+		t.Fatalf("%s response header %s = %v, want [%s]", call, RequestIDHeader, got, handlerID)
+	}
+}
+
+func TestAccessLogInterceptor(t *testing.T) {
+	logs := observeLogs(t)
+
+	auth := &fakeAuthController{
+		login: func(context.Context) (*authpb.TokenResponse, error) {
+			return nil, status.Error(codes.NotFound, "account not found")
+		},
+	}
+	health := &fakeHealthServer{
+		watch: func(healthpb.Health_WatchServer) error {
+			return status.Error(codes.Internal, "broken")
+		},
+	}
+	authClient, healthClient := newInterceptorTestClient(t, Config{AccessLog: true}, auth, health)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), RequestIDHeader, "request-1")
+
+	if _, err := authClient.Login(ctx, &authpb.AuthenticationRequest{}); status.Code(err) != codes.NotFound {
+		t.Fatalf("Login() error = %v, want %v", err, codes.NotFound)
+	}
+
+	stream, err := healthClient.Watch(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+
+	if _, err := stream.Recv(); status.Code(err) != codes.Internal {
+		t.Fatalf("Recv() error = %v, want %v", err, codes.Internal)
+	}
+
+	tests := []struct {
+		message string
+		method  string
+		code    codes.Code
+	}{
+		{message: "gRPC request", method: authpb.AuthService_Login_FullMethodName, code: codes.NotFound},
+		{message: "gRPC request failed", method: healthpb.Health_Watch_FullMethodName, code: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		entries := logs.FilterMessage(tt.message).All()
+		if len(entries) != 1 {
+			t.Fatalf("%d %q entries logged, want 1", len(entries), tt.message)
+		}
+
+		fields := entries[0].ContextMap()
+		if fields["method"] != tt.method || fields["code"] != tt.code.String() || fields["request_id"] != "request-1" {
+			t.Fatalf("%q logged with %v, want method %s, code %s and request ID", tt.message, fields, tt.method, tt.code)
+		}
+	}
+}
+
+func TestAccessLogInterceptor_Disabled(t *testing.T) {
+	logs := observeLogs(t)
+
+	auth := &fakeAuthController{
+		login: func(context.Context) (*authpb.TokenResponse, error) {
+			return &authpb.TokenResponse{}, nil
+		},
+	}
+	client, _ := newInterceptorTestClient(t, Config{AccessLog: false}, auth, &fakeHealthServer{})
+
+	if _, err := client.Login(context.Background(), &authpb.AuthenticationRequest{}); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	if logs.Len() != 0 {
+		t.Fatalf("%d entries logged with disabled access log, want 0", logs.Len())
+	}
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"github.com/intezya/auth_service/internal/application/usecase"
+	"github.com/intezya/auth_service/pkg/clock"
 	authpb "github.com/intezya/auth_service/protos/go/auth"
 )
 
@@ -9,8 +10,15 @@ type Provider struct {
 	AuthController authpb.AuthServiceServer
 }
 
-func NewProvider(provider *usecase.Provider) *Provider {
+func NewProvider(provider *usecase.Provider, clock clock.Clock) *Provider {
 	return &Provider{
-		AuthController: NewAuthControllerWithTracing(provider.AuthUseCase),
+		AuthController: NewAuthControllerWithTracing(
+			provider.AuthUseCase,
+			provider.DeviceUseCase,
+			provider.ExportUseCase,
+			provider.AccountUseCase,
+			provider.EmailUseCase,
+			clock,
+		),
 	}
 }
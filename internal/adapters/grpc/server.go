@@ -4,36 +4,123 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/intezya/auth_service/internal/adapters/http"
+	"github.com/intezya/auth_service/internal/adapters/health"
 	authpb "github.com/intezya/auth_service/protos/go/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
 	"net"
 	"sync"
 
 	"github.com/intezya/pkglib/logger"
-	"google.golang.org/grpc"
+)
+
+const (
+	inProcessBufferSize = 1 << 20
+	inProcessNetwork    = "bufconn" // network of in-process connection addresses
 )
 
 var ErrShutdownTimeout = errors.New("shutdown timed out")
 
 type App struct {
-	server   *grpc.Server
-	port     int
-	listener net.Listener
-	mu       sync.Mutex
-	running  bool
+	server *grpc.Server
+	// inProcessServer serves the same services with the same interceptors to in-process clients
+	// (HTTP gateway) over in-memory listener, transport credentials of server don't apply to it.
+	inProcessServer   *grpc.Server
+	inProcessListener *bufconn.Listener
+	health            *grpchealth.Server
+	checker           *health.Checker
+	port              int
+	listener          net.Listener
+	mu                sync.Mutex
+	running           bool
 }
 
-func NewGRPCApp(provider *Provider, config Config) *App {
-	server := grpc.NewServer()
+func NewGRPCApp(
+	provider *Provider,
+	config Config,
+	checker *health.Checker,
+	interceptors ...grpc.UnaryServerInterceptor,
+) (*App, error) {
+	var options []grpc.ServerOption
+
+	if config.TLSEnabled() {
+		reloader, err := newCertReloader(config)
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, grpc.Creds(credentials.NewTLS(reloader.serverConfig())))
+		logger.Log.Infof("gRPC TLS enabled (mutual: %t)", config.TLSClientCAFile != "")
+	}
+
+	if len(config.MethodAllowedSANs) > 0 {
+		if config.TLSClientCAFile == "" {
+			return nil, errors.New("method SAN rules require mutual TLS")
+		}
+
+		rules, err := parseMethodSANs(config.MethodAllowedSANs)
+		if err != nil {
+			return nil, err
+		}
+
+		interceptors = append([]grpc.UnaryServerInterceptor{sanAuthorizationInterceptor(rules)}, interceptors...)
+	}
+
+	chain := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(append(serverInterceptors(config), interceptors...)...),
+		grpc.ChainStreamInterceptor(serverStreamInterceptors(config)...),
+	}
+	server := grpc.NewServer(append(options, chain...)...)
+	inProcessServer := grpc.NewServer(chain...)
 
 	authpb.RegisterAuthServiceServer(server, provider.AuthController)
+	authpb.RegisterAuthServiceServer(inProcessServer, provider.AuthController)
+
+	// serving status of the whole server ("") and of every service follows readiness of checker
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	checker.Subscribe(
+		func(ready bool) {
+			status := healthpb.HealthCheckResponse_NOT_SERVING
+			if ready {
+				status = healthpb.HealthCheckResponse_SERVING
+			}
 
-	http.SetupMetricsServer(config.MetricsPort)
+			healthServer.SetServingStatus("", status)
+			healthServer.SetServingStatus(authpb.AuthService_ServiceDesc.ServiceName, status)
+		},
+	)
 
-	return &App{
-		server: server,
-		port:   config.GRPCServerPort,
+	if config.Reflection {
+		reflection.Register(server)
 	}
+
+	return &App{
+		server:            server,
+		inProcessServer:   inProcessServer,
+		inProcessListener: bufconn.Listen(inProcessBufferSize),
+		health:            healthServer,
+		checker:           checker,
+		port:              config.GRPCServerPort,
+	}, nil
+}
+
+// DialInProcess returns connection to in-process server, it is usable once the app is started.
+func (a *App) DialInProcess() (*grpc.ClientConn, error) {
+	return grpc.NewClient(
+		"passthrough:///in-process",
+		grpc.WithContextDialer(
+			func(ctx context.Context, _ string) (net.Conn, error) {
+				return a.inProcessListener.DialContext(ctx)
+			},
+		),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
 }
 
 func (a *App) Start(ctx context.Context) error {
@@ -63,6 +150,12 @@ func (a *App) Start(ctx context.Context) error {
 
 	errCh := make(chan error, 1)
 
+	go func() {
+		if err := a.inProcessServer.Serve(a.inProcessListener); err != nil {
+			logger.Log.Warnf("In-process gRPC server serve error: %v", err)
+		}
+	}()
+
 	go func() {
 		defer close(errCh)
 		if err := a.server.Serve(lis); err != nil {
@@ -89,6 +182,10 @@ func (a *App) Start(ctx context.Context) error {
 }
 
 func (a *App) Shutdown(ctx context.Context) error {
+	// clients watching health stop sending new requests while in-flight ones are drained
+	a.checker.SetShuttingDown()
+	a.health.Shutdown()
+
 	a.mu.Lock()
 	if !a.running {
 		a.mu.Unlock()
@@ -103,6 +200,7 @@ func (a *App) Shutdown(ctx context.Context) error {
 	go func() {
 		defer close(done)
 		a.server.GracefulStop()
+		a.inProcessServer.GracefulStop()
 
 		a.mu.Lock()
 		a.running = false
@@ -117,6 +215,7 @@ func (a *App) Shutdown(ctx context.Context) error {
 		logger.Log.Warnf("gRPC server on port %d shutdown timeout, forcing stop", a.port)
 
 		a.server.Stop()
+		a.inProcessServer.Stop()
 
 		a.mu.Lock()
 		a.running = false
@@ -144,3 +243,9 @@ func (a *App) Port() int {
 func (a *App) Server() *grpc.Server {
 	return a.server
 }
+
+// InProcessServer returns credential-less server with the same services and interceptors,
+// it is meant for in-process transports such as HTTP transcoders.
+func (a *App) InProcessServer() *grpc.Server {
+	return a.inProcessServer
+}
@@ -0,0 +1,155 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+const defaultTLSReloadInterval = 30 * time.Second
+
+var errInvalidClientCA = errors.New("no certificates found in client CA file")
+
+// certReloader serves TLS configuration from files and reloads it when they change on disk,
+// files are checked on handshakes at most once per interval. Failed reload keeps previous configuration.
+type certReloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string // mutual TLS is required if set
+	interval     time.Duration
+
+	mu        sync.Mutex
+	config    *tls.Config
+	modTimes  []time.Time
+	checkedAt time.Time
+}
+
+func newCertReloader(config Config) (*certReloader, error) {
+	if config.TLSCertFile == "" || config.TLSKeyFile == "" {
+		return nil, errors.New("both TLS certificate and key files must be set")
+	}
+
+	interval := config.TLSReloadInterval
+	if interval <= 0 {
+		interval = defaultTLSReloadInterval
+	}
+
+	r := &certReloader{
+		certFile:     config.TLSCertFile,
+		keyFile:      config.TLSKeyFile,
+		clientCAFile: config.TLSClientCAFile,
+		interval:     interval,
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// serverConfig returns configuration for grpc credentials which resolves current one on every handshake.
+func (r *certReloader) serverConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetConfigForClient: r.getConfigForClient,
+	}
+}
+
+func (r *certReloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) < r.interval {
+		return r.config, nil
+	}
+
+	r.checkedAt = time.Now()
+
+	if modTimes, err := r.statFiles(); err == nil && !r.isChanged(modTimes) {
+		return r.config, nil
+	}
+
+	if err := r.load(); err != nil {
+		logger.Log.Warnf("Failed to reload TLS certificates, previous ones are used: %v", err)
+	} else {
+		logger.Log.Infof("TLS certificates reloaded")
+	}
+
+	return r.config, nil
+}
+
+// load reads the files, r.mu must be held or r must not be shared yet.
+func (r *certReloader) load() error {
+	modTimes, err := r.statFiles()
+	if err != nil {
+		return err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{certificate},
+		NextProtos:   []string{"h2"}, // configs from GetConfigForClient don't inherit ALPN required by gRPC
+	}
+
+	if r.clientCAFile != "" {
+		pem, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return errInvalidClientCA
+		}
+
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	r.config = config
+	r.modTimes = modTimes
+	r.checkedAt = time.Now()
+
+	return nil
+}
+
+func (r *certReloader) statFiles() ([]time.Time, error) {
+	files := []string{r.certFile, r.keyFile}
+	if r.clientCAFile != "" {
+		files = append(files, r.clientCAFile)
+	}
+
+	modTimes := make([]time.Time, 0, len(files))
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+
+		modTimes = append(modTimes, info.ModTime())
+	}
+
+	return modTimes, nil
+}
+
+func (r *certReloader) isChanged(modTimes []time.Time) bool {
+	for i, modTime := range modTimes {
+		if !modTime.Equal(r.modTimes[i]) {
+			return true
+		}
+	}
+
+	return false
+}
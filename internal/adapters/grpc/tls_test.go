@@ -0,0 +1,346 @@
+package grpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCA issues certificates for tests.
+type testCA struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	pem         []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate CA key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create CA certificate: %v", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse CA certificate: %v", err)
+	}
+
+	return &testCA{
+		certificate: certificate,
+		key:         key,
+		pem:         pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns PEM encoded certificate and key with given serial number and SANs, usage is
+// x509.ExtKeyUsageServerAuth or x509.ExtKeyUsageClientAuth.
+func (ca *testCA) issue(
+	t *testing.T,
+	serial int64,
+	usage x509.ExtKeyUsage,
+	dnsNames []string,
+	uris ...string,
+) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     dnsNames,
+	}
+
+	for _, uri := range uris {
+		parsed, err := url.Parse(uri)
+		if err != nil {
+			t.Fatalf("parse URI SAN: %v", err)
+		}
+
+		template.URIs = append(template.URIs, parsed)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// clientCertificate returns key pair of client certificate with given SANs.
+func (ca *testCA) clientCertificate(t *testing.T, dnsNames []string, uris ...string) tls.Certificate {
+	t.Helper()
+
+	certPEM, keyPEM := ca.issue(t, 100, x509.ExtKeyUsageClientAuth, dnsNames, uris...) //nolint:mnd
+
+	certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatalf("load client certificate: %v", err)
+	}
+
+	return certificate
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.certificate)
+
+	return pool
+}
+
+// tlsFiles are paths of files served by certReloader in tests.
+type tlsFiles struct {
+	cert, key, clientCA string
+}
+
+func newTLSFiles(t *testing.T) tlsFiles {
+	dir := t.TempDir()
+
+	return tlsFiles{
+		cert:     filepath.Join(dir, "server.crt"),
+		key:      filepath.Join(dir, "server.key"),
+		clientCA: filepath.Join(dir, "client-ca.crt"),
+	}
+}
+
+// write replaces file content and moves its modification time forward, so the change is seen
+// regardless of file system timestamp resolution.
+func (f tlsFiles) write(t *testing.T, path string, content []byte) {
+	t.Helper()
+
+	previous := time.Now().Add(-time.Hour)
+	if info, err := os.Stat(path); err == nil {
+		previous = info.ModTime()
+	}
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+
+	modTime := previous.Add(time.Second)
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("touch %s: %v", path, err)
+	}
+}
+
+func (f tlsFiles) writeServerCertificate(t *testing.T, ca *testCA, serial int64) {
+	t.Helper()
+
+	certPEM, keyPEM := ca.issue(t, serial, x509.ExtKeyUsageServerAuth, []string{"auth.test"})
+	f.write(t, f.cert, certPEM)
+	f.write(t, f.key, keyPEM)
+}
+
+// servedSerial handshakes with server using config of reloader and returns serial number of its certificate.
+func servedSerial(t *testing.T, reloader *certReloader, ca *testCA) int64 {
+	t.Helper()
+
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	go func() { _ = tls.Server(serverConn, reloader.serverConfig()).Handshake() }()
+
+	client := tls.Client(
+		clientConn, &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    ca.pool(),
+			ServerName: "auth.test",
+			NextProtos: []string{"h2"},
+		},
+	)
+	if err := client.Handshake(); err != nil {
+		t.Fatalf("Handshake() error = %v", err)
+	}
+
+	state := client.ConnectionState()
+	if state.NegotiatedProtocol != "h2" {
+		t.Fatalf("negotiated protocol %q, want h2", state.NegotiatedProtocol)
+	}
+
+	return state.PeerCertificates[0].SerialNumber.Int64()
+}
+
+func TestCertReloader_ReloadsChangedCertificate(t *testing.T) {
+	ca := newTestCA(t)
+	files := newTLSFiles(t)
+	files.writeServerCertificate(t, ca, 1)
+
+	reloader, err := newCertReloader(
+		Config{TLSCertFile: files.cert, TLSKeyFile: files.key, TLSReloadInterval: time.Nanosecond},
+	)
+	if err != nil {
+		t.Fatalf("newCertReloader() error = %v", err)
+	}
+
+	if serial := servedSerial(t, reloader, ca); serial != 1 {
+		t.Fatalf("served certificate %d, want 1", serial)
+	}
+
+	files.writeServerCertificate(t, ca, 2) //nolint:mnd
+
+	if serial := servedSerial(t, reloader, ca); serial != 2 { //nolint:mnd
+		t.Fatalf("served certificate %d after rotation, want 2", serial)
+	}
+}
+
+func TestCertReloader_KeepsPreviousCertificateOnInvalidFiles(t *testing.T) {
+	ca := newTestCA(t)
+	files := newTLSFiles(t)
+	files.writeServerCertificate(t, ca, 1)
+
+	reloader, err := newCertReloader(
+		Config{TLSCertFile: files.cert, TLSKeyFile: files.key, TLSReloadInterval: time.Nanosecond},
+	)
+	if err != nil {
+		t.Fatalf("newCertReloader() error = %v", err)
+	}
+
+	// e.g. certificate is already replaced, but key is not yet
+	certPEM, _ := ca.issue(t, 2, x509.ExtKeyUsageServerAuth, []string{"auth.test"}) //nolint:mnd
+	files.write(t, files.cert, certPEM)
+
+	if serial := servedSerial(t, reloader, ca); serial != 1 {
+		t.Fatalf("served certificate %d with mismatched key, want previous 1", serial)
+	}
+
+	if err := os.Remove(files.key); err != nil {
+		t.Fatalf("remove key: %v", err)
+	}
+
+	if serial := servedSerial(t, reloader, ca); serial != 1 {
+		t.Fatalf("served certificate %d without key file, want previous 1", serial)
+	}
+}
+
+func TestCertReloader_ChecksFilesOncePerInterval(t *testing.T) {
+	ca := newTestCA(t)
+	files := newTLSFiles(t)
+	files.writeServerCertificate(t, ca, 1)
+
+	reloader, err := newCertReloader(
+		Config{TLSCertFile: files.cert, TLSKeyFile: files.key, TLSReloadInterval: time.Hour},
+	)
+	if err != nil {
+		t.Fatalf("newCertReloader() error = %v", err)
+	}
+
+	files.writeServerCertificate(t, ca, 2) //nolint:mnd
+
+	if serial := servedSerial(t, reloader, ca); serial != 1 {
+		t.Fatalf("served certificate %d before interval passed, want 1", serial)
+	}
+}
+
+func TestNewCertReloader(t *testing.T) {
+	ca := newTestCA(t)
+	files := newTLSFiles(t)
+	files.writeServerCertificate(t, ca, 1)
+	files.write(t, files.clientCA, ca.pem)
+
+	invalidCA := filepath.Join(t.TempDir(), "invalid-ca.crt")
+	if err := os.WriteFile(invalidCA, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("write invalid CA: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		config     Config
+		wantErr    bool
+		wantErrIs  error
+		wantMutual bool
+	}{
+		{
+			name:   "server TLS",
+			config: Config{TLSCertFile: files.cert, TLSKeyFile: files.key},
+		},
+		{
+			name:       "mutual TLS",
+			config:     Config{TLSCertFile: files.cert, TLSKeyFile: files.key, TLSClientCAFile: files.clientCA},
+			wantMutual: true,
+		},
+		{
+			name:    "missing key",
+			config:  Config{TLSCertFile: files.cert},
+			wantErr: true,
+		},
+		{
+			name:    "key of another certificate",
+			config:  Config{TLSCertFile: files.cert, TLSKeyFile: files.clientCA},
+			wantErr: true,
+		},
+		{
+			name:      "invalid client CA",
+			config:    Config{TLSCertFile: files.cert, TLSKeyFile: files.key, TLSClientCAFile: invalidCA},
+			wantErr:   true,
+			wantErrIs: errInvalidClientCA,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				reloader, err := newCertReloader(tt.config)
+
+				if (err != nil) != tt.wantErr || (tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs)) {
+					t.Fatalf("newCertReloader() error = %v, wantErr %t", err, tt.wantErr)
+				}
+
+				if err != nil {
+					return
+				}
+
+				config, err := reloader.getConfigForClient(nil)
+				if err != nil {
+					t.Fatalf("getConfigForClient() error = %v", err)
+				}
+
+				if mutual := config.ClientAuth == tls.RequireAndVerifyClientCert; mutual != tt.wantMutual {
+					t.Fatalf("client certificate required = %t, want %t", mutual, tt.wantMutual)
+				}
+			},
+		)
+	}
+}
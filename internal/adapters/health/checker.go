@@ -0,0 +1,127 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	defaultDBPingInterval = 5 * time.Second
+	defaultDBPingTimeout  = 2 * time.Second
+)
+
+var (
+	ErrNotMigrated  = errors.New("database migrations are not completed")
+	ErrShuttingDown = errors.New("service is shutting down")
+)
+
+type Config struct {
+	DBPingInterval time.Duration `env:"HEALTH_DB_PING_INTERVAL" env-default:"5s"`
+	DBPingTimeout  time.Duration `env:"HEALTH_DB_PING_TIMEOUT" env-default:"2s"`
+}
+
+// Checker tracks readiness of the service, its state is shared by gRPC health service and HTTP probes.
+type Checker struct {
+	mu           sync.Mutex
+	migrated     bool
+	shuttingDown bool
+	dbErr        error
+	ready        bool
+	listeners    []func(ready bool)
+	config       Config
+}
+
+func NewChecker(config Config) *Checker {
+	return &Checker{config: config}
+}
+
+func (c *Checker) SetMigrated() {
+	c.update(func() { c.migrated = true })
+}
+
+// SetShuttingDown marks service not ready for good.
+func (c *Checker) SetShuttingDown() {
+	c.update(func() { c.shuttingDown = true })
+}
+
+// Ready returns nil if service is ready or the reason why it is not.
+func (c *Checker) Ready() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.readiness()
+}
+
+// Subscribe calls listener with current readiness and then on every change of it.
+func (c *Checker) Subscribe(listener func(ready bool)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.listeners = append(c.listeners, listener)
+	listener(c.ready)
+}
+
+// RunDBCheck pings database periodically until ctx is done, failed ping makes service not ready.
+func (c *Checker) RunDBCheck(ctx context.Context, ping func(ctx context.Context) error) {
+	interval, timeout := c.config.DBPingInterval, c.config.DBPingTimeout
+	if interval <= 0 {
+		interval = defaultDBPingInterval
+	}
+
+	if timeout <= 0 {
+		timeout = defaultDBPingTimeout
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pingCtx, cancel := context.WithTimeout(ctx, timeout)
+			err := ping(pingCtx)
+			cancel()
+
+			if err != nil {
+				err = fmt.Errorf("database is unavailable: %w", err)
+			}
+
+			c.update(func() { c.dbErr = err })
+		}
+	}
+}
+
+func (c *Checker) readiness() error {
+	switch {
+	case c.shuttingDown:
+		return ErrShuttingDown
+	case !c.migrated:
+		return ErrNotMigrated
+	default:
+		return c.dbErr
+	}
+}
+
+// update applies change and notifies listeners if readiness changed, listeners are called under the lock
+// so they observe changes in order.
+func (c *Checker) update(change func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	change()
+
+	ready := c.readiness() == nil
+	if ready == c.ready {
+		return
+	}
+
+	c.ready = ready
+	for _, listener := range c.listeners {
+		listener(ready)
+	}
+}
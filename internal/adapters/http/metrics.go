@@ -3,6 +3,7 @@ package http
 import (
 	"errors"
 	"fmt"
+	"github.com/intezya/auth_service/internal/adapters/health"
 	"github.com/intezya/pkglib/logger"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"net/http"
@@ -15,10 +16,17 @@ const (
 	idleTimeout  = 10 * time.Second
 )
 
-func SetupMetricsServer(port int) {
+// SetupMetricsServer serves metrics and probes, /healthz reports liveness and /readyz readiness of checker.
+func SetupMetricsServer(port int, checker *health.Checker) {
 	go func() {
 		mux := http.NewServeMux()
 		mux.Handle("/metrics", promhttp.Handler())
+		mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+			writeProbe(w, nil)
+		})
+		mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+			writeProbe(w, checker.Ready())
+		})
 
 		server := &http.Server{
 			//nolint:exhaustruct
@@ -36,3 +44,16 @@ func SetupMetricsServer(port int) {
 		}
 	}()
 }
+
+func writeProbe(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	if err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = fmt.Fprintln(w, err.Error())
+
+		return
+	}
+
+	_, _ = fmt.Fprintln(w, "ok")
+}
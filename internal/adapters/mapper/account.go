@@ -8,12 +8,119 @@ import (
 func EntAccountToDomain(account *ent.Account) *domain.Account {
 	return domain.NewAccountFromRepository(
 		domain.AccountID(account.ID),
-		domain.Username(account.Username),
-		domain.HashedPassword(account.Password),
+		domain.Username(valueOrEmpty(account.Username)), // nil for erased account
+		domain.HashedPassword(valueOrEmpty(account.Password)),
 		(*domain.HardwareID)(account.HardwareID),
+		(*domain.HardwareIDDigest)(account.HardwareIDDigest),
 		account.AccessLevel,
 		account.BannedUntil,
 		account.BanReason,
 		account.CreatedAt,
+		account.DeletedAt,
+		account.ErasedAt,
+		account.UsernameChangedAt,
+		(*domain.Email)(account.Email),
+		account.EmailVerifiedAt,
+		account.LastLoginAt,
+		account.Version,
 	)
 }
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func EntDeviceToDomain(device *ent.Device) *domain.Device {
+	return domain.NewDeviceFromRepository(
+		domain.DeviceID(device.ID),
+		domain.AccountID(device.AccountID),
+		domain.HardwareID(device.HardwareID),
+		domain.HardwareIDDigest(device.HardwareIDDigest),
+		device.Status,
+		device.CreatedAt,
+		device.ApprovedAt,
+		device.LastSeenAt,
+	)
+}
+
+func EntAuditEntryToDomain(entry *ent.AuditEntry) *domain.AuditEntry {
+	return domain.NewAuditEntryFromRepository(
+		domain.AuditEntryID(entry.ID),
+		domain.AccountID(entry.AccountID),
+		domain.AuditAction(entry.Action),
+		domain.AuditActor(entry.Actor),
+		entry.Details,
+		entry.CreatedAt,
+	)
+}
+
+func EntUsernameChangeToDomain(change *ent.UsernameChange) *domain.UsernameChange {
+	return domain.NewUsernameChangeFromRepository(
+		domain.UsernameChangeID(change.ID),
+		domain.AccountID(change.AccountID),
+		domain.Username(change.Username),
+		change.ChangedAt,
+		change.ReservedUntil,
+	)
+}
+
+func EntEmailVerificationToDomain(verification *ent.EmailVerification) *domain.EmailVerification {
+	return domain.NewEmailVerificationFromRepository(
+		domain.EmailVerificationID(verification.ID),
+		domain.AccountID(verification.AccountID),
+		domain.Email(verification.Email),
+		verification.CodeDigest,
+		verification.Attempts,
+		verification.ExpiresAt,
+		verification.CreatedAt,
+	)
+}
+
+func EntLoginAttemptToDomain(attempt *ent.LoginAttempt) *domain.LoginAttempt {
+	var risk *domain.RiskAssessment
+	if attempt.RiskScore != nil && attempt.RiskDecision != nil {
+		risk = &domain.RiskAssessment{
+			Score:    *attempt.RiskScore,
+			Decision: domain.RiskDecision(*attempt.RiskDecision),
+			Signals:  RiskSignalsToDomain(attempt.RiskSignals),
+		}
+	}
+
+	return domain.NewLoginAttemptFromRepository(
+		domain.LoginAttemptID(attempt.ID),
+		domain.AccountID(attempt.AccountID),
+		attempt.IP,
+		domain.HardwareIDMatch(attempt.HardwareIDMatch),
+		(*domain.LoginFailureReason)(attempt.FailureReason),
+		domain.IPLocation{
+			ASN:       attempt.Asn,
+			Country:   attempt.Country,
+			Latitude:  attempt.Latitude,
+			Longitude: attempt.Longitude,
+		},
+		risk,
+		attempt.CreatedAt,
+	)
+}
+
+func RiskSignalsToDomain(signals []string) []domain.RiskSignal {
+	result := make([]domain.RiskSignal, 0, len(signals))
+	for _, signal := range signals {
+		result = append(result, domain.RiskSignal(signal))
+	}
+
+	return result
+}
+
+func RiskSignalsFromDomain(signals []domain.RiskSignal) []string {
+	result := make([]string, 0, len(signals))
+	for _, signal := range signals {
+		result = append(result, string(signal))
+	}
+
+	return result
+}
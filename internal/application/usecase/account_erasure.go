@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/pkg/clock"
+	"time"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+const (
+	accountErasureBatchSize       = 100
+	defaultAccountErasureInterval = time.Hour
+)
+
+type AccountErasureConfig struct {
+	// GracePeriod is how long deleted account can be restored.
+	GracePeriod time.Duration `env:"ACCOUNT_DELETION_GRACE_PERIOD" env-default:"720h"`
+	Interval    time.Duration `env:"ACCOUNT_ERASURE_INTERVAL" env-default:"1h"`
+}
+
+// AccountErasureJob erases personal data of accounts deleted longer than grace period ago.
+type AccountErasureJob struct {
+	accountRepository           repository.AccountRepository
+	deviceRepository            repository.DeviceRepository
+	auditRepository             repository.AuditRepository
+	usernameChangeRepository    repository.UsernameChangeRepository
+	emailVerificationRepository repository.EmailVerificationRepository
+	loginAttemptRepository      repository.LoginAttemptRepository
+	txManager                   repository.TxManager
+	config                      AccountErasureConfig
+	clock                       clock.Clock
+}
+
+func NewAccountErasureJob(
+	accountRepository repository.AccountRepository,
+	deviceRepository repository.DeviceRepository,
+	auditRepository repository.AuditRepository,
+	usernameChangeRepository repository.UsernameChangeRepository,
+	emailVerificationRepository repository.EmailVerificationRepository,
+	loginAttemptRepository repository.LoginAttemptRepository,
+	txManager repository.TxManager,
+	config AccountErasureConfig,
+	clock clock.Clock,
+) *AccountErasureJob {
+	return &AccountErasureJob{
+		accountRepository:           accountRepository,
+		deviceRepository:            deviceRepository,
+		auditRepository:             auditRepository,
+		usernameChangeRepository:    usernameChangeRepository,
+		emailVerificationRepository: emailVerificationRepository,
+		loginAttemptRepository:      loginAttemptRepository,
+		txManager:                   txManager,
+		config:                      config,
+		clock:                       clock,
+	}
+}
+
+// Run erases expired accounts every config.Interval until ctx is done.
+func (j *AccountErasureJob) Run(ctx context.Context) {
+	interval := j.config.Interval
+	if interval <= 0 {
+		interval = defaultAccountErasureInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		erased, err := j.EraseExpired(ctx)
+		if err != nil {
+			logger.Log.Warnf("Account erasure failed: %v", err)
+		} else if erased > 0 {
+			logger.Log.Infof("Account erasure completed: %d accounts erased", erased)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// EraseExpired erases all accounts with expired grace period and returns their count.
+func (j *AccountErasureJob) EraseExpired(ctx context.Context) (int, error) {
+	erased := 0
+	deletedBefore := j.clock.Now().Add(-j.config.GracePeriod)
+
+	for {
+		batch, err := j.accountRepository.FindAllDeletedBefore(ctx, deletedBefore, accountErasureBatchSize)
+		if err != nil {
+			return erased, err
+		}
+
+		if len(batch) == 0 {
+			return erased, nil
+		}
+
+		for _, account := range batch {
+			if err := j.erase(ctx, entity.AccountID(account.ID())); err != nil {
+				return erased, err
+			}
+
+			erased++
+		}
+	}
+}
+
+func (j *AccountErasureJob) erase(ctx context.Context, id entity.AccountID) error {
+	return j.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			account, err := j.accountRepository.FindByID(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			if !account.IsDeleted() || account.IsErased() {
+				return nil // restored or erased concurrently
+			}
+
+			if err := account.Erase(j.clock); err != nil {
+				return err
+			}
+
+			// devices hold encrypted hardware ids
+			if err := j.deviceRepository.DeleteAllByAccountID(ctx, id); err != nil {
+				return err
+			}
+
+			// previous usernames are personal data as well, their reservation ends with account
+			if err := j.usernameChangeRepository.DeleteAllByAccountID(ctx, id); err != nil {
+				return err
+			}
+
+			if err := j.emailVerificationRepository.DeleteAllByAccountID(ctx, id); err != nil {
+				return err
+			}
+
+			// login history holds ip addresses
+			if err := j.loginAttemptRepository.DeleteAllByAccountID(ctx, id); err != nil {
+				return err
+			}
+
+			if err := j.accountRepository.Update(ctx, account); err != nil {
+				return err
+			}
+
+			_, err = j.auditRepository.Create(
+				ctx,
+				entity.NewAuditEntry(id, entity.AuditActionErased, entity.AuditActorSystem, nil, j.clock),
+			)
+
+			return err
+		},
+	)
+}
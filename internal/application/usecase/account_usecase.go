@@ -0,0 +1,465 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/dto"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"github.com/intezya/auth_service/pkg/clock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	defaultSearchPageSize = 50
+	maxSearchPageSize     = 100
+
+	defaultLoginHistoryPageSize = 50
+	maxLoginHistoryPageSize     = 200
+)
+
+var (
+	ErrInsufficientAccessLevel = status.Error(codes.PermissionDenied, "insufficient access level")
+	ErrInvalidPageToken        = status.Error(codes.InvalidArgument, "invalid page token")
+	ErrAccountNotFound         = status.Error(codes.NotFound, "account not found")
+)
+
+type AccountLookupConfig struct {
+	MaxBatchSize int `env:"ACCOUNT_LOOKUP_MAX_BATCH_SIZE" env-default:"100"`
+}
+
+var accountSortFields = map[string]repository.AccountSortField{
+	"":           repository.AccountSortByID,
+	"id":         repository.AccountSortByID,
+	"created_at": repository.AccountSortByCreatedAt,
+	"username":   repository.AccountSortByUsername,
+}
+
+type AccountUseCase interface {
+	SearchAccounts(ctx context.Context, cmd *SearchAccountsCommand) (*SearchAccountsResult, error)
+	// GetAccount returns active account, deleted accounts are not found.
+	GetAccount(ctx context.Context, cmd *GetAccountCommand) (*dto.AccountDTO, error)
+	// GetAccounts returns active accounts in order of requested ids, missing and deleted ones are skipped.
+	GetAccounts(ctx context.Context, cmd *GetAccountsCommand) ([]*dto.AccountDTO, error)
+	// ChangeUsername renames token owner, previous username stays reserved for the account for a while.
+	ChangeUsername(ctx context.Context, cmd *ChangeUsernameCommand) error
+	// ListLoginHistory returns login attempts of account newest first, caller must have admin access level.
+	ListLoginHistory(ctx context.Context, cmd *ListLoginHistoryCommand) (*ListLoginHistoryResult, error)
+}
+
+type SearchAccountsCommand struct {
+	Token string // caller must have AccessLevelViewAllUsers
+
+	UsernamePrefix string
+	AccessLevel    *int
+	Banned         *bool
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time // exclusive
+
+	SortBy     string // "id" (default) / "created_at" / "username"
+	Descending bool
+	PageSize   int
+	PageToken  string // NextPageToken of previous page
+}
+
+type GetAccountCommand struct {
+	AccountID int
+}
+
+type GetAccountsCommand struct {
+	AccountIDs []int
+}
+
+type ChangeUsernameCommand struct {
+	Token    string
+	Username string
+}
+
+type ListLoginHistoryCommand struct {
+	Token     string
+	AccountID int
+	PageSize  int
+	PageToken string
+}
+
+type ListLoginHistoryResult struct {
+	Attempts      []*dto.LoginAttemptDTO
+	NextPageToken string // empty on the last page
+}
+
+type SearchAccountsResult struct {
+	Accounts      []*dto.AccountDTO
+	NextPageToken string // empty on the last page
+}
+
+// pageToken is the opaque keyset cursor, it is valid only for the same sort order.
+type pageToken struct {
+	SortBy     string    `json:"s"`
+	Descending bool      `json:"d,omitempty"`
+	ID         int       `json:"i"`
+	CreatedAt  time.Time `json:"c,omitempty"`
+	Username   string    `json:"u,omitempty"`
+}
+
+// loginHistoryPageToken is the opaque keyset cursor of login history.
+type loginHistoryPageToken struct {
+	ID        int       `json:"i"`
+	CreatedAt time.Time `json:"c"`
+}
+
+type accountUseCase struct {
+	accountRepository      repository.AccountRepository
+	auditRepository        repository.AuditRepository
+	loginAttemptRepository repository.LoginAttemptRepository
+	txManager              repository.TxManager
+
+	tokenManager      service.TokenManager
+	usernameManager   service.UsernameManager
+	usernameValidator service.Validator[string]
+
+	config AccountLookupConfig
+
+	clock clock.Clock
+}
+
+func NewAccountUseCase(
+	accountRepository repository.AccountRepository,
+	auditRepository repository.AuditRepository,
+	loginAttemptRepository repository.LoginAttemptRepository,
+	txManager repository.TxManager,
+	tokenManager service.TokenManager,
+	usernameManager service.UsernameManager,
+	usernameValidator service.Validator[string],
+	config AccountLookupConfig,
+	clock clock.Clock,
+) AccountUseCase {
+	return &accountUseCase{
+		accountRepository:      accountRepository,
+		auditRepository:        auditRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		txManager:              txManager,
+		tokenManager:           tokenManager,
+		usernameManager:        usernameManager,
+		usernameValidator:      usernameValidator,
+		config:                 config,
+		clock:                  clock,
+	}
+}
+
+// SearchAccounts lists active accounts page by page, pages are stable under concurrent inserts.
+func (uc *accountUseCase) SearchAccounts(
+	ctx context.Context,
+	cmd *SearchAccountsCommand,
+) (*SearchAccountsResult, error) {
+	if err := uc.authorize(ctx, cmd.Token, entity.AccessLevelViewAllUsers); err != nil {
+		return nil, err
+	}
+
+	sortBy, ok := accountSortFields[cmd.SortBy]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported sort field %q", cmd.SortBy)
+	}
+
+	pageSize := cmd.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+
+	pageSize = min(pageSize, maxSearchPageSize)
+
+	criteria := repository.AccountSearchCriteria{
+		UsernamePrefix: cmd.UsernamePrefix,
+		Banned:         cmd.Banned,
+		Now:            uc.clock.Now(),
+		CreatedFrom:    cmd.CreatedFrom,
+		CreatedTo:      cmd.CreatedTo,
+		SortBy:         sortBy,
+		Descending:     cmd.Descending,
+		Limit:          pageSize + 1, // extra row tells whether next page exists
+	}
+
+	if cmd.AccessLevel != nil {
+		level := entity.AccessLevel(*cmd.AccessLevel)
+		if level < entity.AccessLevelUser || level > entity.AccessLevelDev {
+			return nil, status.Error(codes.InvalidArgument, "unknown access level")
+		}
+
+		criteria.AccessLevel = &level
+	}
+
+	if cmd.PageToken != "" {
+		cursor, err := decodePageToken(cmd.PageToken, cmd)
+		if err != nil {
+			return nil, err
+		}
+
+		criteria.After = cursor
+	}
+
+	accounts, err := uc.accountRepository.Search(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchAccountsResult{Accounts: make([]*dto.AccountDTO, 0, min(len(accounts), pageSize))}
+
+	if len(accounts) > pageSize {
+		accounts = accounts[:pageSize]
+		result.NextPageToken = encodePageToken(accounts[pageSize-1], cmd)
+	}
+
+	for _, account := range accounts {
+		result.Accounts = append(result.Accounts, accountToDTO(account))
+	}
+
+	return result, nil
+}
+
+func (uc *accountUseCase) GetAccount(ctx context.Context, cmd *GetAccountCommand) (*dto.AccountDTO, error) {
+	account, err := uc.accountRepository.FindByID(ctx, entity.AccountID(cmd.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	if account.IsDeleted() {
+		return nil, ErrAccountNotFound
+	}
+
+	return accountToDTO(account), nil
+}
+
+func (uc *accountUseCase) GetAccounts(ctx context.Context, cmd *GetAccountsCommand) ([]*dto.AccountDTO, error) {
+	if len(cmd.AccountIDs) > uc.config.MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many account ids, at most %d are allowed", uc.config.MaxBatchSize)
+	}
+
+	if len(cmd.AccountIDs) == 0 {
+		return []*dto.AccountDTO{}, nil
+	}
+
+	ids := make([]entity.AccountID, 0, len(cmd.AccountIDs))
+	for _, id := range cmd.AccountIDs {
+		ids = append(ids, entity.AccountID(id))
+	}
+
+	accounts, err := uc.accountRepository.FindAllByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*entity.Account, len(accounts))
+	for _, account := range accounts {
+		if !account.IsDeleted() {
+			byID[account.ID()] = account
+		}
+	}
+
+	result := make([]*dto.AccountDTO, 0, len(byID))
+	for _, id := range cmd.AccountIDs {
+		if account, ok := byID[id]; ok {
+			result = append(result, accountToDTO(account))
+			delete(byID, id) // duplicated ids are returned once
+		}
+	}
+
+	return result, nil
+}
+
+func (uc *accountUseCase) ChangeUsername(ctx context.Context, cmd *ChangeUsernameCommand) error {
+	if err := uc.usernameValidator.Validate(cmd.Username); err != nil {
+		return err
+	}
+
+	tokenData, err := uc.tokenManager.Parse(cmd.Token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return uc.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			account, err := uc.accountRepository.FindByID(ctx, entity.AccountID(tokenData.Subject))
+			if err != nil {
+				return err
+			}
+
+			if account.IsDeleted() {
+				return entity.ErrAccountDeleted
+			}
+
+			if err := uc.usernameManager.ChangeUsername(ctx, account, entity.Username(cmd.Username)); err != nil {
+				return err
+			}
+
+			// usernames are kept in username history only, it is erased together with account
+			_, err = uc.auditRepository.Create(
+				ctx,
+				entity.NewAuditEntry(
+					entity.AccountID(account.ID()),
+					entity.AuditActionUsernameChanged,
+					entity.AuditActorSubject,
+					nil,
+					uc.clock,
+				),
+			)
+
+			return err
+		},
+	)
+}
+
+func (uc *accountUseCase) ListLoginHistory(
+	ctx context.Context,
+	cmd *ListLoginHistoryCommand,
+) (*ListLoginHistoryResult, error) {
+	if err := uc.authorize(ctx, cmd.Token, entity.AccessLevelAdmin); err != nil {
+		return nil, err
+	}
+
+	pageSize := cmd.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultLoginHistoryPageSize
+	}
+
+	pageSize = min(pageSize, maxLoginHistoryPageSize)
+
+	var after *repository.LoginAttemptCursor
+
+	if cmd.PageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(cmd.PageToken)
+		if err != nil {
+			return nil, ErrInvalidPageToken
+		}
+
+		var token loginHistoryPageToken
+		if err := json.Unmarshal(raw, &token); err != nil {
+			return nil, ErrInvalidPageToken
+		}
+
+		after = &repository.LoginAttemptCursor{ID: token.ID, CreatedAt: token.CreatedAt}
+	}
+
+	attempts, err := uc.loginAttemptRepository.FindAllByAccountID(ctx, entity.AccountID(cmd.AccountID), after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListLoginHistoryResult{Attempts: make([]*dto.LoginAttemptDTO, 0, min(len(attempts), pageSize))}
+
+	if len(attempts) > pageSize {
+		attempts = attempts[:pageSize]
+
+		last := attempts[pageSize-1]
+		token, _ := json.Marshal(loginHistoryPageToken{ID: last.ID(), CreatedAt: last.CreatedAt()})
+		result.NextPageToken = base64.RawURLEncoding.EncodeToString(token)
+	}
+
+	for _, attempt := range attempts {
+		result.Attempts = append(result.Attempts, loginAttemptToDTO(attempt))
+	}
+
+	return result, nil
+}
+
+func (uc *accountUseCase) authorize(ctx context.Context, token string, level entity.AccessLevel) error {
+	tokenData, err := uc.tokenManager.Parse(token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	account, err := uc.accountRepository.FindByID(ctx, entity.AccountID(tokenData.Subject))
+	if err != nil {
+		return err
+	}
+
+	if account.IsDeleted() {
+		return entity.ErrAccountDeleted
+	}
+
+	if !account.HasAccessLevel(level) {
+		return ErrInsufficientAccessLevel
+	}
+
+	return nil
+}
+
+func encodePageToken(last *entity.Account, cmd *SearchAccountsCommand) string {
+	token, _ := json.Marshal(
+		pageToken{
+			SortBy:     cmd.SortBy,
+			Descending: cmd.Descending,
+			ID:         last.ID(),
+			CreatedAt:  last.CreatedAt(),
+			Username:   string(entity.Username(last.Username()).Canonical()),
+		},
+	)
+
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+func decodePageToken(encoded string, cmd *SearchAccountsCommand) (*repository.AccountCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(raw, &token); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if token.SortBy != cmd.SortBy || token.Descending != cmd.Descending {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &repository.AccountCursor{
+		ID:                token.ID,
+		CreatedAt:         token.CreatedAt,
+		UsernameCanonical: entity.CanonicalUsername(token.Username),
+	}, nil
+}
+
+func accountToDTO(account *entity.Account) *dto.AccountDTO {
+	return &dto.AccountDTO{
+		ID:          account.ID(),
+		Username:    account.Username(),
+		AccessLevel: account.AccessLevel(),
+		CreatedAt:   account.CreatedAt(),
+		BannedUntil: account.BannedUntil(),
+		BanReason:   account.BanReason(),
+		LastLoginAt: account.LastLoginAt(),
+	}
+}
+
+func loginAttemptToDTO(attempt *entity.LoginAttempt) *dto.LoginAttemptDTO {
+	location := attempt.Location()
+
+	attemptDTO := &dto.LoginAttemptDTO{
+		ID:              attempt.ID(),
+		IP:              attempt.IP(),
+		HardwareIDMatch: string(attempt.HardwareIDMatch()),
+		Success:         attempt.IsSuccessful(),
+		FailureReason:   (*string)(attempt.FailureReason()),
+		ASN:             location.ASN,
+		Country:         location.Country,
+		Latitude:        location.Latitude,
+		Longitude:       location.Longitude,
+		CreatedAt:       attempt.CreatedAt(),
+	}
+
+	if risk := attempt.Risk(); risk != nil {
+		decision := string(risk.Decision)
+
+		attemptDTO.RiskScore = &risk.Score
+		attemptDTO.RiskDecision = &decision
+		attemptDTO.RiskSignals = make([]string, 0, len(risk.Signals))
+		for _, signal := range risk.Signals {
+			attemptDTO.RiskSignals = append(attemptDTO.RiskSignals, string(signal))
+		}
+	}
+
+	return attemptDTO
+}
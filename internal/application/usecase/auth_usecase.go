@@ -2,12 +2,25 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	entity "github.com/intezya/auth_service/internal/domain/account"
 	"github.com/intezya/auth_service/internal/domain/dto"
 	"github.com/intezya/auth_service/internal/domain/repository"
 	"github.com/intezya/auth_service/internal/domain/service"
 	"github.com/intezya/auth_service/pkg/clock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
 	"time"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+var (
+	ErrInvalidCredentials = status.Error(codes.Unauthenticated, "invalid credentials")
+	ErrAccountBanned      = status.Error(codes.PermissionDenied, "account is banned")
+	ErrLoginDenied        = status.Error(codes.PermissionDenied, "login denied")
 )
 
 type AuthUseCase interface {
@@ -15,6 +28,8 @@ type AuthUseCase interface {
 	Login(ctx context.Context, cmd *LoginCommand) (*LoginResult, error)
 	VerifyToken(ctx context.Context, cmd *VerifyTokenCommand) (*dto.TokenData, error)
 	BanAccount(ctx context.Context, cmd *BanAccountCommand) error
+	DeleteAccount(ctx context.Context, cmd *DeleteAccountCommand) error
+	RestoreAccount(ctx context.Context, cmd *RestoreAccountCommand) error
 }
 
 type RegisterCommand struct {
@@ -24,9 +39,10 @@ type RegisterCommand struct {
 }
 
 type LoginCommand struct {
-	Username   string
+	Username   string // username or verified email
 	Password   string
 	HardwareID string
+	IP         *string // client address for login history, nil if unknown
 }
 
 type VerifyTokenCommand struct {
@@ -39,6 +55,16 @@ type BanAccountCommand struct {
 	BanReason    *string
 }
 
+type DeleteAccountCommand struct {
+	Token    string
+	Password string // confirmation
+}
+
+type RestoreAccountCommand struct {
+	Username string
+	Password string
+}
+
 type LoginResult struct {
 	Token       string
 	AccessLevel int
@@ -47,6 +73,8 @@ type LoginResult struct {
 
 type authUseCase struct {
 	accountRepository repository.AccountRepository
+	auditRepository   repository.AuditRepository
+	txManager         repository.TxManager
 
 	tokenManager service.TokenManager
 
@@ -55,32 +83,48 @@ type authUseCase struct {
 	hardwareValidator service.Validator[string]
 	passwordEncoder   service.PasswordEncoder
 	hardwareIDManager service.HardwareIDManager
+	usernameManager   service.UsernameManager
+	loginRecorder     service.LoginRecorder
+	riskEngine        service.RiskEngine
 
 	clock clock.Clock
 }
 
 func NewAuthUseCase(
 	accountRepository repository.AccountRepository,
+	auditRepository repository.AuditRepository,
+	txManager repository.TxManager,
 	passwordEncoder service.PasswordEncoder,
 	tokenManager service.TokenManager,
 	hardwareIDManager service.HardwareIDManager,
+	usernameManager service.UsernameManager,
+	loginRecorder service.LoginRecorder,
+	riskEngine service.RiskEngine,
 	usernameValidator service.Validator[string],
 	passwordValidator service.Validator[string],
 	hardwareValidator service.Validator[string],
+	clock clock.Clock,
 ) AuthUseCase {
 	return &authUseCase{
 		accountRepository: accountRepository,
+		auditRepository:   auditRepository,
+		txManager:         txManager,
 		passwordEncoder:   passwordEncoder,
 		tokenManager:      tokenManager,
 		usernameValidator: usernameValidator,
 		passwordValidator: passwordValidator,
 		hardwareValidator: hardwareValidator,
 		hardwareIDManager: hardwareIDManager,
+		usernameManager:   usernameManager,
+		loginRecorder:     loginRecorder,
+		riskEngine:        riskEngine,
+		clock:             clock,
 	}
 }
 
 func (uc *authUseCase) Register(ctx context.Context, cmd *RegisterCommand) error {
-	err := uc.passwordValidator.Validate(cmd.Username)
+	// also keeps '@' out of usernames, so logins containing it are resolved as emails
+	err := uc.usernameValidator.Validate(cmd.Username)
 	if err != nil {
 		return err
 	}
@@ -95,55 +139,194 @@ func (uc *authUseCase) Register(ctx context.Context, cmd *RegisterCommand) error
 		return err
 	}
 
-	if uc.accountRepository.ExistsByLowerUsername(ctx, entity.Username(cmd.Username)) {
-		panic("TODO()")
-		//return TODO()
+	err = uc.hardwareIDManager.EnsureHardwareIDAvailable(ctx, cmd.HardwareID)
+	if err != nil {
+		return err
+	}
+
+	err = uc.usernameManager.EnsureUsernameAvailable(ctx, 0, entity.Username(cmd.Username))
+	if err != nil {
+		return err
 	}
 
 	encodedPassword := uc.passwordEncoder.EncodePassword(ctx, cmd.Password)
 	encodedHardwareID := uc.passwordEncoder.EncodeHardwareID(ctx, cmd.HardwareID)
+	hardwareIDDigest := uc.passwordEncoder.DigestHardwareID(ctx, cmd.HardwareID)
 
 	newAccount := entity.NewAccount(
 		entity.Username(cmd.Username),
 		entity.HashedPassword(encodedPassword),
 		entity.HardwareID(encodedHardwareID),
+		entity.HardwareIDDigest(hardwareIDDigest),
 		uc.clock,
 	)
 
-	_, err = uc.accountRepository.Create(ctx, newAccount) // hardware id conflict
-
-	return err
+	// account is not created if its device can't be bound, so the username stays free
+	return uc.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			// username uniqueness is enforced by unique username_canonical index, concurrent registrations
+			// of the same name get repository.ErrAccountAlreadyExists
+			created, err := uc.accountRepository.Create(ctx, newAccount)
+			if err != nil {
+				return err
+			}
+
+			// registration device becomes the first trusted device of account
+			return uc.hardwareIDManager.ValidateAndSetHardwareID(ctx, created, cmd.HardwareID)
+		},
+	)
 }
 
 func (uc *authUseCase) Login(ctx context.Context, cmd *LoginCommand) (*LoginResult, error) {
-	account, err := uc.accountRepository.FindByLowerUsername(ctx, entity.Username(cmd.Username))
+	account, err := uc.findAccountToLogin(ctx, cmd.Username)
 	if err != nil {
+		// unknown logins have no history, they are recorded only against the ip to detect credential stuffing
+		if status.Code(err) == codes.NotFound && cmd.IP != nil {
+			uc.loginRecorder.Record(entity.NewUnknownLoginAttempt(cmd.Username, *cmd.IP, uc.clock))
+		}
+
 		return nil, err
 	}
 
+	risk, result, hardwareIDMatch, err := uc.login(ctx, account, cmd)
+
+	uc.loginRecorder.Record(
+		entity.NewLoginAttempt(
+			entity.AccountID(account.ID()),
+			cmd.IP,
+			hardwareIDMatch,
+			loginFailureReason(err),
+			risk,
+			uc.clock,
+		),
+	)
+
+	return result, err
+}
+
+func (uc *authUseCase) login(ctx context.Context, account *entity.Account, cmd *LoginCommand) (
+	*entity.RiskAssessment,
+	*LoginResult,
+	entity.HardwareIDMatch,
+	error,
+) {
 	if !uc.passwordEncoder.VerifyPassword(ctx, cmd.Password, account.Password()) {
-		panic("TODO()")
-		//return nil, TODO()
+		return nil, nil, entity.HardwareIDMatchNotChecked, ErrInvalidCredentials
+	}
+
+	// assessed before hardware id validation, which may register or approve the device
+	risk := uc.assessRisk(ctx, account, cmd)
+
+	var err error
+
+	switch risk.Decision {
+	case entity.RiskDecisionDeny:
+		return risk, nil, entity.HardwareIDMatchNotChecked, ErrLoginDenied
+	case entity.RiskDecisionStepUp:
+		// no second factor is supported, risky login proceeds only from already trusted device
+		err = uc.hardwareIDManager.RequireDeviceApproval(ctx, account, cmd.HardwareID)
+	default:
+		err = uc.hardwareIDManager.ValidateAndSetHardwareID(ctx, account, cmd.HardwareID)
 	}
 
-	err = uc.hardwareIDManager.ValidateAndSetHardwareID(ctx, account, cmd.HardwareID)
 	if err != nil {
-		return nil, err
+		return risk, nil, hardwareIDMatch(err), err
 	}
 
 	if account.IsBanned(uc.clock) {
-		panic("TODO()")
-		//return nil, TODO()
+		return risk, nil, entity.HardwareIDMatchTrusted, ErrAccountBanned
 	}
 
 	token := uc.tokenManager.Generate(account.ID())
 
-	return &LoginResult{
+	return risk, &LoginResult{
 		Token:       token,
 		AccessLevel: account.AccessLevel(),
 		BannedUntil: account.BannedUntil(),
-	}, nil
+	}, entity.HardwareIDMatchTrusted, nil
+}
+
+// assessRisk scores login and logs the decision with its signals for tuning of weights and thresholds.
+func (uc *authUseCase) assessRisk(
+	ctx context.Context,
+	account *entity.Account,
+	cmd *LoginCommand,
+) *entity.RiskAssessment {
+	risk, err := uc.riskEngine.Assess(ctx, account, cmd.HardwareID, cmd.IP)
+	if err != nil {
+		logger.Log.Warnf("risk assessment of account %d is incomplete: %v", account.ID(), err)
+	}
+
+	ip, asn, country := "unknown", "unknown", "unknown"
+	if cmd.IP != nil {
+		ip = *cmd.IP
+	}
+
+	if risk.Location.ASN != nil {
+		asn = fmt.Sprintf("AS%d", *risk.Location.ASN)
+	}
 
+	if risk.Location.Country != nil {
+		country = *risk.Location.Country
+	}
+
+	logger.Log.Infof(
+		"login risk of account %d: decision=%s score=%d signals=%v ip=%s asn=%s country=%s",
+		account.ID(), risk.Decision, risk.Score, risk.Signals, ip, asn, country,
+	)
+
+	return risk
+}
+
+func hardwareIDMatch(err error) entity.HardwareIDMatch {
+	switch {
+	case errors.Is(err, service.ErrDeviceApprovalPending):
+		return entity.HardwareIDMatchPending
+	case errors.Is(err, service.ErrHardwareIDConflict):
+		return entity.HardwareIDMatchConflict
+	case errors.Is(err, service.ErrDeviceLimitExceeded):
+		return entity.HardwareIDMatchLimitExceeded
+	default:
+		return entity.HardwareIDMatchNotChecked
+	}
+}
+
+func loginFailureReason(err error) *entity.LoginFailureReason {
+	var reason entity.LoginFailureReason
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrInvalidCredentials):
+		reason = entity.LoginFailureInvalidPassword
+	case errors.Is(err, ErrAccountBanned):
+		reason = entity.LoginFailureBanned
+	case errors.Is(err, ErrLoginDenied):
+		reason = entity.LoginFailureRiskDenied
+	case errors.Is(err, service.ErrDeviceApprovalPending):
+		reason = entity.LoginFailureDeviceApprovalPending
+	case errors.Is(err, service.ErrHardwareIDConflict):
+		reason = entity.LoginFailureHardwareIDConflict
+	case errors.Is(err, service.ErrDeviceLimitExceeded):
+		reason = entity.LoginFailureDeviceLimitExceeded
+	default:
+		reason = entity.LoginFailureInternal
+	}
+
+	return &reason
+}
+
+// findAccountToLogin resolves login as verified email or username. New usernames can't contain '@',
+// accounts registered before the rule are still found by username if no email matches.
+func (uc *authUseCase) findAccountToLogin(ctx context.Context, login string) (*entity.Account, error) {
+	if strings.Contains(login, "@") {
+		account, err := uc.accountRepository.FindByEmail(ctx, entity.Email(login))
+		if status.Code(err) != codes.NotFound {
+			return account, err
+		}
+	}
+
+	return uc.usernameManager.FindAccountByUsername(ctx, entity.Username(login))
 }
 
 func (uc *authUseCase) VerifyToken(ctx context.Context, cmd *VerifyTokenCommand) (*dto.TokenData, error) {
@@ -157,6 +340,10 @@ func (uc *authUseCase) VerifyToken(ctx context.Context, cmd *VerifyTokenCommand)
 		return nil, err
 	}
 
+	if account.IsDeleted() {
+		return nil, entity.ErrAccountDeleted
+	}
+
 	if account.IsBanned(uc.clock) {
 		panic("TODO()")
 		//return nil, TODO()
@@ -168,26 +355,113 @@ func (uc *authUseCase) VerifyToken(ctx context.Context, cmd *VerifyTokenCommand)
 }
 
 func (uc *authUseCase) BanAccount(ctx context.Context, cmd *BanAccountCommand) error {
-	account, err := uc.accountRepository.FindByID(ctx, entity.AccountID(cmd.AccountID))
+	return uc.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			account, err := uc.accountRepository.FindByID(ctx, entity.AccountID(cmd.AccountID))
+			if err != nil {
+				return err
+			}
+
+			banUntilAsTime := uc.clock.Unix(cmd.BanUntilUnix, 0)
+			action := entity.AuditActionUnbanned
+			details := map[string]string{}
+
+			if cmd.BanUntilUnix == 0 {
+				account.Unban()
+			} else {
+				err = account.Ban(banUntilAsTime, cmd.BanReason)
+				if err != nil {
+					return err
+				}
+
+				action = entity.AuditActionBanned
+				details["banned_until"] = banUntilAsTime.UTC().Format(time.RFC3339)
+				if cmd.BanReason != nil {
+					details["reason"] = *cmd.BanReason
+				}
+			}
+
+			if err := uc.accountRepository.Update(ctx, account); err != nil {
+				return err
+			}
+
+			return uc.audit(ctx, account, action, entity.AuditActorOperator, details)
+		},
+	)
+}
+
+// DeleteAccount soft deletes account of token owner, personal data is erased after grace period
+// by AccountErasureJob unless the account is restored.
+func (uc *authUseCase) DeleteAccount(ctx context.Context, cmd *DeleteAccountCommand) error {
+	tokenData, err := uc.tokenManager.Parse(cmd.Token)
 	if err != nil {
 		return err
 	}
 
-	banUntilAsTime := uc.clock.Unix(cmd.BanUntilUnix, 0)
+	return uc.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			account, err := uc.accountRepository.FindByID(ctx, entity.AccountID(tokenData.Subject))
+			if err != nil {
+				return err
+			}
 
-	if cmd.BanUntilUnix == 0 {
-		account.Unban()
-	} else {
-		err = account.Ban(banUntilAsTime, cmd.BanReason)
-		if err != nil {
-			return err
-		}
-	}
+			if account.IsDeleted() {
+				return entity.ErrAccountDeleted
+			}
 
-	err = uc.accountRepository.Update(ctx, account)
-	if err != nil {
-		return err // only unexpected
-	}
+			if !uc.passwordEncoder.VerifyPassword(ctx, cmd.Password, account.Password()) {
+				return ErrInvalidCredentials
+			}
+
+			if err := account.Delete(uc.clock); err != nil {
+				return err
+			}
+
+			if err := uc.accountRepository.Update(ctx, account); err != nil {
+				return err
+			}
+
+			return uc.audit(ctx, account, entity.AuditActionDeleted, entity.AuditActorSubject, nil)
+		},
+	)
+}
 
-	return nil
+func (uc *authUseCase) RestoreAccount(ctx context.Context, cmd *RestoreAccountCommand) error {
+	return uc.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			account, err := uc.accountRepository.FindDeletedByLowerUsername(ctx, entity.Username(cmd.Username))
+			if err != nil {
+				return err
+			}
+
+			if !uc.passwordEncoder.VerifyPassword(ctx, cmd.Password, account.Password()) {
+				return ErrInvalidCredentials
+			}
+
+			if err := account.Restore(); err != nil {
+				return err
+			}
+
+			if err := uc.accountRepository.Update(ctx, account); err != nil {
+				return err
+			}
+
+			return uc.audit(ctx, account, entity.AuditActionRestored, entity.AuditActorSubject, nil)
+		},
+	)
+}
+
+func (uc *authUseCase) audit(
+	ctx context.Context,
+	account *entity.Account,
+	action entity.AuditAction,
+	actor entity.AuditActor,
+	details map[string]string,
+) error {
+	_, err := uc.auditRepository.Create(
+		ctx,
+		entity.NewAuditEntry(entity.AccountID(account.ID()), action, actor, details, uc.clock),
+	)
+
+	return err
 }
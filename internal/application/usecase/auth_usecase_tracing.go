@@ -45,3 +45,17 @@ func (t *authUseCaseWithTracing) BanAccount(ctx context.Context, cmd *BanAccount
 
 	return t.wrapped.BanAccount(ctx, cmd)
 }
+
+func (t *authUseCaseWithTracing) DeleteAccount(ctx context.Context, cmd *DeleteAccountCommand) error {
+	ctx, span := tracer.StartSpan(ctx, "AuthUseCase.DeleteAccount")
+	defer span.End()
+
+	return t.wrapped.DeleteAccount(ctx, cmd)
+}
+
+func (t *authUseCaseWithTracing) RestoreAccount(ctx context.Context, cmd *RestoreAccountCommand) error {
+	ctx, span := tracer.StartSpan(ctx, "AuthUseCase.RestoreAccount")
+	defer span.End()
+
+	return t.wrapped.RestoreAccount(ctx, cmd)
+}
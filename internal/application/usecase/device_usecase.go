@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/dto"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"github.com/intezya/auth_service/pkg/clock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrUntrustedDevice   = status.Error(codes.PermissionDenied, "device is not trusted")
+	ErrLastTrustedDevice = status.Error(codes.FailedPrecondition, "last trusted device can't be removed")
+)
+
+type DeviceUseCase interface {
+	ListDevices(ctx context.Context, cmd *ListDevicesCommand) ([]*dto.DeviceDTO, error)
+	ApproveDevice(ctx context.Context, cmd *ApproveDeviceCommand) error
+	RemoveDevice(ctx context.Context, cmd *RemoveDeviceCommand) error
+}
+
+type ListDevicesCommand struct {
+	Token string
+}
+
+type ApproveDeviceCommand struct {
+	Token      string
+	HardwareID string // hardware id of the trusted device confirming the approval
+	DeviceID   int
+}
+
+type RemoveDeviceCommand struct {
+	Token    string
+	DeviceID int
+}
+
+type deviceUseCase struct {
+	accountRepository repository.AccountRepository
+	deviceRepository  repository.DeviceRepository
+	txManager         repository.TxManager
+
+	tokenManager      service.TokenManager
+	hardwareIDManager service.HardwareIDManager
+
+	clock clock.Clock
+}
+
+func NewDeviceUseCase(
+	accountRepository repository.AccountRepository,
+	deviceRepository repository.DeviceRepository,
+	txManager repository.TxManager,
+	tokenManager service.TokenManager,
+	hardwareIDManager service.HardwareIDManager,
+	clock clock.Clock,
+) DeviceUseCase {
+	return &deviceUseCase{
+		accountRepository: accountRepository,
+		deviceRepository:  deviceRepository,
+		txManager:         txManager,
+		tokenManager:      tokenManager,
+		hardwareIDManager: hardwareIDManager,
+		clock:             clock,
+	}
+}
+
+func (uc *deviceUseCase) ListDevices(ctx context.Context, cmd *ListDevicesCommand) ([]*dto.DeviceDTO, error) {
+	account, err := uc.authenticate(ctx, cmd.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := uc.deviceRepository.FindAllByAccountID(ctx, entity.AccountID(account.ID()))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.DeviceDTO, 0, len(devices))
+	for _, device := range devices {
+		result = append(
+			result, &dto.DeviceDTO{
+				ID:         device.ID(),
+				IsTrusted:  device.IsTrusted(),
+				CreatedAt:  device.CreatedAt(),
+				ApprovedAt: device.ApprovedAt(),
+				LastSeenAt: device.LastSeenAt(),
+			},
+		)
+	}
+
+	return result, nil
+}
+
+func (uc *deviceUseCase) ApproveDevice(ctx context.Context, cmd *ApproveDeviceCommand) error {
+	account, err := uc.authenticate(ctx, cmd.Token)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := uc.hardwareIDManager.FindTrustedDevice(ctx, account, cmd.HardwareID); !ok {
+		return ErrUntrustedDevice
+	}
+
+	return uc.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			device, err := uc.findAccountDevice(ctx, account, cmd.DeviceID)
+			if err != nil {
+				return err
+			}
+
+			if device.IsTrusted() {
+				return nil
+			}
+
+			if err := uc.hardwareIDManager.EnsureTrustedDeviceSlot(ctx, entity.AccountID(account.ID())); err != nil {
+				return err
+			}
+
+			device.Approve(uc.clock)
+
+			return uc.deviceRepository.Update(ctx, device)
+		},
+	)
+}
+
+func (uc *deviceUseCase) RemoveDevice(ctx context.Context, cmd *RemoveDeviceCommand) error {
+	account, err := uc.authenticate(ctx, cmd.Token)
+	if err != nil {
+		return err
+	}
+
+	return uc.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			device, err := uc.findAccountDevice(ctx, account, cmd.DeviceID)
+			if err != nil {
+				return err
+			}
+
+			// otherwise the owner (or a stolen token) would lock the account into pending approval
+			if device.IsTrusted() {
+				count, err := uc.deviceRepository.CountTrustedByAccountID(ctx, entity.AccountID(account.ID()))
+				if err != nil {
+					return err
+				}
+
+				if count <= 1 {
+					return ErrLastTrustedDevice
+				}
+			}
+
+			return uc.deviceRepository.Delete(ctx, entity.DeviceID(device.ID()))
+		},
+	)
+}
+
+func (uc *deviceUseCase) authenticate(ctx context.Context, token string) (*entity.Account, error) {
+	tokenData, err := uc.tokenManager.Parse(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	account, err := uc.accountRepository.FindByID(ctx, entity.AccountID(tokenData.Subject))
+	if err != nil {
+		return nil, err
+	}
+
+	if account.IsDeleted() {
+		return nil, entity.ErrAccountDeleted
+	}
+
+	return account, nil
+}
+
+func (uc *deviceUseCase) findAccountDevice(
+	ctx context.Context,
+	account *entity.Account,
+	deviceID int,
+) (*entity.Device, error) {
+	device, err := uc.deviceRepository.FindByID(ctx, entity.DeviceID(deviceID))
+	if err != nil {
+		return nil, err
+	}
+
+	if device.AccountID() != account.ID() {
+		return nil, status.Errorf(codes.NotFound, "device not found")
+	}
+
+	return device, nil
+}
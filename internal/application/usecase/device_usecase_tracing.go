@@ -0,0 +1,40 @@
+// Code generated by tracing-gen. DO NOT EDIT.
+
+package usecase
+
+import (
+	"context"
+	"github.com/intezya/auth_service/internal/domain/dto"
+	tracer "github.com/intezya/auth_service/pkg/tracer"
+)
+
+type deviceUseCaseWithTracing struct {
+	wrapped DeviceUseCase
+}
+
+func NewDeviceUseCaseWithTracing(wrapped DeviceUseCase) DeviceUseCase {
+	return &deviceUseCaseWithTracing{
+		wrapped: wrapped,
+	}
+}
+
+func (t *deviceUseCaseWithTracing) ListDevices(ctx context.Context, cmd *ListDevicesCommand) ([]*dto.DeviceDTO, error) {
+	ctx, span := tracer.StartSpan(ctx, "DeviceUseCase.ListDevices")
+	defer span.End()
+
+	return t.wrapped.ListDevices(ctx, cmd)
+}
+
+func (t *deviceUseCaseWithTracing) ApproveDevice(ctx context.Context, cmd *ApproveDeviceCommand) error {
+	ctx, span := tracer.StartSpan(ctx, "DeviceUseCase.ApproveDevice")
+	defer span.End()
+
+	return t.wrapped.ApproveDevice(ctx, cmd)
+}
+
+func (t *deviceUseCaseWithTracing) RemoveDevice(ctx context.Context, cmd *RemoveDeviceCommand) error {
+	ctx, span := tracer.StartSpan(ctx, "DeviceUseCase.RemoveDevice")
+	defer span.End()
+
+	return t.wrapped.RemoveDevice(ctx, cmd)
+}
@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"github.com/intezya/auth_service/pkg/clock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+const (
+	verificationCodeDigits = 6
+	maxEmailLength         = 254
+)
+
+var (
+	ErrInvalidEmail                = status.Error(codes.InvalidArgument, "invalid email")
+	ErrInvalidVerificationCode     = status.Error(codes.InvalidArgument, "invalid verification code")
+	ErrNoPendingEmailVerification  = status.Error(codes.FailedPrecondition, "no pending email verification")
+	ErrVerificationCodeExpired     = status.Error(codes.FailedPrecondition, "verification code expired")
+	ErrTooManyVerificationAttempts = status.Error(
+		codes.ResourceExhausted,
+		"too many verification attempts, request a new code after the current one expires",
+	)
+	ErrVerificationResendTooSoon = status.Error(
+		codes.ResourceExhausted,
+		"verification code was sent recently, try again later",
+	)
+	ErrVerificationMailNotSent = status.Error(codes.Unavailable, "failed to send verification email")
+)
+
+type EmailVerificationConfig struct {
+	CodeTTL time.Duration `env:"EMAIL_VERIFICATION_CODE_TTL" env-default:"15m"`
+	// MaxAttempts is how many wrong codes can be entered before a new code must be requested,
+	// codes re-issued while the previous one is valid share its attempts.
+	MaxAttempts int `env:"EMAIL_VERIFICATION_MAX_ATTEMPTS" env-default:"5"`
+	// ResendCooldown is minimal time between codes sent for one account.
+	ResendCooldown time.Duration `env:"EMAIL_VERIFICATION_RESEND_COOLDOWN" env-default:"1m"`
+}
+
+type EmailUseCase interface {
+	// AddEmail sends verification code to email, account email is replaced only after VerifyEmail.
+	AddEmail(ctx context.Context, cmd *AddEmailCommand) error
+	VerifyEmail(ctx context.Context, cmd *VerifyEmailCommand) error
+}
+
+type AddEmailCommand struct {
+	Token string
+	Email string
+}
+
+type VerifyEmailCommand struct {
+	Token string
+	Code  string
+}
+
+type emailUseCase struct {
+	accountRepository           repository.AccountRepository
+	emailVerificationRepository repository.EmailVerificationRepository
+	auditRepository             repository.AuditRepository
+	txManager                   repository.TxManager
+
+	tokenManager service.TokenManager
+	mailer       service.Mailer
+
+	config EmailVerificationConfig
+
+	clock clock.Clock
+}
+
+func NewEmailUseCase(
+	accountRepository repository.AccountRepository,
+	emailVerificationRepository repository.EmailVerificationRepository,
+	auditRepository repository.AuditRepository,
+	txManager repository.TxManager,
+	tokenManager service.TokenManager,
+	mailer service.Mailer,
+	config EmailVerificationConfig,
+	clock clock.Clock,
+) EmailUseCase {
+	return &emailUseCase{
+		accountRepository:           accountRepository,
+		emailVerificationRepository: emailVerificationRepository,
+		auditRepository:             auditRepository,
+		txManager:                   txManager,
+		tokenManager:                tokenManager,
+		mailer:                      mailer,
+		config:                      config,
+		clock:                       clock,
+	}
+}
+
+func (uc *emailUseCase) AddEmail(ctx context.Context, cmd *AddEmailCommand) error {
+	email, err := parseEmail(cmd.Email)
+	if err != nil {
+		return err
+	}
+
+	tokenData, err := uc.tokenManager.Parse(cmd.Token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	code, err := generateVerificationCode()
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to generate verification code: %v", err)
+	}
+
+	err = uc.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			account, err := uc.activeAccount(ctx, entity.AccountID(tokenData.Subject))
+			if err != nil {
+				return err
+			}
+
+			if account.HasEmail(email) {
+				return entity.ErrEmailUnchanged
+			}
+
+			exists, err := uc.accountRepository.ExistsByEmail(ctx, email)
+			if err != nil {
+				return err
+			}
+
+			if exists {
+				return repository.ErrEmailAlreadyUsed
+			}
+
+			accountID := entity.AccountID(account.ID())
+			verification := entity.NewEmailVerification(accountID, email, code, uc.config.CodeTTL, uc.clock)
+
+			previous, err := uc.emailVerificationRepository.FindLatestByAccountID(ctx, accountID)
+
+			switch {
+			case err == nil:
+				if !previous.IsResendAllowed(uc.config.ResendCooldown, uc.clock) {
+					return ErrVerificationResendTooSoon
+				}
+
+				verification.InheritAttempts(previous, uc.clock)
+			case status.Code(err) != codes.NotFound:
+				return err
+			}
+
+			// only the latest code is valid
+			if err := uc.emailVerificationRepository.DeleteAllByAccountID(ctx, accountID); err != nil {
+				return err
+			}
+
+			_, err = uc.emailVerificationRepository.Create(ctx, verification)
+
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// mail is sent after commit, so the code in the mailbox always exists in database
+	err = uc.mailer.Send(
+		ctx, service.Mail{
+			To:      string(email),
+			Subject: "Email verification code",
+			Body: fmt.Sprintf(
+				"Your verification code is %s.\nIt expires in %s.\n\nIf you did not request it, ignore this email.\n",
+				code,
+				uc.config.CodeTTL,
+			),
+		},
+	)
+	if err != nil {
+		return ErrVerificationMailNotSent
+	}
+
+	return nil
+}
+
+func (uc *emailUseCase) VerifyEmail(ctx context.Context, cmd *VerifyEmailCommand) error {
+	tokenData, err := uc.tokenManager.Parse(cmd.Token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	// wrong code is reported after commit, so the failed attempt is counted
+	var codeErr error
+
+	err = uc.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			codeErr = nil
+
+			account, err := uc.activeAccount(ctx, entity.AccountID(tokenData.Subject))
+			if err != nil {
+				return err
+			}
+
+			accountID := entity.AccountID(account.ID())
+
+			verification, err := uc.emailVerificationRepository.FindLatestByAccountID(ctx, accountID)
+			if status.Code(err) == codes.NotFound {
+				return ErrNoPendingEmailVerification
+			}
+
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case verification.IsExpired(uc.clock):
+				return ErrVerificationCodeExpired
+			case verification.Attempts() >= uc.config.MaxAttempts:
+				return ErrTooManyVerificationAttempts
+			}
+
+			if !verification.Matches(strings.TrimSpace(cmd.Code)) {
+				verification.RegisterFailedAttempt()
+				codeErr = ErrInvalidVerificationCode
+
+				return uc.emailVerificationRepository.Update(ctx, verification)
+			}
+
+			account.SetVerifiedEmail(entity.Email(verification.Email()), uc.clock)
+
+			// email verified by another account meanwhile violates unique index: repository.ErrEmailAlreadyUsed
+			if err := uc.accountRepository.Update(ctx, account); err != nil {
+				return err
+			}
+
+			if err := uc.emailVerificationRepository.DeleteAllByAccountID(ctx, accountID); err != nil {
+				return err
+			}
+
+			// email itself is not written to audit log, it is erased together with account
+			_, err = uc.auditRepository.Create(
+				ctx,
+				entity.NewAuditEntry(accountID, entity.AuditActionEmailVerified, entity.AuditActorSubject, nil, uc.clock),
+			)
+
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return codeErr
+}
+
+func (uc *emailUseCase) activeAccount(ctx context.Context, id entity.AccountID) (*entity.Account, error) {
+	account, err := uc.accountRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.IsDeleted() {
+		return nil, entity.ErrAccountDeleted
+	}
+
+	return account, nil
+}
+
+// parseEmail accepts bare address only, display names ("Name <address>") are rejected.
+func parseEmail(value string) (entity.Email, error) {
+	if len(value) > maxEmailLength {
+		return "", ErrInvalidEmail
+	}
+
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Name != "" || address.Address != value {
+		return "", ErrInvalidEmail
+	}
+
+	return entity.Email(address.Address), nil
+}
+
+func generateVerificationCode() (string, error) {
+	limit := big.NewInt(1)
+	for range verificationCodeDigits {
+		limit.Mul(limit, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", verificationCodeDigits, n), nil
+}
@@ -0,0 +1,32 @@
+// Code generated by tracing-gen. DO NOT EDIT.
+
+package usecase
+
+import (
+	"context"
+	tracer "github.com/intezya/auth_service/pkg/tracer"
+)
+
+type emailUseCaseWithTracing struct {
+	wrapped EmailUseCase
+}
+
+func NewEmailUseCaseWithTracing(wrapped EmailUseCase) EmailUseCase {
+	return &emailUseCaseWithTracing{
+		wrapped: wrapped,
+	}
+}
+
+func (t *emailUseCaseWithTracing) AddEmail(ctx context.Context, cmd *AddEmailCommand) error {
+	ctx, span := tracer.StartSpan(ctx, "EmailUseCase.AddEmail")
+	defer span.End()
+
+	return t.wrapped.AddEmail(ctx, cmd)
+}
+
+func (t *emailUseCaseWithTracing) VerifyEmail(ctx context.Context, cmd *VerifyEmailCommand) error {
+	ctx, span := tracer.StartSpan(ctx, "EmailUseCase.VerifyEmail")
+	defer span.End()
+
+	return t.wrapped.VerifyEmail(ctx, cmd)
+}
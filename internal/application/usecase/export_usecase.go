@@ -0,0 +1,391 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/dto"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"github.com/intezya/auth_service/pkg/clock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"strconv"
+	"time"
+)
+
+const (
+	accountExportFormatVersion = 1
+	accountExportSignature     = "HMAC-SHA256"
+	accountExportDataFile      = "account_data.json"
+	accountExportSignatureFile = accountExportDataFile + ".sig"
+)
+
+var (
+	ErrUnsupportedExportFormat = status.Error(codes.InvalidArgument, "unsupported export format")
+	ErrExportNotConfigured     = status.Error(
+		codes.FailedPrecondition,
+		"account data export is disabled: ACCOUNT_EXPORT_SIGNING_KEY is not set",
+	)
+)
+
+type AccountExportConfig struct {
+	// SigningKey is HMAC-SHA256 key used to sign export bundles, so their integrity can be verified later.
+	// Export is disabled without it, the rest of the service works as usual.
+	SigningKey string `env:"ACCOUNT_EXPORT_SIGNING_KEY"`
+}
+
+type ExportFormat string
+
+const (
+	ExportFormatJSON ExportFormat = "json"
+	ExportFormatZIP  ExportFormat = "zip"
+)
+
+type ExportUseCase interface {
+	// ExportAccountData collects personal data of account into signed bundle and records the export in audit log.
+	ExportAccountData(ctx context.Context, cmd *ExportAccountDataCommand) (*ExportAccountDataResult, error)
+}
+
+type ExportAccountDataCommand struct {
+	// Token of the subject or of caller with admin access level.
+	// Only trusted in-process callers (cmd/export) omit it, they set Actor instead.
+	Token     string
+	AccountID int
+	Format    ExportFormat // json by default
+	Actor     entity.AuditActor
+}
+
+type ExportAccountDataResult struct {
+	Bundle      []byte
+	ContentType string
+	FileName    string
+}
+
+// signedAccountExport is json bundle: signature is computed over exact bytes of payload.
+type signedAccountExport struct {
+	Payload   json.RawMessage `json:"payload"`
+	Signature struct {
+		Algorithm string `json:"algorithm"`
+		Value     string `json:"value"` // hex
+	} `json:"signature"`
+}
+
+type exportUseCase struct {
+	accountRepository        repository.AccountRepository
+	deviceRepository         repository.DeviceRepository
+	auditRepository          repository.AuditRepository
+	usernameChangeRepository repository.UsernameChangeRepository
+	loginAttemptRepository   repository.LoginAttemptRepository
+	txManager                repository.TxManager
+
+	tokenManager service.TokenManager
+
+	signingKey []byte
+
+	clock clock.Clock
+}
+
+func NewExportUseCase(
+	accountRepository repository.AccountRepository,
+	deviceRepository repository.DeviceRepository,
+	auditRepository repository.AuditRepository,
+	usernameChangeRepository repository.UsernameChangeRepository,
+	loginAttemptRepository repository.LoginAttemptRepository,
+	txManager repository.TxManager,
+	tokenManager service.TokenManager,
+	config AccountExportConfig,
+	clock clock.Clock,
+) ExportUseCase {
+	return &exportUseCase{
+		accountRepository:        accountRepository,
+		deviceRepository:         deviceRepository,
+		auditRepository:          auditRepository,
+		usernameChangeRepository: usernameChangeRepository,
+		loginAttemptRepository:   loginAttemptRepository,
+		txManager:                txManager,
+		tokenManager:             tokenManager,
+		signingKey:               []byte(config.SigningKey),
+		clock:                    clock,
+	}
+}
+
+func (uc *exportUseCase) ExportAccountData(
+	ctx context.Context,
+	cmd *ExportAccountDataCommand,
+) (*ExportAccountDataResult, error) {
+	format := cmd.Format
+	if format == "" {
+		format = ExportFormatJSON
+	}
+
+	if format != ExportFormatJSON && format != ExportFormatZIP {
+		return nil, ErrUnsupportedExportFormat
+	}
+
+	// unsigned bundle couldn't be verified later, export is refused instead
+	if len(uc.signingKey) == 0 {
+		return nil, ErrExportNotConfigured
+	}
+
+	actor, metadata, err := uc.authorize(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata["format"] = string(format)
+
+	var result *ExportAccountDataResult
+
+	// audit entry is written only together with successfully built bundle
+	err = uc.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			export, err := uc.collect(ctx, entity.AccountID(cmd.AccountID))
+			if err != nil {
+				return err
+			}
+
+			result, err = uc.bundle(export, format)
+			if err != nil {
+				return err
+			}
+
+			_, err = uc.auditRepository.Create(
+				ctx,
+				entity.NewAuditEntry(
+					entity.AccountID(cmd.AccountID),
+					entity.AuditActionDataExported,
+					actor,
+					metadata,
+					uc.clock,
+				),
+			)
+
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// authorize returns audit actor of the export: subject exports own data, anybody else needs admin access level.
+// Commands without token come from trusted in-process callers.
+func (uc *exportUseCase) authorize(
+	ctx context.Context,
+	cmd *ExportAccountDataCommand,
+) (entity.AuditActor, map[string]string, error) {
+	if cmd.Token == "" {
+		if cmd.Actor == "" {
+			return "", nil, status.Error(codes.Unauthenticated, "token is required")
+		}
+
+		return cmd.Actor, map[string]string{}, nil
+	}
+
+	tokenData, err := uc.tokenManager.Parse(cmd.Token)
+	if err != nil {
+		return "", nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	caller, err := uc.accountRepository.FindByID(ctx, entity.AccountID(tokenData.Subject))
+	if err != nil {
+		return "", nil, err
+	}
+
+	if caller.IsDeleted() {
+		return "", nil, entity.ErrAccountDeleted
+	}
+
+	if caller.ID() == cmd.AccountID {
+		return entity.AuditActorSubject, map[string]string{}, nil
+	}
+
+	if !caller.HasAccessLevel(entity.AccessLevelAdmin) {
+		return "", nil, ErrInsufficientAccessLevel
+	}
+
+	return entity.AuditActorOperator, map[string]string{"operator_id": strconv.Itoa(caller.ID())}, nil
+}
+
+func (uc *exportUseCase) collect(ctx context.Context, accountID entity.AccountID) (*dto.AccountExportDTO, error) {
+	account, err := uc.accountRepository.FindByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.IsErased() {
+		return nil, entity.ErrAccountErased
+	}
+
+	devices, err := uc.deviceRepository.FindAllByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	auditEntries, err := uc.auditRepository.FindAllByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	usernameChanges, err := uc.usernameChangeRepository.FindAllByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// login history is bounded by retention, so it is read in one go
+	loginAttempts, err := uc.loginAttemptRepository.FindAllByAccountID(ctx, accountID, nil, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &dto.AccountExportDTO{
+		FormatVersion: accountExportFormatVersion,
+		GeneratedAt:   uc.clock.Now().UTC(),
+		Account: dto.AccountExportAccount{
+			ID:                account.ID(),
+			Username:          account.Username(),
+			Password:          dto.Redacted,
+			AccessLevel:       entity.AccessLevel(account.AccessLevel()).String(),
+			CreatedAt:         account.CreatedAt(),
+			BannedUntil:       account.BannedUntil(),
+			BanReason:         account.BanReason(),
+			DeletedAt:         account.DeletedAt(),
+			UsernameChangedAt: account.UsernameChangedAt(),
+			Email:             account.Email(),
+			EmailVerifiedAt:   account.EmailVerifiedAt(),
+			LastLoginAt:       account.LastLoginAt(),
+		},
+		BanHistory:      make([]dto.AuditEntryDTO, 0),
+		UsernameHistory: make([]dto.UsernameChangeDTO, 0, len(usernameChanges)),
+		Devices:         make([]dto.AccountExportDevice, 0, len(devices)),
+		LoginHistory:    make([]dto.LoginAttemptDTO, 0, len(loginAttempts)),
+		AuditLog:        make([]dto.AuditEntryDTO, 0, len(auditEntries)),
+	}
+
+	if account.HardwareID() != nil {
+		redacted := dto.Redacted
+		export.Account.HardwareID = &redacted
+	}
+
+	for _, device := range devices {
+		export.Devices = append(
+			export.Devices, dto.AccountExportDevice{
+				ID:         device.ID(),
+				HardwareID: dto.Redacted,
+				Status:     device.Status().String(),
+				CreatedAt:  device.CreatedAt(),
+				ApprovedAt: device.ApprovedAt(),
+				LastSeenAt: device.LastSeenAt(),
+			},
+		)
+	}
+
+	for _, attempt := range loginAttempts {
+		export.LoginHistory = append(export.LoginHistory, *loginAttemptToDTO(attempt))
+	}
+
+	for _, change := range usernameChanges {
+		export.UsernameHistory = append(
+			export.UsernameHistory, dto.UsernameChangeDTO{
+				PreviousUsername: change.Username(),
+				ChangedAt:        change.ChangedAt(),
+			},
+		)
+	}
+
+	for _, auditEntry := range auditEntries {
+		entry := dto.AuditEntryDTO{
+			Action:    string(auditEntry.Action()),
+			Actor:     string(auditEntry.Actor()),
+			Details:   auditEntry.Details(),
+			CreatedAt: auditEntry.CreatedAt(),
+		}
+
+		export.AuditLog = append(export.AuditLog, entry)
+
+		if auditEntry.Action() == entity.AuditActionBanned || auditEntry.Action() == entity.AuditActionUnbanned {
+			export.BanHistory = append(export.BanHistory, entry)
+		}
+	}
+
+	return export, nil
+}
+
+func (uc *exportUseCase) bundle(export *dto.AccountExportDTO, format ExportFormat) (*ExportAccountDataResult, error) {
+	payload, err := json.Marshal(export)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode account data: %v", err)
+	}
+
+	signature := uc.sign(payload)
+	fileName := "account-" + strconv.Itoa(export.Account.ID) + "-" + export.GeneratedAt.Format("20060102T150405Z")
+
+	if format == ExportFormatZIP {
+		archive, err := zipBundle(payload, signature, export.GeneratedAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build export archive: %v", err)
+		}
+
+		return &ExportAccountDataResult{Bundle: archive, ContentType: "application/zip", FileName: fileName + ".zip"}, nil
+	}
+
+	signed := signedAccountExport{Payload: payload}
+	signed.Signature.Algorithm = accountExportSignature
+	signed.Signature.Value = signature
+
+	bundle, err := json.Marshal(signed)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode account data: %v", err)
+	}
+
+	return &ExportAccountDataResult{Bundle: bundle, ContentType: "application/json", FileName: fileName + ".json"}, nil
+}
+
+func (uc *exportUseCase) sign(payload []byte) string {
+	mac := hmac.New(sha256.New, uc.signingKey)
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// zipBundle packs payload and its detached signature ("<algorithm> <hex>").
+func zipBundle(payload []byte, signature string, modified time.Time) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	archive := zip.NewWriter(&buffer)
+
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{accountExportDataFile, payload},
+		{accountExportSignatureFile, []byte(fmt.Sprintf("%s %s\n", accountExportSignature, signature))},
+	}
+
+	for _, file := range files {
+		writer, err := archive.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := writer.Write(file.content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"github.com/intezya/auth_service/pkg/clock"
+	"github.com/intezya/auth_service/pkg/defaults"
+	"sync/atomic"
+	"time"
+
+	"github.com/intezya/pkglib/logger"
+)
+
+const (
+	defaultLoginHistoryBufferSize    = 4096
+	defaultLoginHistoryBatchSize     = 256
+	defaultLoginHistoryFlushInterval = time.Second
+	defaultLoginHistoryPruneInterval = time.Hour
+	loginHistoryPruneBatchSize       = 1000
+)
+
+type LoginHistoryConfig struct {
+	// BufferSize is how many attempts wait for writing, attempts over it are dropped instead of slowing login down.
+	BufferSize    int           `env:"LOGIN_HISTORY_BUFFER_SIZE" env-default:"4096"`
+	BatchSize     int           `env:"LOGIN_HISTORY_BATCH_SIZE" env-default:"256"`
+	FlushInterval time.Duration `env:"LOGIN_HISTORY_FLUSH_INTERVAL" env-default:"1s"`
+
+	// Retention is how long login attempts are kept.
+	Retention     time.Duration `env:"LOGIN_HISTORY_RETENTION" env-default:"2160h"`
+	PruneInterval time.Duration `env:"LOGIN_HISTORY_PRUNE_INTERVAL" env-default:"1h"`
+}
+
+// LoginHistoryRecorder buffers login attempts in memory and writes them in batches together
+// with last login time of accounts.
+type LoginHistoryRecorder struct {
+	accountRepository      repository.AccountRepository
+	loginAttemptRepository repository.LoginAttemptRepository
+	txManager              repository.TxManager
+
+	attempts      chan *entity.LoginAttempt
+	batchSize     int
+	flushInterval time.Duration
+	dropped       atomic.Int64
+}
+
+func NewLoginHistoryRecorder(
+	accountRepository repository.AccountRepository,
+	loginAttemptRepository repository.LoginAttemptRepository,
+	txManager repository.TxManager,
+	config LoginHistoryConfig,
+) *LoginHistoryRecorder {
+	return &LoginHistoryRecorder{
+		accountRepository:      accountRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		txManager:              txManager,
+		attempts:               make(chan *entity.LoginAttempt, defaults.Positive(config.BufferSize, defaultLoginHistoryBufferSize)),
+		batchSize:              defaults.Positive(config.BatchSize, defaultLoginHistoryBatchSize),
+		flushInterval:          defaults.Positive(config.FlushInterval, defaultLoginHistoryFlushInterval),
+	}
+}
+
+var _ service.LoginRecorder = (*LoginHistoryRecorder)(nil)
+
+func (r *LoginHistoryRecorder) Record(attempt *entity.LoginAttempt) {
+	select {
+	case r.attempts <- attempt:
+	default:
+		r.dropped.Add(1)
+	}
+}
+
+// Run writes buffered attempts every config.FlushInterval or as soon as batch is full, until ctx is done.
+// Attempts recorded after that are written by Flush.
+func (r *LoginHistoryRecorder) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*entity.LoginAttempt, 0, r.batchSize)
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.write(context.WithoutCancel(ctx), batch)
+
+			return
+		case attempt := <-r.attempts:
+			batch = append(batch, attempt)
+			if len(batch) < r.batchSize {
+				continue
+			}
+		case <-ticker.C:
+			if dropped := r.dropped.Swap(0); dropped > 0 {
+				logger.Log.Warnf("Login history buffer is full: %d attempts dropped", dropped)
+			}
+		}
+
+		r.write(ctx, batch)
+		batch = batch[:0]
+	}
+}
+
+// Flush writes all buffered attempts, it is called on shutdown after Run is stopped.
+func (r *LoginHistoryRecorder) Flush(ctx context.Context) {
+	batch := make([]*entity.LoginAttempt, 0, r.batchSize)
+
+	for {
+		select {
+		case attempt := <-r.attempts:
+			batch = append(batch, attempt)
+			if len(batch) < r.batchSize {
+				continue
+			}
+
+			r.write(ctx, batch)
+			batch = batch[:0]
+		default:
+			r.write(ctx, batch)
+
+			return
+		}
+	}
+}
+
+func (r *LoginHistoryRecorder) write(ctx context.Context, batch []*entity.LoginAttempt) {
+	if len(batch) == 0 {
+		return
+	}
+
+	if lost, err := r.writeSplitting(ctx, batch); err != nil {
+		logger.Log.Warnf("Failed to write login history: %d of %d attempts lost: %v", lost, len(batch), err)
+	}
+}
+
+// writeSplitting writes halves of failed batch separately, so one bad attempt (e.g. of account
+// deleted meanwhile) doesn't discard the others. Returns count of attempts which weren't written
+// and the last error.
+func (r *LoginHistoryRecorder) writeSplitting(ctx context.Context, batch []*entity.LoginAttempt) (int, error) {
+	err := r.writeBatch(ctx, batch)
+
+	switch {
+	case err == nil:
+		return 0, nil
+	case len(batch) == 1 || ctx.Err() != nil:
+		return len(batch), err
+	}
+
+	middle := len(batch) / 2 //nolint:mnd
+
+	lostFirst, errFirst := r.writeSplitting(ctx, batch[:middle])
+	lostSecond, errSecond := r.writeSplitting(ctx, batch[middle:])
+
+	if errSecond == nil {
+		errSecond = errFirst
+	}
+
+	return lostFirst + lostSecond, errSecond
+}
+
+func (r *LoginHistoryRecorder) writeBatch(ctx context.Context, batch []*entity.LoginAttempt) error {
+	lastLogins := make(map[entity.AccountID]time.Time)
+	for _, attempt := range batch {
+		id := entity.AccountID(attempt.AccountID())
+		if attempt.IsSuccessful() && attempt.CreatedAt().After(lastLogins[id]) {
+			lastLogins[id] = attempt.CreatedAt()
+		}
+	}
+
+	return r.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			if err := r.loginAttemptRepository.CreateBulk(ctx, batch); err != nil {
+				return err
+			}
+
+			for id, at := range lastLogins {
+				if err := r.accountRepository.UpdateLastLoginAt(ctx, id, at); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+}
+
+// LoginHistoryPruneJob deletes login attempts older than retention period.
+type LoginHistoryPruneJob struct {
+	loginAttemptRepository repository.LoginAttemptRepository
+	config                 LoginHistoryConfig
+	clock                  clock.Clock
+}
+
+func NewLoginHistoryPruneJob(
+	loginAttemptRepository repository.LoginAttemptRepository,
+	config LoginHistoryConfig,
+	clock clock.Clock,
+) *LoginHistoryPruneJob {
+	return &LoginHistoryPruneJob{
+		loginAttemptRepository: loginAttemptRepository,
+		config:                 config,
+		clock:                  clock,
+	}
+}
+
+// Run prunes login history every config.PruneInterval until ctx is done.
+func (j *LoginHistoryPruneJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(defaults.Positive(j.config.PruneInterval, defaultLoginHistoryPruneInterval))
+	defer ticker.Stop()
+
+	for {
+		pruned, err := j.Prune(ctx)
+		if err != nil {
+			logger.Log.Warnf("Login history pruning failed: %v", err)
+		} else if pruned > 0 {
+			logger.Log.Infof("Login history pruning completed: %d attempts deleted", pruned)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune deletes expired login attempts in batches and returns their count.
+func (j *LoginHistoryPruneJob) Prune(ctx context.Context) (int, error) {
+	pruned := 0
+	before := j.clock.Now().Add(-j.config.Retention)
+
+	for {
+		deleted, err := j.loginAttemptRepository.DeleteAllCreatedBefore(ctx, before, loginHistoryPruneBatchSize)
+		pruned += deleted
+
+		if err != nil || deleted < loginHistoryPruneBatchSize {
+			return pruned, err
+		}
+	}
+}
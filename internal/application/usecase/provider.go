@@ -4,10 +4,15 @@ import (
 	"github.com/intezya/auth_service/internal/domain/service"
 	"github.com/intezya/auth_service/internal/infrastructure/persistence"
 	"github.com/intezya/auth_service/internal/pkg/validator"
+	"github.com/intezya/auth_service/pkg/clock"
 )
 
 type Provider struct {
-	AuthUseCase AuthUseCase
+	AuthUseCase    AuthUseCase
+	DeviceUseCase  DeviceUseCase
+	ExportUseCase  ExportUseCase
+	AccountUseCase AccountUseCase
+	EmailUseCase   EmailUseCase
 }
 
 func NewProvider(
@@ -16,16 +21,69 @@ func NewProvider(
 	passwordEncoder service.PasswordEncoder,
 	tokenManager service.TokenManager,
 	hardwareIDManager service.HardwareIDManager,
+	usernameManager service.UsernameManager,
+	loginRecorder service.LoginRecorder,
+	riskEngine service.RiskEngine,
+	exportConfig AccountExportConfig,
+	lookupConfig AccountLookupConfig,
+	mailer service.Mailer,
+	emailVerificationConfig EmailVerificationConfig,
 ) *Provider {
 	return &Provider{
 		AuthUseCase: NewAuthUseCase(
 			repositoryProvider.AccountRepository,
+			repositoryProvider.AuditRepository,
+			repositoryProvider.TxManager,
 			passwordEncoder,
 			tokenManager,
 			hardwareIDManager,
+			usernameManager,
+			loginRecorder,
+			riskEngine,
 			validatorProvider.UsernameValidator,
 			validatorProvider.PasswordValidator,
 			validatorProvider.HardwareValidator,
+			clock.NewRealClock(),
+		),
+		DeviceUseCase: NewDeviceUseCase(
+			repositoryProvider.AccountRepository,
+			repositoryProvider.DeviceRepository,
+			repositoryProvider.TxManager,
+			tokenManager,
+			hardwareIDManager,
+			clock.NewRealClock(),
+		),
+		ExportUseCase: NewExportUseCase(
+			repositoryProvider.AccountRepository,
+			repositoryProvider.DeviceRepository,
+			repositoryProvider.AuditRepository,
+			repositoryProvider.UsernameChangeRepository,
+			repositoryProvider.LoginAttemptRepository,
+			repositoryProvider.TxManager,
+			tokenManager,
+			exportConfig,
+			clock.NewRealClock(),
+		),
+		AccountUseCase: NewAccountUseCase(
+			repositoryProvider.AccountRepository,
+			repositoryProvider.AuditRepository,
+			repositoryProvider.LoginAttemptRepository,
+			repositoryProvider.TxManager,
+			tokenManager,
+			usernameManager,
+			validatorProvider.UsernameValidator,
+			lookupConfig,
+			clock.NewRealClock(),
+		),
+		EmailUseCase: NewEmailUseCase(
+			repositoryProvider.AccountRepository,
+			repositoryProvider.EmailVerificationRepository,
+			repositoryProvider.AuditRepository,
+			repositoryProvider.TxManager,
+			tokenManager,
+			mailer,
+			emailVerificationConfig,
+			clock.NewRealClock(),
 		),
 	}
 }
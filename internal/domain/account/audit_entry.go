@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"github.com/intezya/auth_service/pkg/clock"
+	"time"
+)
+
+type AuditEntryID int
+
+type AuditAction string
+
+const (
+	AuditActionBanned          AuditAction = "account.banned"
+	AuditActionUnbanned        AuditAction = "account.unbanned"
+	AuditActionDeleted         AuditAction = "account.deleted"
+	AuditActionRestored        AuditAction = "account.restored"
+	AuditActionErased          AuditAction = "account.erased"
+	AuditActionDataExported    AuditAction = "account.data_exported"
+	AuditActionUsernameChanged AuditAction = "account.username_changed"
+	AuditActionEmailVerified   AuditAction = "account.email_verified"
+)
+
+type AuditActor string
+
+const (
+	AuditActorSubject  AuditActor = "subject"  // account owner
+	AuditActorOperator AuditActor = "operator" // internal services and admin tools
+	AuditActorSystem   AuditActor = "system"   // background jobs
+)
+
+// AuditEntry is an immutable record of action done to account.
+type AuditEntry struct {
+	id        AuditEntryID
+	accountID AccountID
+	action    AuditAction
+	actor     AuditActor
+	details   map[string]string
+	createdAt time.Time
+}
+
+func NewAuditEntry(
+	accountID AccountID,
+	action AuditAction,
+	actor AuditActor,
+	details map[string]string,
+	clock clock.Clock,
+) *AuditEntry {
+	return &AuditEntry{
+		accountID: accountID,
+		action:    action,
+		actor:     actor,
+		details:   details,
+		createdAt: clock.Now(),
+	}
+}
+
+func NewAuditEntryFromRepository(
+	id AuditEntryID,
+	accountID AccountID,
+	action AuditAction,
+	actor AuditActor,
+	details map[string]string,
+	createdAt time.Time,
+) *AuditEntry {
+	return &AuditEntry{
+		id:        id,
+		accountID: accountID,
+		action:    action,
+		actor:     actor,
+		details:   details,
+		createdAt: createdAt,
+	}
+}
+
+func (e *AuditEntry) ID() int                    { return int(e.id) }
+func (e *AuditEntry) AccountID() int             { return int(e.accountID) }
+func (e *AuditEntry) Action() AuditAction        { return e.action }
+func (e *AuditEntry) Actor() AuditActor          { return e.actor }
+func (e *AuditEntry) Details() map[string]string { return e.details }
+func (e *AuditEntry) CreatedAt() time.Time       { return e.createdAt }
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"github.com/intezya/auth_service/pkg/clock"
+	"time"
+)
+
+type Device struct {
+	id               DeviceID
+	accountID        AccountID
+	hardwareID       HardwareID
+	hardwareIDDigest HardwareIDDigest
+	status           DeviceStatus
+	createdAt        time.Time
+	approvedAt       *time.Time
+	lastSeenAt       *time.Time
+}
+
+func NewDevice(
+	accountID AccountID,
+	hardwareID HardwareID,
+	hardwareIDDigest HardwareIDDigest,
+	status DeviceStatus,
+	clock clock.Clock,
+) *Device {
+	now := clock.Now()
+
+	device := &Device{
+		accountID:        accountID,
+		hardwareID:       hardwareID,
+		hardwareIDDigest: hardwareIDDigest,
+		status:           status,
+		createdAt:        now,
+		approvedAt:       nil,
+		lastSeenAt:       &now,
+	}
+
+	if status == DeviceStatusTrusted {
+		device.approvedAt = &now
+	}
+
+	return device
+}
+
+func NewDeviceFromRepository(
+	id DeviceID,
+	accountID AccountID,
+	hardwareID HardwareID,
+	hardwareIDDigest HardwareIDDigest,
+	status DeviceStatus,
+	createdAt time.Time,
+	approvedAt *time.Time,
+	lastSeenAt *time.Time,
+) *Device {
+	return &Device{
+		id:               id,
+		accountID:        accountID,
+		hardwareID:       hardwareID,
+		hardwareIDDigest: hardwareIDDigest,
+		status:           status,
+		createdAt:        createdAt,
+		approvedAt:       approvedAt,
+		lastSeenAt:       lastSeenAt,
+	}
+}
+
+func (d *Device) ID() int                  { return int(d.id) }
+func (d *Device) AccountID() int           { return int(d.accountID) }
+func (d *Device) HardwareID() string       { return string(d.hardwareID) }
+func (d *Device) HardwareIDDigest() string { return string(d.hardwareIDDigest) }
+func (d *Device) Status() DeviceStatus     { return d.status }
+func (d *Device) CreatedAt() time.Time     { return d.createdAt }
+func (d *Device) ApprovedAt() *time.Time   { return d.approvedAt }
+func (d *Device) LastSeenAt() *time.Time   { return d.lastSeenAt }
+
+func (d *Device) IsTrusted() bool {
+	return d.status == DeviceStatusTrusted
+}
+
+// IsApprovalDue reports whether pending device waited long enough to be approved without confirmation.
+func (d *Device) IsApprovalDue(cooldown time.Duration, clock clock.Clock) bool {
+	return !clock.Now().Before(d.createdAt.Add(cooldown))
+}
+
+func (d *Device) Approve(clock clock.Clock) {
+	now := clock.Now()
+	d.status = DeviceStatusTrusted
+	d.approvedAt = &now
+}
+
+func (d *Device) Touch(clock clock.Clock) {
+	now := clock.Now()
+	d.lastSeenAt = &now
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"github.com/intezya/auth_service/pkg/clock"
+	"strconv"
+	"time"
+)
+
+type EmailVerificationID int
+
+// EmailVerification is email added to account which is not confirmed yet. Only digest of the code is stored,
+// the code itself is known to the owner of the mailbox.
+type EmailVerification struct {
+	id         EmailVerificationID
+	accountID  AccountID
+	email      Email
+	codeDigest string
+	attempts   int
+	expiresAt  time.Time
+	createdAt  time.Time
+}
+
+func NewEmailVerification(
+	accountID AccountID,
+	email Email,
+	code string,
+	ttl time.Duration,
+	clock clock.Clock,
+) *EmailVerification {
+	now := clock.Now()
+
+	return &EmailVerification{
+		accountID:  accountID,
+		email:      email,
+		codeDigest: verificationCodeDigest(accountID, email, code),
+		expiresAt:  now.Add(ttl),
+		createdAt:  now,
+	}
+}
+
+func NewEmailVerificationFromRepository(
+	id EmailVerificationID,
+	accountID AccountID,
+	email Email,
+	codeDigest string,
+	attempts int,
+	expiresAt time.Time,
+	createdAt time.Time,
+) *EmailVerification {
+	return &EmailVerification{
+		id:         id,
+		accountID:  accountID,
+		email:      email,
+		codeDigest: codeDigest,
+		attempts:   attempts,
+		expiresAt:  expiresAt,
+		createdAt:  createdAt,
+	}
+}
+
+func (v *EmailVerification) ID() int              { return int(v.id) }
+func (v *EmailVerification) AccountID() int       { return int(v.accountID) }
+func (v *EmailVerification) Email() string        { return string(v.email) }
+func (v *EmailVerification) CodeDigest() string   { return v.codeDigest }
+func (v *EmailVerification) Attempts() int        { return v.attempts }
+func (v *EmailVerification) ExpiresAt() time.Time { return v.expiresAt }
+func (v *EmailVerification) CreatedAt() time.Time { return v.createdAt }
+
+func (v *EmailVerification) IsExpired(clock clock.Clock) bool {
+	return !clock.Now().Before(v.expiresAt)
+}
+
+// Matches compares code with the stored digest in constant time.
+func (v *EmailVerification) Matches(code string) bool {
+	digest := verificationCodeDigest(v.accountID, v.email, code)
+
+	return subtle.ConstantTimeCompare([]byte(digest), []byte(v.codeDigest)) == 1
+}
+
+func (v *EmailVerification) RegisterFailedAttempt() {
+	v.attempts++
+}
+
+// IsResendAllowed reports whether cooldown passed since the code was sent.
+func (v *EmailVerification) IsResendAllowed(cooldown time.Duration, clock clock.Clock) bool {
+	return !clock.Now().Before(v.createdAt.Add(cooldown))
+}
+
+// InheritAttempts keeps failed attempts of previous code which is still valid, so re-issuing the code
+// doesn't give more guesses.
+func (v *EmailVerification) InheritAttempts(previous *EmailVerification, clock clock.Clock) {
+	if !previous.IsExpired(clock) {
+		v.attempts = previous.attempts
+	}
+}
+
+// verificationCodeDigest binds code to account and email, so a code can't be replayed for another address.
+func verificationCodeDigest(accountID AccountID, email Email, code string) string {
+	sum := sha256.Sum256([]byte(strconv.Itoa(int(accountID)) + ":" + string(email.Canonical()) + ":" + code))
+
+	return hex.EncodeToString(sum[:])
+}
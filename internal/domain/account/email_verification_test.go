@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"github.com/intezya/auth_service/pkg/clock"
+	"testing"
+	"time"
+)
+
+func TestEmailVerification_IsResendAllowed(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	mockClock := clock.NewMockClock(start)
+
+	verification := NewEmailVerification(1, "alice@example.com", "123456", 15*time.Minute, mockClock)
+
+	mockClock.SetTime(start.Add(59 * time.Second))
+	if verification.IsResendAllowed(time.Minute, mockClock) {
+		t.Fatal("IsResendAllowed() = true before cooldown passed")
+	}
+
+	mockClock.SetTime(start.Add(time.Minute))
+	if !verification.IsResendAllowed(time.Minute, mockClock) {
+		t.Fatal("IsResendAllowed() = false after cooldown passed")
+	}
+}
+
+func TestEmailVerification_InheritAttempts(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		after time.Duration
+		want  int
+	}{
+		{name: "previous code is valid", after: 5 * time.Minute, want: 3},
+		{name: "previous code expired", after: 15 * time.Minute, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				mockClock := clock.NewMockClock(start)
+
+				previous := NewEmailVerification(1, "alice@example.com", "123456", 15*time.Minute, mockClock)
+				for range 3 {
+					previous.RegisterFailedAttempt()
+				}
+
+				mockClock.SetTime(start.Add(tt.after))
+
+				reissued := NewEmailVerification(1, "alice@example.com", "654321", 15*time.Minute, mockClock)
+				reissued.InheritAttempts(previous, mockClock)
+
+				if reissued.Attempts() != tt.want {
+					t.Fatalf("Attempts() = %d, want %d", reissued.Attempts(), tt.want)
+				}
+			},
+		)
+	}
+}
@@ -3,34 +3,59 @@ package domain
 import (
 	"errors"
 	"github.com/intezya/auth_service/pkg/clock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"time"
 )
 
+var (
+	ErrAccountDeleted    = status.Error(codes.FailedPrecondition, "account is deleted")
+	ErrAccountNotDeleted = status.Error(codes.FailedPrecondition, "account is not deleted")
+	ErrAccountErased     = status.Error(codes.NotFound, "account is erased")
+	ErrUsernameUnchanged = status.Error(codes.InvalidArgument, "username is unchanged")
+	ErrEmailUnchanged    = status.Error(codes.InvalidArgument, "email is unchanged")
+)
+
 type Account struct {
-	id          AccountID
-	username    Username
-	password    HashedPassword
-	hardwareID  *HardwareID
-	accessLevel AccessLevel
-	bannedUntil *time.Time
-	banReason   *string
-	createdAt   time.Time
+	id               AccountID
+	username         Username
+	password         HashedPassword
+	hardwareID       *HardwareID
+	hardwareIDDigest *HardwareIDDigest
+	accessLevel      AccessLevel
+	bannedUntil      *time.Time
+	banReason        *string
+	createdAt        time.Time
+	deletedAt        *time.Time
+	erasedAt         *time.Time
+	version          int
+
+	usernameChangedAt *time.Time
+
+	// email is set only after it is verified
+	email           *Email
+	emailVerifiedAt *time.Time
+
+	// lastLoginAt is written by login history recorder only, Update doesn't store it
+	lastLoginAt *time.Time
 }
 
 func NewAccount(
 	username Username,
 	password HashedPassword,
 	hardwareID HardwareID,
+	hardwareIDDigest HardwareIDDigest,
 	clock clock.Clock,
 ) *Account {
 	return &Account{
-		username:    username,
-		password:    password,
-		hardwareID:  &hardwareID,
-		accessLevel: AccessLevelUser,
-		bannedUntil: nil,
-		banReason:   nil,
-		createdAt:   clock.Now(),
+		username:         username,
+		password:         password,
+		hardwareID:       &hardwareID,
+		hardwareIDDigest: &hardwareIDDigest,
+		accessLevel:      AccessLevelUser,
+		bannedUntil:      nil,
+		banReason:        nil,
+		createdAt:        clock.Now(),
 	}
 }
 
@@ -39,34 +64,68 @@ func NewAccountFromRepository(
 	username Username,
 	password HashedPassword,
 	hardwareID *HardwareID,
+	hardwareIDDigest *HardwareIDDigest,
 	accessLevel AccessLevel,
 	bannedUntil *time.Time,
 	banReason *string,
 	createdAt time.Time,
+	deletedAt *time.Time,
+	erasedAt *time.Time,
+	usernameChangedAt *time.Time,
+	email *Email,
+	emailVerifiedAt *time.Time,
+	lastLoginAt *time.Time,
+	version int,
 ) *Account {
 	return &Account{
-		id:          id,
-		username:    username,
-		password:    password,
-		hardwareID:  hardwareID,
-		accessLevel: accessLevel,
-		bannedUntil: bannedUntil,
-		banReason:   banReason,
-		createdAt:   createdAt,
+		id:               id,
+		username:         username,
+		password:         password,
+		hardwareID:       hardwareID,
+		hardwareIDDigest: hardwareIDDigest,
+		accessLevel:      accessLevel,
+		bannedUntil:      bannedUntil,
+		banReason:        banReason,
+		createdAt:        createdAt,
+		deletedAt:        deletedAt,
+		erasedAt:         erasedAt,
+		version:          version,
+
+		usernameChangedAt: usernameChangedAt,
+
+		email:           email,
+		emailVerifiedAt: emailVerifiedAt,
+
+		lastLoginAt: lastLoginAt,
 	}
 }
 
-func (a *Account) ID() int                 { return int(a.id) }
-func (a *Account) Username() string        { return string(a.username) }
-func (a *Account) Password() string        { return string(a.password) }
-func (a *Account) HardwareID() *string     { return (*string)(a.hardwareID) }
-func (a *Account) AccessLevel() int        { return int(a.accessLevel) }
-func (a *Account) BannedUntil() *time.Time { return a.bannedUntil }
-func (a *Account) BanReason() *string      { return a.banReason }
-func (a *Account) CreatedAt() time.Time    { return a.createdAt }
+func (a *Account) ID() int                   { return int(a.id) }
+func (a *Account) Username() string          { return string(a.username) }
+func (a *Account) Password() string          { return string(a.password) }
+func (a *Account) HardwareID() *string       { return (*string)(a.hardwareID) }
+func (a *Account) HardwareIDDigest() *string { return (*string)(a.hardwareIDDigest) }
+func (a *Account) AccessLevel() int          { return int(a.accessLevel) }
+func (a *Account) BannedUntil() *time.Time   { return a.bannedUntil }
+func (a *Account) BanReason() *string        { return a.banReason }
+func (a *Account) CreatedAt() time.Time      { return a.createdAt }
+func (a *Account) DeletedAt() *time.Time     { return a.deletedAt }
+func (a *Account) ErasedAt() *time.Time      { return a.erasedAt }
+func (a *Account) Version() int              { return a.version }
+
+func (a *Account) UsernameChangedAt() *time.Time { return a.usernameChangedAt }
+func (a *Account) Email() *string                { return (*string)(a.email) }
+func (a *Account) EmailVerifiedAt() *time.Time   { return a.emailVerifiedAt }
+func (a *Account) LastLoginAt() *time.Time       { return a.lastLoginAt }
 
-func (a *Account) SetHardwareID(hardwareID HardwareID) {
+// SetVersion is called by repository after account is written.
+func (a *Account) SetVersion(version int) {
+	a.version = version
+}
+
+func (a *Account) SetHardwareID(hardwareID HardwareID, digest HardwareIDDigest) {
 	a.hardwareID = &hardwareID
+	a.hardwareIDDigest = &digest
 }
 
 func (a *Account) Ban(until time.Time, reason *string) error {
@@ -90,3 +149,97 @@ func (a *Account) IsBanned(clock clock.Clock) bool {
 
 	return a.bannedUntil.Unix() > clock.Now().Unix()
 }
+
+// ChangeUsername renames account, username can be changed once per cooldown.
+func (a *Account) ChangeUsername(username Username, cooldown time.Duration, clock clock.Clock) error {
+	if username == a.username {
+		return ErrUsernameUnchanged
+	}
+
+	now := clock.Now()
+
+	if a.usernameChangedAt != nil {
+		if next := a.usernameChangedAt.Add(cooldown); now.Before(next) {
+			return status.Errorf(
+				codes.FailedPrecondition,
+				"username can be changed again after %s",
+				next.UTC().Format(time.RFC3339),
+			)
+		}
+	}
+
+	a.username = username
+	a.usernameChangedAt = &now
+
+	return nil
+}
+
+// HasEmail reports whether account has verified email equal to given one ignoring case.
+func (a *Account) HasEmail(email Email) bool {
+	return a.email != nil && a.email.Canonical() == email.Canonical()
+}
+
+// SetVerifiedEmail replaces email of account with the one confirmed by verification code.
+func (a *Account) SetVerifiedEmail(email Email, clock clock.Clock) {
+	now := clock.Now()
+
+	a.email = &email
+	a.emailVerifiedAt = &now
+}
+
+// HasAccessLevel reports whether account has at least given access level, levels are ordered by privilege.
+func (a *Account) HasAccessLevel(level AccessLevel) bool {
+	return a.accessLevel >= level
+}
+
+func (a *Account) IsDeleted() bool { return a.deletedAt != nil }
+func (a *Account) IsErased() bool  { return a.erasedAt != nil }
+
+// Delete deactivates account, it can be restored until erased.
+func (a *Account) Delete(clock clock.Clock) error {
+	if a.IsDeleted() {
+		return ErrAccountDeleted
+	}
+
+	now := clock.Now()
+	a.deletedAt = &now
+
+	return nil
+}
+
+func (a *Account) Restore() error {
+	switch {
+	case a.IsErased():
+		return ErrAccountErased
+	case !a.IsDeleted():
+		return ErrAccountNotDeleted
+	}
+
+	a.deletedAt = nil
+
+	return nil
+}
+
+// Erase removes personal data of deleted account. Only id and timestamps are kept, so references to the account
+// from other services still resolve, and username becomes available for registration.
+func (a *Account) Erase(clock clock.Clock) error {
+	switch {
+	case a.IsErased():
+		return ErrAccountErased
+	case !a.IsDeleted():
+		return ErrAccountNotDeleted
+	}
+
+	now := clock.Now()
+
+	a.username = ""
+	a.password = ""
+	a.hardwareID = nil
+	a.hardwareIDDigest = nil
+	a.banReason = nil
+	a.email = nil
+	a.emailVerifiedAt = nil
+	a.erasedAt = &now
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/intezya/auth_service/pkg/clock"
+	"strings"
+	"time"
+)
+
+type LoginAttemptID int
+
+// HardwareIDMatch is result of hardware id check of login.
+type HardwareIDMatch string
+
+const (
+	HardwareIDMatchTrusted       HardwareIDMatch = "trusted"        // trusted device of the account
+	HardwareIDMatchPending       HardwareIDMatch = "pending"        // new or not yet approved device
+	HardwareIDMatchConflict      HardwareIDMatch = "conflict"       // hardware id belongs to another account
+	HardwareIDMatchLimitExceeded HardwareIDMatch = "limit_exceeded" // new device over device limit
+	HardwareIDMatchNotChecked    HardwareIDMatch = "not_checked"    // login failed before the check
+)
+
+type LoginFailureReason string
+
+const (
+	LoginFailureInvalidPassword       LoginFailureReason = "invalid_password"
+	LoginFailureHardwareIDConflict    LoginFailureReason = "hardware_id_conflict"
+	LoginFailureDeviceApprovalPending LoginFailureReason = "device_approval_pending"
+	LoginFailureDeviceLimitExceeded   LoginFailureReason = "device_limit_exceeded"
+	LoginFailureBanned                LoginFailureReason = "banned"
+	LoginFailureRiskDenied            LoginFailureReason = "risk_denied"
+	LoginFailureInternal              LoginFailureReason = "internal_error"
+)
+
+// LoginAttempt is an entry of login history of account.
+type LoginAttempt struct {
+	id              LoginAttemptID
+	accountID       AccountID
+	loginDigest     *string // set only for attempts of logins which match no account, accountID is 0 then
+	ip              *string
+	hardwareIDMatch HardwareIDMatch
+	failureReason   *LoginFailureReason // nil for successful login
+	location        IPLocation
+	risk            *RiskAssessment // nil if login failed before assessment
+	createdAt       time.Time
+}
+
+func NewLoginAttempt(
+	accountID AccountID,
+	ip *string,
+	hardwareIDMatch HardwareIDMatch,
+	failureReason *LoginFailureReason,
+	risk *RiskAssessment,
+	clock clock.Clock,
+) *LoginAttempt {
+	attempt := &LoginAttempt{
+		accountID:       accountID,
+		ip:              ip,
+		hardwareIDMatch: hardwareIDMatch,
+		failureReason:   failureReason,
+		risk:            risk,
+		createdAt:       clock.Now(),
+	}
+
+	if risk != nil {
+		attempt.location = risk.Location
+	}
+
+	return attempt
+}
+
+// NewUnknownLoginAttempt creates failed attempt of login which matches no account. It isn't part of any
+// login history and only counts against the ip. Login is kept as digest, users sometimes type passwords
+// into login field.
+func NewUnknownLoginAttempt(login string, ip string, clock clock.Clock) *LoginAttempt {
+	digest := sha256.Sum256([]byte(strings.ToLower(login)))
+	loginDigest := hex.EncodeToString(digest[:])
+	reason := LoginFailureInvalidPassword
+
+	return &LoginAttempt{
+		loginDigest:     &loginDigest,
+		ip:              &ip,
+		hardwareIDMatch: HardwareIDMatchNotChecked,
+		failureReason:   &reason,
+		createdAt:       clock.Now(),
+	}
+}
+
+func NewLoginAttemptFromRepository(
+	id LoginAttemptID,
+	accountID AccountID,
+	ip *string,
+	hardwareIDMatch HardwareIDMatch,
+	failureReason *LoginFailureReason,
+	location IPLocation,
+	risk *RiskAssessment,
+	createdAt time.Time,
+) *LoginAttempt {
+	if risk != nil {
+		risk.Location = location
+	}
+
+	return &LoginAttempt{
+		id:              id,
+		accountID:       accountID,
+		ip:              ip,
+		hardwareIDMatch: hardwareIDMatch,
+		failureReason:   failureReason,
+		location:        location,
+		risk:            risk,
+		createdAt:       createdAt,
+	}
+}
+
+func (a *LoginAttempt) ID() int                            { return int(a.id) }
+func (a *LoginAttempt) AccountID() int                     { return int(a.accountID) }
+func (a *LoginAttempt) IP() *string                        { return a.ip }
+func (a *LoginAttempt) HardwareIDMatch() HardwareIDMatch   { return a.hardwareIDMatch }
+func (a *LoginAttempt) FailureReason() *LoginFailureReason { return a.failureReason }
+func (a *LoginAttempt) Location() IPLocation               { return a.location }
+func (a *LoginAttempt) Risk() *RiskAssessment              { return a.risk }
+func (a *LoginAttempt) CreatedAt() time.Time               { return a.createdAt }
+func (a *LoginAttempt) IsSuccessful() bool                 { return a.failureReason == nil }
+func (a *LoginAttempt) LoginDigest() *string               { return a.loginDigest }
+func (a *LoginAttempt) IsUnknownAccount() bool             { return a.loginDigest != nil }
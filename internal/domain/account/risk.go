@@ -0,0 +1,46 @@
+package domain
+
+// IPLocation is GeoIP data of ip address, unknown values are nil.
+type IPLocation struct {
+	ASN       *uint
+	Country   *string // ISO 3166-1 alpha-2
+	Latitude  *float64
+	Longitude *float64
+}
+
+func (l IPLocation) HasCoordinates() bool {
+	return l.Latitude != nil && l.Longitude != nil
+}
+
+type RiskSignal string
+
+const (
+	RiskSignalNewDevice        RiskSignal = "new_device"        // hardware id is not a trusted device of account
+	RiskSignalNewIP            RiskSignal = "new_ip"            // ip is not in recent successful logins
+	RiskSignalNewASN           RiskSignal = "new_asn"           // network is not in recent successful logins
+	RiskSignalImpossibleTravel RiskSignal = "impossible_travel" // too far from previous login location for elapsed time
+	RiskSignalIPFailures       RiskSignal = "ip_failures"       // many accounts failed to log in from the ip recently
+	// RiskSignalIncomplete has no weight, some signals couldn't be evaluated and login requires at least step-up.
+	RiskSignalIncomplete RiskSignal = "incomplete"
+)
+
+type RiskDecision string
+
+const (
+	RiskDecisionAllow  RiskDecision = "allow"
+	RiskDecisionStepUp RiskDecision = "step_up"
+	RiskDecisionDeny   RiskDecision = "deny"
+)
+
+// RiskAssessment is result of scoring login, Score is sum of weights of Signals.
+type RiskAssessment struct {
+	Score    int
+	Decision RiskDecision
+	Signals  []RiskSignal
+	Location IPLocation
+}
+
+func (a *RiskAssessment) AddSignal(signal RiskSignal, weight int) {
+	a.Signals = append(a.Signals, signal)
+	a.Score += weight
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"github.com/intezya/auth_service/pkg/clock"
+	"time"
+)
+
+type UsernameChangeID int
+
+// UsernameChange is entry of username history. Previous username stays reserved for the account
+// until reservedUntil, so nobody can take it to impersonate the previous owner.
+type UsernameChange struct {
+	id            UsernameChangeID
+	accountID     AccountID
+	username      Username // previous username
+	changedAt     time.Time
+	reservedUntil time.Time
+}
+
+func NewUsernameChange(
+	accountID AccountID,
+	previousUsername Username,
+	reservation time.Duration,
+	clock clock.Clock,
+) *UsernameChange {
+	now := clock.Now()
+
+	return &UsernameChange{
+		accountID:     accountID,
+		username:      previousUsername,
+		changedAt:     now,
+		reservedUntil: now.Add(reservation),
+	}
+}
+
+func NewUsernameChangeFromRepository(
+	id UsernameChangeID,
+	accountID AccountID,
+	username Username,
+	changedAt time.Time,
+	reservedUntil time.Time,
+) *UsernameChange {
+	return &UsernameChange{
+		id:            id,
+		accountID:     accountID,
+		username:      username,
+		changedAt:     changedAt,
+		reservedUntil: reservedUntil,
+	}
+}
+
+func (c *UsernameChange) ID() int                  { return int(c.id) }
+func (c *UsernameChange) AccountID() int           { return int(c.accountID) }
+func (c *UsernameChange) Username() string         { return string(c.username) }
+func (c *UsernameChange) ChangedAt() time.Time     { return c.changedAt }
+func (c *UsernameChange) ReservedUntil() time.Time { return c.reservedUntil }
+
+// IsReserved reports whether reservation period of previous username is not over yet.
+func (c *UsernameChange) IsReserved(clock clock.Clock) bool {
+	return clock.Now().Before(c.reservedUntil)
+}
+
+// IsReservedFor reports whether previous username can't be taken by given account.
+func (c *UsernameChange) IsReservedFor(accountID AccountID, clock clock.Clock) bool {
+	return c.accountID != accountID && c.IsReserved(clock)
+}
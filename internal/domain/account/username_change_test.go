@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"github.com/intezya/auth_service/pkg/clock"
+	"testing"
+	"time"
+)
+
+func TestUsernameChange_IsReserved(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		after    time.Duration
+		reserved bool
+		forOther bool
+	}{
+		{name: "within reservation period", after: time.Hour, reserved: true, forOther: true},
+		{name: "reservation period is over", after: 24 * time.Hour, reserved: false, forOther: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				mockClock := clock.NewMockClock(start)
+				change := NewUsernameChange(1, "alice", 24*time.Hour, mockClock)
+
+				mockClock.SetTime(start.Add(tt.after))
+
+				if got := change.IsReserved(mockClock); got != tt.reserved {
+					t.Fatalf("IsReserved() = %t, want %t", got, tt.reserved)
+				}
+
+				if got := change.IsReservedFor(2, mockClock); got != tt.forOther {
+					t.Fatalf("IsReservedFor(other account) = %t, want %t", got, tt.forOther)
+				}
+
+				if change.IsReservedFor(1, mockClock) {
+					t.Fatal("IsReservedFor(previous owner) = true")
+				}
+			},
+		)
+	}
+}
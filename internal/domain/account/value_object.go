@@ -5,12 +5,32 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
 )
 
 type AccountID int
+type DeviceID int
 type Username string
+type CanonicalUsername string
 type HashedPassword string
 type HardwareID string
+type HardwareIDDigest string
+type Email string
+type CanonicalEmail string
+
+// Canonical returns NFKC casefolded username, so "Alice", "ALICE" and "ａｌｉｃｅ" are the same user.
+func (u Username) Canonical() CanonicalUsername {
+	folded := cases.Fold().String(norm.NFKC.String(string(u)))
+
+	return CanonicalUsername(norm.NFKC.String(folded))
+}
+
+// Canonical returns lower-cased email, addresses are compared case-insensitively.
+func (e Email) Canonical() CanonicalEmail {
+	return CanonicalEmail(strings.ToLower(string(e)))
+}
 
 //go:generate stringer -type=AccessLevel
 type AccessLevel int
@@ -31,10 +51,22 @@ const (
 	AccessLevelDev
 )
 
+//go:generate stringer -type=DeviceStatus
+type DeviceStatus int
+
+const (
+	DeviceStatusPending DeviceStatus = iota
+	DeviceStatusTrusted
+)
+
 var (
 	errUnknownAccessLevel     = errors.New("unknown AccessLevel")
 	errOutOfRangeAccessLevel  = errors.New("AccessLevel out of range")
 	errInvalidTypeAccessLevel = errors.New("invalid AccessLevel value type")
+
+	errUnknownDeviceStatus     = errors.New("unknown DeviceStatus")
+	errOutOfRangeDeviceStatus  = errors.New("DeviceStatus out of range")
+	errInvalidTypeDeviceStatus = errors.New("invalid DeviceStatus value type")
 )
 
 // Value implements the driver.Valuer interface for saving to DB (as string).
@@ -69,3 +101,36 @@ func (a *AccessLevel) Scan(value interface{}) error {
 		return fmt.Errorf("%w: %T", errInvalidTypeAccessLevel, value)
 	}
 }
+
+// Value implements the driver.Valuer interface for saving to DB (as string).
+func (s DeviceStatus) Value() (driver.Value, error) {
+	return s.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for reading from DB (as string).
+func (s *DeviceStatus) Scan(value interface{}) error {
+	switch typedValue := value.(type) {
+	case string:
+		for i := DeviceStatusPending; i <= DeviceStatusTrusted; i++ {
+			if strings.EqualFold(i.String(), typedValue) {
+				*s = i
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%w: %v", errUnknownDeviceStatus, value)
+	case []byte:
+		return s.Scan(string(typedValue))
+	case int64:
+		if typedValue >= int64(DeviceStatusPending) && typedValue <= int64(DeviceStatusTrusted) {
+			*s = DeviceStatus(typedValue)
+
+			return nil
+		}
+
+		return fmt.Errorf("%w (%v)", errOutOfRangeDeviceStatus, value)
+	default:
+		return fmt.Errorf("%w: %T", errInvalidTypeDeviceStatus, value)
+	}
+}
@@ -13,4 +13,21 @@ type AccountDTO struct {
 	CreatedAt   time.Time  `json:"created_at,omitempty"`
 	BannedUntil *time.Time `json:"banned_until,omitempty"`
 	BanReason   *string    `json:"ban_reason,omitempty"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+}
+
+type LoginAttemptDTO struct {
+	ID              int       `json:"id"`
+	IP              *string   `json:"ip"`
+	HardwareIDMatch string    `json:"hardware_id_match"`
+	Success         bool      `json:"success"`
+	FailureReason   *string   `json:"failure_reason"`
+	ASN             *uint     `json:"asn,omitempty"`
+	Country         *string   `json:"country,omitempty"`
+	Latitude        *float64  `json:"latitude,omitempty"`
+	Longitude       *float64  `json:"longitude,omitempty"`
+	RiskScore       *int      `json:"risk_score,omitempty"`
+	RiskDecision    *string   `json:"risk_decision,omitempty"` // allow / step_up / deny
+	RiskSignals     []string  `json:"risk_signals,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
 }
@@ -0,0 +1,55 @@
+package dto
+
+import "time"
+
+// Redacted replaces secret values in account data export.
+const Redacted = "[REDACTED]"
+
+// AccountExportDTO is personal data of account collected for subject access request.
+type AccountExportDTO struct {
+	FormatVersion   int                   `json:"format_version"`
+	GeneratedAt     time.Time             `json:"generated_at"`
+	Account         AccountExportAccount  `json:"account"`
+	BanHistory      []AuditEntryDTO       `json:"ban_history"`
+	UsernameHistory []UsernameChangeDTO   `json:"username_history"`
+	Devices         []AccountExportDevice `json:"devices"`
+	LoginHistory    []LoginAttemptDTO     `json:"login_history"`
+	AuditLog        []AuditEntryDTO       `json:"audit_log"`
+}
+
+type AccountExportAccount struct {
+	ID                int        `json:"id"`
+	Username          string     `json:"username"`
+	Password          string     `json:"password"`
+	HardwareID        *string    `json:"hardware_id"`
+	AccessLevel       string     `json:"access_level"`
+	CreatedAt         time.Time  `json:"created_at"`
+	BannedUntil       *time.Time `json:"banned_until"`
+	BanReason         *string    `json:"ban_reason"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	UsernameChangedAt *time.Time `json:"username_changed_at"`
+	Email             *string    `json:"email"`
+	EmailVerifiedAt   *time.Time `json:"email_verified_at"`
+	LastLoginAt       *time.Time `json:"last_login_at"`
+}
+
+type UsernameChangeDTO struct {
+	PreviousUsername string    `json:"previous_username"`
+	ChangedAt        time.Time `json:"changed_at"`
+}
+
+type AccountExportDevice struct {
+	ID         int        `json:"id"`
+	HardwareID string     `json:"hardware_id"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ApprovedAt *time.Time `json:"approved_at"`
+	LastSeenAt *time.Time `json:"last_seen_at"`
+}
+
+type AuditEntryDTO struct {
+	Action    string            `json:"action"`
+	Actor     string            `json:"actor"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+type DeviceDTO struct {
+	ID         int        `json:"id"`
+	IsTrusted  bool       `json:"is_trusted"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+}
@@ -3,12 +3,59 @@ package repository
 import (
 	"context"
 	domain "github.com/intezya/auth_service/internal/domain/account"
+	"time"
 )
 
 type AccountRepository interface {
 	Create(ctx context.Context, account *domain.Account) (*domain.Account, error)
 	FindByID(ctx context.Context, id domain.AccountID) (*domain.Account, error)
+	// FindAllByIDs returns found accounts (deleted included) in unspecified order.
+	FindAllByIDs(ctx context.Context, ids []domain.AccountID) ([]*domain.Account, error)
+	// FindByLowerUsername finds active (not deleted) account.
 	FindByLowerUsername(ctx context.Context, username domain.Username) (*domain.Account, error)
+	// FindByEmail finds active account by verified email (compared canonically).
+	FindByEmail(ctx context.Context, email domain.Email) (*domain.Account, error)
+	// FindDeletedByLowerUsername finds deleted account which is not erased yet.
+	FindDeletedByLowerUsername(ctx context.Context, username domain.Username) (*domain.Account, error)
+	// FindAllDeletedBefore returns up to limit not erased accounts deleted before given time.
+	FindAllDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*domain.Account, error)
+	// Search returns up to criteria.Limit active accounts matching criteria in criteria.SortBy order.
+	Search(ctx context.Context, criteria AccountSearchCriteria) ([]*domain.Account, error)
 	Update(ctx context.Context, account *domain.Account) error
-	ExistsByLowerUsername(ctx context.Context, username domain.Username) bool
+	// UpdateLastLoginAt moves last login time of account forward, version is not changed.
+	UpdateLastLoginAt(ctx context.Context, id domain.AccountID, at time.Time) error
+	// ExistsByEmail reports whether email is verified by any account (compared canonically).
+	ExistsByEmail(ctx context.Context, email domain.Email) (bool, error)
+	ExistsByHardwareIDDigest(ctx context.Context, digest domain.HardwareIDDigest) (bool, error)
+}
+
+type AccountSortField int
+
+const (
+	AccountSortByID AccountSortField = iota
+	AccountSortByCreatedAt
+	AccountSortByUsername
+)
+
+// AccountSearchCriteria filters accounts, nil or zero filter matches everything.
+type AccountSearchCriteria struct {
+	UsernamePrefix string // matched against canonical username
+	AccessLevel    *domain.AccessLevel
+	Banned         *bool     // banned state at Now
+	Now            time.Time // required by Banned filter
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time // exclusive
+
+	SortBy     AccountSortField
+	Descending bool
+	// After is the last account of previous page, results continue after it (keyset pagination).
+	After *AccountCursor
+	Limit int
+}
+
+// AccountCursor is the position of account in search order, ties of sort field are broken by id.
+type AccountCursor struct {
+	ID                int
+	CreatedAt         time.Time
+	UsernameCanonical domain.CanonicalUsername
 }
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+)
+
+type AuditRepository interface {
+	Create(ctx context.Context, entry *domain.AuditEntry) (*domain.AuditEntry, error)
+	// FindAllByAccountID returns audit entries of account in chronological order.
+	FindAllByAccountID(ctx context.Context, accountID domain.AccountID) ([]*domain.AuditEntry, error)
+}
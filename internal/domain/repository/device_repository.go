@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+)
+
+type DeviceRepository interface {
+	Create(ctx context.Context, device *domain.Device) (*domain.Device, error)
+	FindByID(ctx context.Context, id domain.DeviceID) (*domain.Device, error)
+	FindByHardwareIDDigest(ctx context.Context, digest domain.HardwareIDDigest) (*domain.Device, error)
+	FindAllByAccountID(ctx context.Context, accountID domain.AccountID) ([]*domain.Device, error)
+	CountByAccountID(ctx context.Context, accountID domain.AccountID) (int, error)
+	CountTrustedByAccountID(ctx context.Context, accountID domain.AccountID) (int, error)
+	Update(ctx context.Context, device *domain.Device) error
+	Delete(ctx context.Context, id domain.DeviceID) error
+	DeleteAllByAccountID(ctx context.Context, accountID domain.AccountID) error
+}
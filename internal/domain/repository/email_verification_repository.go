@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+)
+
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, verification *domain.EmailVerification) (*domain.EmailVerification, error)
+	// FindLatestByAccountID finds the most recent pending verification of account.
+	FindLatestByAccountID(ctx context.Context, accountID domain.AccountID) (*domain.EmailVerification, error)
+	// Update stores attempts counter of verification.
+	Update(ctx context.Context, verification *domain.EmailVerification) error
+	DeleteAllByAccountID(ctx context.Context, accountID domain.AccountID) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors returned by repositories on constraint violations and transaction conflicts.
+var (
+	ErrAccountAlreadyExists = status.Error(codes.AlreadyExists, "user already exists")
+	ErrHardwareIDConflict   = status.Error(codes.AlreadyExists, "hardware_id conflict")
+	ErrEmailAlreadyUsed     = status.Error(codes.AlreadyExists, "email is already used")
+	ErrDuplicateEntity      = status.Error(codes.AlreadyExists, "entity already exists")
+	ErrReferenceNotFound    = status.Error(codes.FailedPrecondition, "referenced entity does not exist")
+	ErrConstraintViolation  = status.Error(codes.InvalidArgument, "constraint violation")
+
+	// ErrSerializationFailure is returned when transaction conflicts with concurrent one and can be retried.
+	ErrSerializationFailure = status.Error(codes.Aborted, "transaction conflict, retry")
+	// ErrConcurrentModification is returned by Update when entity was changed since it was loaded.
+	ErrConcurrentModification = status.Error(codes.Aborted, "entity was modified concurrently, retry")
+)
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"time"
+)
+
+type LoginAttemptRepository interface {
+	// CreateBulk stores attempts, attempts of unknown logins are kept only for CountFailedAccountsByIPSince.
+	CreateBulk(ctx context.Context, attempts []*domain.LoginAttempt) error
+	// FindAllByAccountID returns up to limit attempts of account, newest first, continuing after cursor if it is set.
+	FindAllByAccountID(
+		ctx context.Context,
+		accountID domain.AccountID,
+		after *LoginAttemptCursor,
+		limit int,
+	) ([]*domain.LoginAttempt, error)
+	// FindRecentSuccessfulByAccountID returns up to limit successful attempts of account, newest first.
+	FindRecentSuccessfulByAccountID(ctx context.Context, accountID domain.AccountID, limit int) (
+		[]*domain.LoginAttempt,
+		error,
+	)
+	// CountFailedAccountsByIPSince returns count of distinct accounts with attempts failed because of invalid password
+	// from ip since given time, distinct unknown logins tried from the ip are counted as accounts too.
+	CountFailedAccountsByIPSince(ctx context.Context, ip string, since time.Time) (int, error)
+	// DeleteAllCreatedBefore deletes up to limit oldest attempts created before given time and returns their count.
+	DeleteAllCreatedBefore(ctx context.Context, before time.Time, limit int) (int, error)
+	DeleteAllByAccountID(ctx context.Context, accountID domain.AccountID) error
+}
+
+// LoginAttemptCursor is the position of attempt in login history, ties of created_at are broken by id.
+type LoginAttemptCursor struct {
+	ID        int
+	CreatedAt time.Time
+}
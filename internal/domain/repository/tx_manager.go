@@ -0,0 +1,13 @@
+package repository
+
+import "context"
+
+// TxManager runs several repository calls atomically.
+type TxManager interface {
+	// WithinTx runs fn in transaction, repositories called with ctx passed to fn take part in it.
+	// Transaction is committed if fn returns nil. Nested calls join the outer transaction.
+	// Whole fn is retried when transaction fails due to concurrent modification (ErrSerializationFailure,
+	// ErrConcurrentModification), so it must load entities it changes itself and must not have side effects
+	// besides repository calls.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
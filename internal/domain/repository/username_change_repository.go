@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+)
+
+type UsernameChangeRepository interface {
+	Create(ctx context.Context, change *domain.UsernameChange) (*domain.UsernameChange, error)
+	// FindLatestByUsername finds the most recent change away from username (compared canonically).
+	FindLatestByUsername(ctx context.Context, username domain.Username) (*domain.UsernameChange, error)
+	// FindAllByAccountID returns username history of account in chronological order.
+	FindAllByAccountID(ctx context.Context, accountID domain.AccountID) ([]*domain.UsernameChange, error)
+	DeleteAllByAccountID(ctx context.Context, accountID domain.AccountID) error
+}
@@ -10,6 +10,9 @@ type PasswordEncoder interface {
 	VerifyPassword(ctx context.Context, password, hash string) bool
 	EncodeHardwareID(ctx context.Context, hardwareID string) string
 	VerifyHardwareID(ctx context.Context, hardwareID, hash string) bool
+	DecodeHardwareID(ctx context.Context, hash string) (string, error)
+	ReencryptHardwareID(ctx context.Context, hash string) (string, bool, error)
+	DigestHardwareID(ctx context.Context, hardwareID string) string
 }
 
 type TokenManager interface {
@@ -4,45 +4,275 @@ import (
 	"context"
 	entity "github.com/intezya/auth_service/internal/domain/account"
 	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/pkg/clock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
 )
 
+var (
+	ErrHardwareIDConflict    = repository.ErrHardwareIDConflict
+	ErrDeviceApprovalPending = status.Error(codes.FailedPrecondition, "device approval pending")
+	ErrDeviceLimitExceeded   = status.Error(codes.ResourceExhausted, "device limit exceeded")
+)
+
+type DeviceConfig struct {
+	Limit            int           `env:"DEVICE_LIMIT" env-default:"3"`
+	ApprovalCooldown time.Duration `env:"DEVICE_APPROVAL_COOLDOWN" env-default:"72h"`
+}
+
 type HardwareIDManager interface {
+	EnsureHardwareIDAvailable(ctx context.Context, providedHardwareID string) error
 	ValidateAndSetHardwareID(ctx context.Context, account *entity.Account, providedHardwareID string) error
+	RequireDeviceApproval(ctx context.Context, account *entity.Account, providedHardwareID string) error
+	FindTrustedDevice(ctx context.Context, account *entity.Account, providedHardwareID string) (*entity.Device, bool)
+	EnsureTrustedDeviceSlot(ctx context.Context, accountID entity.AccountID) error
 }
 
 type hardwareIDManager struct {
 	accountRepository repository.AccountRepository
+	deviceRepository  repository.DeviceRepository
+	txManager         repository.TxManager
 	passwordEncoder   PasswordEncoder
+	config            DeviceConfig
+	clock             clock.Clock
 }
 
 func NewHardwareIDManager(
 	accountRepository repository.AccountRepository,
+	deviceRepository repository.DeviceRepository,
+	txManager repository.TxManager,
 	passwordEncoder PasswordEncoder,
+	config DeviceConfig,
+	clock clock.Clock,
 ) HardwareIDManager {
 	return &hardwareIDManager{
 		accountRepository: accountRepository,
+		deviceRepository:  deviceRepository,
+		txManager:         txManager,
 		passwordEncoder:   passwordEncoder,
+		config:            config,
+		clock:             clock,
 	}
 }
 
+// EnsureHardwareIDAvailable checks that hardware id is not used by any account or device yet.
+func (h *hardwareIDManager) EnsureHardwareIDAvailable(ctx context.Context, providedHardwareID string) error {
+	digest := entity.HardwareIDDigest(h.passwordEncoder.DigestHardwareID(ctx, providedHardwareID))
+
+	_, err := h.deviceRepository.FindByHardwareIDDigest(ctx, digest)
+	if err == nil {
+		return ErrHardwareIDConflict
+	}
+
+	if status.Code(err) != codes.NotFound {
+		return err
+	}
+
+	exists, err := h.accountRepository.ExistsByHardwareIDDigest(ctx, digest)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return ErrHardwareIDConflict
+	}
+
+	return nil
+}
+
+// ValidateAndSetHardwareID lets the login through only from a trusted device of the account.
+// Unknown hardware ids are registered as pending devices which are approved from a trusted device
+// or automatically after the approval cooldown.
 func (h *hardwareIDManager) ValidateAndSetHardwareID(
 	ctx context.Context,
 	account *entity.Account,
 	providedHardwareID string,
 ) error {
-	if account.HardwareID() != nil {
-		if h.passwordEncoder.VerifyHardwareID(ctx, providedHardwareID, *account.HardwareID()) {
-			panic("TODO()")
-			//return TODO()
-		}
-		return nil
-	} else {
-		hashedHardwareID := h.passwordEncoder.EncodeHardwareID(ctx, providedHardwareID)
-		account.SetHardwareID(entity.HardwareID(hashedHardwareID))
+	return h.validateAndSetHardwareID(ctx, account, providedHardwareID, true)
+}
+
+// RequireDeviceApproval is ValidateAndSetHardwareID for risky logins: device which isn't trusted yet
+// stays pending, even the first device of account or one past the approval cooldown.
+func (h *hardwareIDManager) RequireDeviceApproval(
+	ctx context.Context,
+	account *entity.Account,
+	providedHardwareID string,
+) error {
+	return h.validateAndSetHardwareID(ctx, account, providedHardwareID, false)
+}
+
+// autoTrust allows trusting device without approval from a trusted device.
+func (h *hardwareIDManager) validateAndSetHardwareID(
+	ctx context.Context,
+	account *entity.Account,
+	providedHardwareID string,
+	autoTrust bool,
+) error {
+	trusted := false
+
+	err := h.txManager.WithinTx(
+		ctx, func(ctx context.Context) error {
+			// account could be changed concurrently after it was loaded by caller
+			current, err := h.accountRepository.FindByID(ctx, entity.AccountID(account.ID()))
+			if err != nil {
+				return err
+			}
+
+			trusted, err = h.bindDevice(ctx, current, providedHardwareID, autoTrust)
+
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !trusted {
+		return ErrDeviceApprovalPending // pending device is stored
+	}
+
+	return nil
+}
+
+// bindDevice returns whether hardware id belongs to trusted device of the account, registering it if it is new.
+func (h *hardwareIDManager) bindDevice(
+	ctx context.Context,
+	account *entity.Account,
+	providedHardwareID string,
+	autoTrust bool,
+) (bool, error) {
+	digest := entity.HardwareIDDigest(h.passwordEncoder.DigestHardwareID(ctx, providedHardwareID))
+
+	device, err := h.deviceRepository.FindByHardwareIDDigest(ctx, digest)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return false, err
+	}
+
+	if device != nil {
+		return true, h.validateKnownDevice(ctx, account, device, autoTrust)
+	}
+
+	bound, err := h.isBoundToAnotherAccount(ctx, account, digest)
+	if err != nil {
+		return false, err
+	}
+
+	if bound {
+		return false, ErrHardwareIDConflict
+	}
+
+	count, err := h.deviceRepository.CountByAccountID(ctx, entity.AccountID(account.ID()))
+	if err != nil {
+		return false, err
+	}
+
+	deviceStatus := entity.DeviceStatusPending
+
+	if autoTrust && count == 0 && h.isAccountHardwareID(ctx, account, providedHardwareID) {
+		// first device of account (or account registered before devices existed)
+		deviceStatus = entity.DeviceStatusTrusted
+	} else if err := h.EnsureTrustedDeviceSlot(ctx, entity.AccountID(account.ID())); err != nil {
+		return false, err
+	}
+
+	encodedHardwareID := entity.HardwareID(h.passwordEncoder.EncodeHardwareID(ctx, providedHardwareID))
+
+	if account.HardwareID() == nil {
+		account.SetHardwareID(encodedHardwareID, digest)
 		if err := h.accountRepository.Update(ctx, account); err != nil {
-			return err // hardware id conflict
+			return false, err // hardware id conflict
 		}
 	}
 
+	_, err = h.deviceRepository.Create(
+		ctx,
+		entity.NewDevice(entity.AccountID(account.ID()), encodedHardwareID, digest, deviceStatus, h.clock),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return deviceStatus == entity.DeviceStatusTrusted, nil
+}
+
+func (h *hardwareIDManager) FindTrustedDevice(
+	ctx context.Context,
+	account *entity.Account,
+	providedHardwareID string,
+) (*entity.Device, bool) {
+	digest := entity.HardwareIDDigest(h.passwordEncoder.DigestHardwareID(ctx, providedHardwareID))
+
+	device, err := h.deviceRepository.FindByHardwareIDDigest(ctx, digest)
+	if err != nil || device.AccountID() != account.ID() || !device.IsTrusted() {
+		return nil, false
+	}
+
+	return device, true
+}
+
+// EnsureTrustedDeviceSlot checks that one more device of the account can be trusted.
+// Only trusted devices count toward the limit, so pending ones can't exhaust it.
+func (h *hardwareIDManager) EnsureTrustedDeviceSlot(ctx context.Context, accountID entity.AccountID) error {
+	count, err := h.deviceRepository.CountTrustedByAccountID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if count >= h.config.Limit {
+		return ErrDeviceLimitExceeded
+	}
+
 	return nil
 }
+
+func (h *hardwareIDManager) validateKnownDevice(
+	ctx context.Context,
+	account *entity.Account,
+	device *entity.Device,
+	autoTrust bool,
+) error {
+	if device.AccountID() != account.ID() {
+		return ErrHardwareIDConflict
+	}
+
+	if !device.IsTrusted() {
+		if !autoTrust || !device.IsApprovalDue(h.config.ApprovalCooldown, h.clock) {
+			return ErrDeviceApprovalPending
+		}
+
+		if err := h.EnsureTrustedDeviceSlot(ctx, entity.AccountID(account.ID())); err != nil {
+			return err
+		}
+
+		device.Approve(h.clock)
+	}
+
+	device.Touch(h.clock)
+
+	return h.deviceRepository.Update(ctx, device)
+}
+
+func (h *hardwareIDManager) isBoundToAnotherAccount(
+	ctx context.Context,
+	account *entity.Account,
+	digest entity.HardwareIDDigest,
+) (bool, error) {
+	if account.HardwareIDDigest() != nil && *account.HardwareIDDigest() == string(digest) {
+		return false, nil
+	}
+
+	return h.accountRepository.ExistsByHardwareIDDigest(ctx, digest)
+}
+
+func (h *hardwareIDManager) isAccountHardwareID(
+	ctx context.Context,
+	account *entity.Account,
+	providedHardwareID string,
+) bool {
+	if account.HardwareID() == nil {
+		return true
+	}
+
+	return h.passwordEncoder.VerifyHardwareID(ctx, providedHardwareID, *account.HardwareID())
+}
@@ -0,0 +1,8 @@
+package service
+
+import entity "github.com/intezya/auth_service/internal/domain/account"
+
+// LoginRecorder stores login attempts asynchronously, Record must not block login.
+type LoginRecorder interface {
+	Record(attempt *entity.LoginAttempt)
+}
@@ -0,0 +1,14 @@
+package service
+
+import "context"
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string // plain text
+}
+
+// Mailer delivers mails to users, see internal/pkg/mailer for implementations.
+type Mailer interface {
+	Send(ctx context.Context, mail Mail) error
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/pkg/clock"
+	"math"
+	"net/netip"
+	"slices"
+	"time"
+)
+
+const (
+	earthRadiusKM = 6371
+	// geoIPAccuracyKM is distance between logins which is treated as the same place, GeoIP is only city accurate.
+	geoIPAccuracyKM = 100
+)
+
+type RiskConfig struct {
+	Enabled         bool `env:"RISK_ENGINE_ENABLED" env-default:"true"`
+	StepUpThreshold int  `env:"RISK_STEP_UP_THRESHOLD" env-default:"50"`
+	DenyThreshold   int  `env:"RISK_DENY_THRESHOLD" env-default:"90"`
+
+	NewDeviceWeight        int `env:"RISK_WEIGHT_NEW_DEVICE" env-default:"30"`
+	NewIPWeight            int `env:"RISK_WEIGHT_NEW_IP" env-default:"10"`
+	NewASNWeight           int `env:"RISK_WEIGHT_NEW_ASN" env-default:"20"`
+	ImpossibleTravelWeight int `env:"RISK_WEIGHT_IMPOSSIBLE_TRAVEL" env-default:"60"`
+	IPFailuresWeight       int `env:"RISK_WEIGHT_IP_FAILURES" env-default:"50"`
+
+	MaxTravelSpeed float64 `env:"RISK_MAX_TRAVEL_SPEED_KMH" env-default:"900"`
+	// IPFailureAccounts is count of distinct accounts failed to log in from ip within IPFailureWindow
+	// which marks the ip as abusive, distinct logins which match no account are counted as accounts.
+	IPFailureAccounts int           `env:"RISK_IP_FAILURE_ACCOUNTS" env-default:"5"`
+	IPFailureWindow   time.Duration `env:"RISK_IP_FAILURE_WINDOW" env-default:"15m"`
+	// HistorySize is count of recent successful logins which new ip and network are compared with.
+	HistorySize int `env:"RISK_HISTORY_SIZE" env-default:"50"`
+}
+
+// GeoIPResolver looks up location of ip address, unknown addresses resolve to empty location.
+type GeoIPResolver interface {
+	Resolve(ip netip.Addr) entity.IPLocation
+}
+
+type RiskEngine interface {
+	// Assess scores login of account with valid credentials. Signals which can't be evaluated are skipped,
+	// the assessment gets RiskSignalIncomplete and at least step-up decision, so only trusted devices can
+	// log in while e.g. login history is unavailable. Returned error describes the skipped signals,
+	// the assessment is usable anyway.
+	Assess(ctx context.Context, account *entity.Account, hardwareID string, ip *string) (
+		*entity.RiskAssessment,
+		error,
+	)
+}
+
+type riskEngine struct {
+	loginAttemptRepository repository.LoginAttemptRepository
+	hardwareIDManager      HardwareIDManager
+	geoIPResolver          GeoIPResolver
+	config                 RiskConfig
+	clock                  clock.Clock
+}
+
+func NewRiskEngine(
+	loginAttemptRepository repository.LoginAttemptRepository,
+	hardwareIDManager HardwareIDManager,
+	geoIPResolver GeoIPResolver,
+	config RiskConfig,
+	clock clock.Clock,
+) RiskEngine {
+	return &riskEngine{
+		loginAttemptRepository: loginAttemptRepository,
+		hardwareIDManager:      hardwareIDManager,
+		geoIPResolver:          geoIPResolver,
+		config:                 config,
+		clock:                  clock,
+	}
+}
+
+func (e *riskEngine) Assess(
+	ctx context.Context,
+	account *entity.Account,
+	hardwareID string,
+	ip *string,
+) (*entity.RiskAssessment, error) {
+	assessment := &entity.RiskAssessment{Decision: entity.RiskDecisionAllow}
+
+	var addr netip.Addr
+	if ip != nil {
+		if parsed, err := netip.ParseAddr(*ip); err == nil {
+			addr = parsed.Unmap()
+			assessment.Location = e.geoIPResolver.Resolve(addr)
+		}
+	}
+
+	if !e.config.Enabled {
+		return assessment, nil
+	}
+
+	var errs []error
+
+	if _, trusted := e.hardwareIDManager.FindTrustedDevice(ctx, account, hardwareID); !trusted {
+		assessment.AddSignal(entity.RiskSignalNewDevice, e.config.NewDeviceWeight)
+	}
+
+	history, err := e.loginAttemptRepository.FindRecentSuccessfulByAccountID(
+		ctx,
+		entity.AccountID(account.ID()),
+		e.config.HistorySize,
+	)
+	if err != nil {
+		errs = append(errs, err)
+	} else if len(history) > 0 && addr.IsValid() {
+		// first login has nothing to compare with
+		e.assessHistory(assessment, *ip, history)
+	}
+
+	if addr.IsValid() {
+		failedAccounts, err := e.loginAttemptRepository.CountFailedAccountsByIPSince(
+			ctx,
+			*ip,
+			e.clock.Now().Add(-e.config.IPFailureWindow),
+		)
+		if err != nil {
+			errs = append(errs, err)
+		} else if failedAccounts >= e.config.IPFailureAccounts {
+			assessment.AddSignal(entity.RiskSignalIPFailures, e.config.IPFailuresWeight)
+		}
+	}
+
+	switch {
+	case assessment.Score >= e.config.DenyThreshold:
+		assessment.Decision = entity.RiskDecisionDeny
+	case assessment.Score >= e.config.StepUpThreshold:
+		assessment.Decision = entity.RiskDecisionStepUp
+	case len(errs) > 0:
+		assessment.Decision = entity.RiskDecisionStepUp
+	}
+
+	if len(errs) > 0 {
+		assessment.AddSignal(entity.RiskSignalIncomplete, 0)
+	}
+
+	return assessment, errors.Join(errs...)
+}
+
+func (e *riskEngine) assessHistory(assessment *entity.RiskAssessment, ip string, history []*entity.LoginAttempt) {
+	location := assessment.Location
+
+	knownIP := slices.ContainsFunc(
+		history, func(attempt *entity.LoginAttempt) bool {
+			return attempt.IP() != nil && *attempt.IP() == ip
+		},
+	)
+	if !knownIP {
+		assessment.AddSignal(entity.RiskSignalNewIP, e.config.NewIPWeight)
+	}
+
+	if location.ASN != nil {
+		resolved := false
+		knownASN := false
+
+		for _, attempt := range history {
+			if asn := attempt.Location().ASN; asn != nil {
+				resolved = true
+				knownASN = knownASN || *asn == *location.ASN
+			}
+		}
+
+		if resolved && !knownASN {
+			assessment.AddSignal(entity.RiskSignalNewASN, e.config.NewASNWeight)
+		}
+	}
+
+	if !location.HasCoordinates() {
+		return
+	}
+
+	for _, attempt := range history {
+		if attempt.Location().HasCoordinates() {
+			if e.isImpossibleTravel(attempt.Location(), location, e.clock.Now().Sub(attempt.CreatedAt())) {
+				assessment.AddSignal(entity.RiskSignalImpossibleTravel, e.config.ImpossibleTravelWeight)
+			}
+
+			return
+		}
+	}
+}
+
+// isImpossibleTravel checks if distance between locations can't be covered in elapsed time at max travel speed.
+func (e *riskEngine) isImpossibleTravel(from, to entity.IPLocation, elapsed time.Duration) bool {
+	distance := haversineDistance(*from.Latitude, *from.Longitude, *to.Latitude, *to.Longitude)
+	if distance <= geoIPAccuracyKM {
+		return false
+	}
+
+	if elapsed <= 0 {
+		return true
+	}
+
+	return distance/elapsed.Hours() > e.config.MaxTravelSpeed
+}
+
+// haversineDistance returns great-circle distance between two points in kilometers.
+func haversineDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKM * math.Asin(math.Sqrt(a))
+}
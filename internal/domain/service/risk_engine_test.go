@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"errors"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/pkg/clock"
+	"math"
+	"net/netip"
+	"slices"
+	"testing"
+	"time"
+)
+
+var (
+	berlin  = location(3320, "DE", 52.5200, 13.4050)
+	potsdam = location(3320, "DE", 52.3906, 13.0645)
+	paris   = location(3215, "FR", 48.8566, 2.3522)
+	newYork = location(7922, "US", 40.7128, -74.0060)
+	boston  = location(7922, "US", 42.3601, -71.0589)
+)
+
+type fakeGeoIPResolver map[netip.Addr]entity.IPLocation
+
+func (r fakeGeoIPResolver) Resolve(ip netip.Addr) entity.IPLocation {
+	return r[ip]
+}
+
+type fakeLoginAttemptRepository struct {
+	repository.LoginAttemptRepository
+
+	history        []*entity.LoginAttempt
+	historyErr     error
+	failedAccounts int
+	failedErr      error
+}
+
+func (r *fakeLoginAttemptRepository) FindRecentSuccessfulByAccountID(context.Context, entity.AccountID, int) (
+	[]*entity.LoginAttempt,
+	error,
+) {
+	return r.history, r.historyErr
+}
+
+func (r *fakeLoginAttemptRepository) CountFailedAccountsByIPSince(context.Context, string, time.Time) (int, error) {
+	return r.failedAccounts, r.failedErr
+}
+
+type fakeHardwareIDManager struct {
+	HardwareIDManager
+
+	trusted []string
+}
+
+func (m *fakeHardwareIDManager) FindTrustedDevice(_ context.Context, _ *entity.Account, hardwareID string) (
+	*entity.Device,
+	bool,
+) {
+	return nil, slices.Contains(m.trusted, hardwareID)
+}
+
+func location(asn uint, country string, latitude, longitude float64) entity.IPLocation {
+	return entity.IPLocation{ASN: &asn, Country: &country, Latitude: &latitude, Longitude: &longitude}
+}
+
+func successfulLogin(ip string, location entity.IPLocation, at time.Time) *entity.LoginAttempt {
+	return entity.NewLoginAttemptFromRepository(1, 1, &ip, entity.HardwareIDMatchTrusted, nil, location, nil, at)
+}
+
+func testRiskConfig() RiskConfig {
+	return RiskConfig{
+		Enabled:                true,
+		StepUpThreshold:        50,
+		DenyThreshold:          90,
+		NewDeviceWeight:        30,
+		NewIPWeight:            10,
+		NewASNWeight:           20,
+		ImpossibleTravelWeight: 60,
+		IPFailuresWeight:       50,
+		MaxTravelSpeed:         900,
+		IPFailureAccounts:      5,
+		IPFailureWindow:        15 * time.Minute,
+		HistorySize:            50,
+	}
+}
+
+func TestRiskEngine_Assess(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	errUnavailable := errors.New("database is unavailable")
+
+	resolver := fakeGeoIPResolver{
+		netip.MustParseAddr("192.0.2.1"):    berlin,
+		netip.MustParseAddr("192.0.2.2"):    potsdam,
+		netip.MustParseAddr("198.51.100.1"): paris,
+		netip.MustParseAddr("203.0.113.1"):  newYork,
+	}
+
+	tests := []struct {
+		name         string
+		hardwareID   string
+		ip           string
+		repository   *fakeLoginAttemptRepository
+		wantDecision entity.RiskDecision
+		wantScore    int
+		wantSignals  []entity.RiskSignal
+		wantErr      bool
+	}{
+		{
+			name:         "first login from trusted device",
+			hardwareID:   "trusted",
+			ip:           "192.0.2.1",
+			repository:   &fakeLoginAttemptRepository{},
+			wantDecision: entity.RiskDecisionAllow,
+		},
+		{
+			name:       "known ip and device",
+			hardwareID: "trusted",
+			ip:         "192.0.2.1",
+			repository: &fakeLoginAttemptRepository{
+				history: []*entity.LoginAttempt{successfulLogin("192.0.2.1", berlin, now.Add(-time.Hour))},
+			},
+			wantDecision: entity.RiskDecisionAllow,
+		},
+		{
+			name:         "new device",
+			hardwareID:   "new",
+			ip:           "192.0.2.1",
+			repository:   &fakeLoginAttemptRepository{},
+			wantDecision: entity.RiskDecisionAllow,
+			wantScore:    30,
+			wantSignals:  []entity.RiskSignal{entity.RiskSignalNewDevice},
+		},
+		{
+			name:       "new ip of known network nearby",
+			hardwareID: "trusted",
+			ip:         "192.0.2.2",
+			repository: &fakeLoginAttemptRepository{
+				history: []*entity.LoginAttempt{successfulLogin("192.0.2.1", berlin, now.Add(-time.Minute))},
+			},
+			wantDecision: entity.RiskDecisionAllow,
+			wantScore:    10,
+			wantSignals:  []entity.RiskSignal{entity.RiskSignalNewIP},
+		},
+		{
+			name:       "new device in new network reaches step-up",
+			hardwareID: "new",
+			ip:         "198.51.100.1",
+			repository: &fakeLoginAttemptRepository{
+				history: []*entity.LoginAttempt{successfulLogin("192.0.2.1", berlin, now.Add(-24*time.Hour))},
+			},
+			wantDecision: entity.RiskDecisionStepUp,
+			wantScore:    60,
+			wantSignals: []entity.RiskSignal{
+				entity.RiskSignalNewDevice, entity.RiskSignalNewIP, entity.RiskSignalNewASN,
+			},
+		},
+		{
+			name:       "travel within max speed",
+			hardwareID: "trusted",
+			ip:         "203.0.113.1",
+			repository: &fakeLoginAttemptRepository{
+				history: []*entity.LoginAttempt{successfulLogin("192.0.2.1", berlin, now.Add(-10*time.Hour))},
+			},
+			wantDecision: entity.RiskDecisionAllow,
+			wantScore:    30,
+			wantSignals:  []entity.RiskSignal{entity.RiskSignalNewIP, entity.RiskSignalNewASN},
+		},
+		{
+			name:       "impossible travel within known network",
+			hardwareID: "trusted",
+			ip:         "203.0.113.1",
+			repository: &fakeLoginAttemptRepository{
+				history: []*entity.LoginAttempt{successfulLogin("203.0.113.2", boston, now.Add(-10*time.Minute))},
+			},
+			wantDecision: entity.RiskDecisionStepUp,
+			wantScore:    70,
+			wantSignals:  []entity.RiskSignal{entity.RiskSignalNewIP, entity.RiskSignalImpossibleTravel},
+		},
+		{
+			name:       "impossible travel from new device is denied",
+			hardwareID: "new",
+			ip:         "203.0.113.1",
+			repository: &fakeLoginAttemptRepository{
+				history: []*entity.LoginAttempt{successfulLogin("192.0.2.1", berlin, now.Add(-time.Hour))},
+			},
+			wantDecision: entity.RiskDecisionDeny,
+			wantScore:    120,
+			wantSignals: []entity.RiskSignal{
+				entity.RiskSignalNewDevice,
+				entity.RiskSignalNewIP,
+				entity.RiskSignalNewASN,
+				entity.RiskSignalImpossibleTravel,
+			},
+		},
+		{
+			name:         "ip failed many accounts",
+			hardwareID:   "trusted",
+			ip:           "192.0.2.1",
+			repository:   &fakeLoginAttemptRepository{failedAccounts: 5},
+			wantDecision: entity.RiskDecisionStepUp,
+			wantScore:    50,
+			wantSignals:  []entity.RiskSignal{entity.RiskSignalIPFailures},
+		},
+		{
+			name:         "ip failed few accounts",
+			hardwareID:   "trusted",
+			ip:           "192.0.2.1",
+			repository:   &fakeLoginAttemptRepository{failedAccounts: 4},
+			wantDecision: entity.RiskDecisionAllow,
+		},
+		{
+			name:         "unavailable history requires step-up",
+			hardwareID:   "trusted",
+			ip:           "192.0.2.1",
+			repository:   &fakeLoginAttemptRepository{historyErr: errUnavailable},
+			wantDecision: entity.RiskDecisionStepUp,
+			wantSignals:  []entity.RiskSignal{entity.RiskSignalIncomplete},
+			wantErr:      true,
+		},
+		{
+			name:         "unavailable ip failures require step-up",
+			hardwareID:   "trusted",
+			ip:           "192.0.2.1",
+			repository:   &fakeLoginAttemptRepository{failedErr: errUnavailable},
+			wantDecision: entity.RiskDecisionStepUp,
+			wantSignals:  []entity.RiskSignal{entity.RiskSignalIncomplete},
+			wantErr:      true,
+		},
+		{
+			name:       "incomplete assessment keeps deny",
+			hardwareID: "new",
+			ip:         "203.0.113.1",
+			repository: &fakeLoginAttemptRepository{
+				history:   []*entity.LoginAttempt{successfulLogin("192.0.2.1", berlin, now.Add(-time.Hour))},
+				failedErr: errUnavailable,
+			},
+			wantDecision: entity.RiskDecisionDeny,
+			wantScore:    120,
+			wantSignals: []entity.RiskSignal{
+				entity.RiskSignalNewDevice,
+				entity.RiskSignalNewIP,
+				entity.RiskSignalNewASN,
+				entity.RiskSignalImpossibleTravel,
+				entity.RiskSignalIncomplete,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				engine := NewRiskEngine(
+					tt.repository,
+					&fakeHardwareIDManager{trusted: []string{"trusted"}},
+					resolver,
+					testRiskConfig(),
+					clock.NewMockClock(now),
+				)
+
+				assessment, err := engine.Assess(
+					context.Background(),
+					entity.NewAccount("alice", "password-hash", "trusted", "digest", clock.NewMockClock(now)),
+					tt.hardwareID,
+					&tt.ip,
+				)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Assess() error = %v, wantErr %v", err, tt.wantErr)
+				}
+
+				if assessment.Decision != tt.wantDecision {
+					t.Errorf("Decision = %s, want %s", assessment.Decision, tt.wantDecision)
+				}
+
+				if assessment.Score != tt.wantScore {
+					t.Errorf("Score = %d, want %d", assessment.Score, tt.wantScore)
+				}
+
+				if !slices.Equal(assessment.Signals, tt.wantSignals) {
+					t.Errorf("Signals = %v, want %v", assessment.Signals, tt.wantSignals)
+				}
+
+				if assessment.Location != resolver[netip.MustParseAddr(tt.ip)] {
+					t.Errorf("Location is not resolved by GeoIP")
+				}
+			},
+		)
+	}
+}
+
+func TestRiskEngine_AssessDisabledOnlyResolvesLocation(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ip := "::ffff:192.0.2.1" // IPv4-mapped addresses are resolved as IPv4
+
+	config := testRiskConfig()
+	config.Enabled = false
+
+	engine := NewRiskEngine(
+		&fakeLoginAttemptRepository{failedAccounts: 100, historyErr: errors.New("unused")},
+		&fakeHardwareIDManager{},
+		fakeGeoIPResolver{netip.MustParseAddr("192.0.2.1"): berlin},
+		config,
+		clock.NewMockClock(now),
+	)
+
+	assessment, err := engine.Assess(
+		context.Background(),
+		entity.NewAccount("alice", "password-hash", "trusted", "digest", clock.NewMockClock(now)),
+		"new",
+		&ip,
+	)
+	if err != nil {
+		t.Fatalf("Assess() error = %v", err)
+	}
+
+	if assessment.Decision != entity.RiskDecisionAllow || assessment.Score != 0 || len(assessment.Signals) != 0 {
+		t.Fatalf("Assess() = %+v, want allow without signals", assessment)
+	}
+
+	if assessment.Location != berlin {
+		t.Fatalf("Location = %+v, want %+v", assessment.Location, berlin)
+	}
+}
+
+func TestRiskEngine_IsImpossibleTravel(t *testing.T) {
+	engine := &riskEngine{config: testRiskConfig()}
+
+	tests := []struct {
+		name     string
+		from, to entity.IPLocation
+		elapsed  time.Duration
+		want     bool
+	}{
+		{name: "same city at once", from: berlin, to: potsdam, elapsed: 0, want: false},
+		{name: "another country at once", from: berlin, to: paris, elapsed: 0, want: true},
+		{name: "faster than max speed", from: berlin, to: paris, elapsed: 30 * time.Minute, want: true},
+		{name: "slower than max speed", from: berlin, to: paris, elapsed: 2 * time.Hour, want: false},
+		{name: "across the ocean by plane", from: berlin, to: newYork, elapsed: 8 * time.Hour, want: false},
+		{name: "across the ocean in an hour", from: newYork, to: berlin, elapsed: time.Hour, want: true},
+		{name: "neighbour city in ten minutes", from: boston, to: newYork, elapsed: 10 * time.Minute, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := engine.isImpossibleTravel(tt.from, tt.to, tt.elapsed); got != tt.want {
+					t.Fatalf("isImpossibleTravel() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestHaversineDistance(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to entity.IPLocation
+		want     float64 // km
+	}{
+		{name: "same point", from: berlin, to: berlin, want: 0},
+		{name: "Berlin - Potsdam", from: berlin, to: potsdam, want: 27},
+		{name: "Berlin - Paris", from: berlin, to: paris, want: 878},
+		{name: "Paris - Berlin", from: paris, to: berlin, want: 878},
+		{name: "Berlin - New York", from: berlin, to: newYork, want: 6385},
+		{name: "Boston - New York", from: boston, to: newYork, want: 306},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := haversineDistance(*tt.from.Latitude, *tt.from.Longitude, *tt.to.Latitude, *tt.to.Longitude)
+				if math.Abs(got-tt.want) > 5 { //nolint:mnd // km, spherical Earth model is ~0.5% off
+					t.Fatalf("haversineDistance() = %.1f, want %.0f", got, tt.want)
+				}
+			},
+		)
+	}
+}
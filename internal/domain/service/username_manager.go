@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/pkg/clock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	ErrUsernameReserved = status.Error(codes.AlreadyExists, "username is reserved")
+	ErrUsernameRenamed  = status.Error(codes.NotFound, "username was changed by its owner")
+)
+
+type UsernameConfig struct {
+	ChangeCooldown time.Duration `env:"USERNAME_CHANGE_COOLDOWN" env-default:"720h"`
+	// ReservationPeriod is how long previous username can't be taken by other accounts.
+	ReservationPeriod time.Duration `env:"USERNAME_RESERVATION_PERIOD" env-default:"2160h"`
+}
+
+type UsernameManager interface {
+	// EnsureUsernameAvailable checks that username is not reserved by another account after rename.
+	// accountID is 0 for account which is not created yet.
+	EnsureUsernameAvailable(ctx context.Context, accountID entity.AccountID, username entity.Username) error
+	// FindAccountByUsername finds active account, ErrUsernameRenamed is returned for previous usernames
+	// while they are reserved, so rename history isn't disclosed after that.
+	FindAccountByUsername(ctx context.Context, username entity.Username) (*entity.Account, error)
+	// ChangeUsername renames account and records previous username in history, must be called in transaction.
+	ChangeUsername(ctx context.Context, account *entity.Account, username entity.Username) error
+}
+
+type usernameManager struct {
+	accountRepository        repository.AccountRepository
+	usernameChangeRepository repository.UsernameChangeRepository
+	config                   UsernameConfig
+	clock                    clock.Clock
+}
+
+func NewUsernameManager(
+	accountRepository repository.AccountRepository,
+	usernameChangeRepository repository.UsernameChangeRepository,
+	config UsernameConfig,
+	clock clock.Clock,
+) UsernameManager {
+	return &usernameManager{
+		accountRepository:        accountRepository,
+		usernameChangeRepository: usernameChangeRepository,
+		config:                   config,
+		clock:                    clock,
+	}
+}
+
+func (m *usernameManager) EnsureUsernameAvailable(
+	ctx context.Context,
+	accountID entity.AccountID,
+	username entity.Username,
+) error {
+	change, err := m.usernameChangeRepository.FindLatestByUsername(ctx, username)
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if change.IsReservedFor(accountID, m.clock) {
+		return ErrUsernameReserved
+	}
+
+	return nil
+}
+
+func (m *usernameManager) FindAccountByUsername(ctx context.Context, username entity.Username) (
+	*entity.Account,
+	error,
+) {
+	account, err := m.accountRepository.FindByLowerUsername(ctx, username)
+	if status.Code(err) != codes.NotFound {
+		return account, err
+	}
+
+	change, historyErr := m.usernameChangeRepository.FindLatestByUsername(ctx, username)
+
+	switch {
+	case historyErr == nil:
+		if change.IsReserved(m.clock) {
+			return nil, ErrUsernameRenamed
+		}
+	case status.Code(historyErr) != codes.NotFound:
+		return nil, historyErr
+	}
+
+	return nil, err
+}
+
+func (m *usernameManager) ChangeUsername(
+	ctx context.Context,
+	account *entity.Account,
+	username entity.Username,
+) error {
+	previous := entity.Username(account.Username())
+
+	// case-only changes keep the same canonical username, nothing to reserve
+	if previous.Canonical() != username.Canonical() {
+		if err := m.EnsureUsernameAvailable(ctx, entity.AccountID(account.ID()), username); err != nil {
+			return err
+		}
+	}
+
+	if err := account.ChangeUsername(username, m.config.ChangeCooldown, m.clock); err != nil {
+		return err
+	}
+
+	// username taken by active account violates unique index: repository.ErrAccountAlreadyExists
+	if err := m.accountRepository.Update(ctx, account); err != nil {
+		return err
+	}
+
+	_, err := m.usernameChangeRepository.Create(
+		ctx,
+		entity.NewUsernameChange(entity.AccountID(account.ID()), previous, m.config.ReservationPeriod, m.clock),
+	)
+
+	return err
+}
@@ -19,22 +19,107 @@ type Account struct {
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
 	// Username holds the value of the "username" field.
-	Username string `json:"username,omitempty"`
+	Username *string `json:"username,omitempty"`
+	// UsernameCanonical holds the value of the "username_canonical" field.
+	UsernameCanonical *string `json:"username_canonical,omitempty"`
+	// Email holds the value of the "email" field.
+	Email *string `json:"email,omitempty"`
+	// EmailCanonical holds the value of the "email_canonical" field.
+	EmailCanonical *string `json:"email_canonical,omitempty"`
+	// EmailVerifiedAt holds the value of the "email_verified_at" field.
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// UsernameChangedAt holds the value of the "username_changed_at" field.
+	UsernameChangedAt *time.Time `json:"username_changed_at,omitempty"`
 	// Password holds the value of the "password" field.
-	Password string `json:"-"`
+	Password *string `json:"-"`
 	// HardwareID holds the value of the "hardware_id" field.
 	HardwareID *string `json:"-"`
+	// HardwareIDDigest holds the value of the "hardware_id_digest" field.
+	HardwareIDDigest *string `json:"-"`
 	// AccessLevel holds the value of the "access_level" field.
 	AccessLevel domain.AccessLevel `json:"access_level,omitempty"`
+	// LastLoginAt holds the value of the "last_login_at" field.
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// BannedUntil holds the value of the "banned_until" field.
 	BannedUntil *time.Time `json:"banned_until,omitempty"`
 	// BanReason holds the value of the "ban_reason" field.
-	BanReason    *string `json:"ban_reason,omitempty"`
+	BanReason *string `json:"ban_reason,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ErasedAt holds the value of the "erased_at" field.
+	ErasedAt *time.Time `json:"erased_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountQuery when eager-loading is set.
+	Edges        AccountEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// AccountEdges holds the relations/edges for other nodes in the graph.
+type AccountEdges struct {
+	// Devices holds the value of the devices edge.
+	Devices []*Device `json:"devices,omitempty"`
+	// AuditEntries holds the value of the audit_entries edge.
+	AuditEntries []*AuditEntry `json:"audit_entries,omitempty"`
+	// UsernameChanges holds the value of the username_changes edge.
+	UsernameChanges []*UsernameChange `json:"username_changes,omitempty"`
+	// EmailVerifications holds the value of the email_verifications edge.
+	EmailVerifications []*EmailVerification `json:"email_verifications,omitempty"`
+	// LoginAttempts holds the value of the login_attempts edge.
+	LoginAttempts []*LoginAttempt `json:"login_attempts,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// DevicesOrErr returns the Devices value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) DevicesOrErr() ([]*Device, error) {
+	if e.loadedTypes[0] {
+		return e.Devices, nil
+	}
+	return nil, &NotLoadedError{edge: "devices"}
+}
+
+// AuditEntriesOrErr returns the AuditEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) AuditEntriesOrErr() ([]*AuditEntry, error) {
+	if e.loadedTypes[1] {
+		return e.AuditEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_entries"}
+}
+
+// UsernameChangesOrErr returns the UsernameChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) UsernameChangesOrErr() ([]*UsernameChange, error) {
+	if e.loadedTypes[2] {
+		return e.UsernameChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "username_changes"}
+}
+
+// EmailVerificationsOrErr returns the EmailVerifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) EmailVerificationsOrErr() ([]*EmailVerification, error) {
+	if e.loadedTypes[3] {
+		return e.EmailVerifications, nil
+	}
+	return nil, &NotLoadedError{edge: "email_verifications"}
+}
+
+// LoginAttemptsOrErr returns the LoginAttempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) LoginAttemptsOrErr() ([]*LoginAttempt, error) {
+	if e.loadedTypes[4] {
+		return e.LoginAttempts, nil
+	}
+	return nil, &NotLoadedError{edge: "login_attempts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Account) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -42,11 +127,11 @@ func (*Account) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case account.FieldAccessLevel:
 			values[i] = new(domain.AccessLevel)
-		case account.FieldID:
+		case account.FieldID, account.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case account.FieldUsername, account.FieldPassword, account.FieldHardwareID, account.FieldBanReason:
+		case account.FieldUsername, account.FieldUsernameCanonical, account.FieldEmail, account.FieldEmailCanonical, account.FieldPassword, account.FieldHardwareID, account.FieldHardwareIDDigest, account.FieldBanReason:
 			values[i] = new(sql.NullString)
-		case account.FieldCreatedAt, account.FieldBannedUntil:
+		case account.FieldEmailVerifiedAt, account.FieldUsernameChangedAt, account.FieldLastLoginAt, account.FieldCreatedAt, account.FieldBannedUntil, account.FieldDeletedAt, account.FieldErasedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -73,13 +158,50 @@ func (a *Account) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field username", values[i])
 			} else if value.Valid {
-				a.Username = value.String
+				a.Username = new(string)
+				*a.Username = value.String
+			}
+		case account.FieldUsernameCanonical:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username_canonical", values[i])
+			} else if value.Valid {
+				a.UsernameCanonical = new(string)
+				*a.UsernameCanonical = value.String
+			}
+		case account.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				a.Email = new(string)
+				*a.Email = value.String
+			}
+		case account.FieldEmailCanonical:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email_canonical", values[i])
+			} else if value.Valid {
+				a.EmailCanonical = new(string)
+				*a.EmailCanonical = value.String
+			}
+		case account.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				a.EmailVerifiedAt = new(time.Time)
+				*a.EmailVerifiedAt = value.Time
+			}
+		case account.FieldUsernameChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field username_changed_at", values[i])
+			} else if value.Valid {
+				a.UsernameChangedAt = new(time.Time)
+				*a.UsernameChangedAt = value.Time
 			}
 		case account.FieldPassword:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field password", values[i])
 			} else if value.Valid {
-				a.Password = value.String
+				a.Password = new(string)
+				*a.Password = value.String
 			}
 		case account.FieldHardwareID:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -88,12 +210,26 @@ func (a *Account) assignValues(columns []string, values []any) error {
 				a.HardwareID = new(string)
 				*a.HardwareID = value.String
 			}
+		case account.FieldHardwareIDDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware_id_digest", values[i])
+			} else if value.Valid {
+				a.HardwareIDDigest = new(string)
+				*a.HardwareIDDigest = value.String
+			}
 		case account.FieldAccessLevel:
 			if value, ok := values[i].(*domain.AccessLevel); !ok {
 				return fmt.Errorf("unexpected type %T for field access_level", values[i])
 			} else if value != nil {
 				a.AccessLevel = *value
 			}
+		case account.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				a.LastLoginAt = new(time.Time)
+				*a.LastLoginAt = value.Time
+			}
 		case account.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -114,6 +250,26 @@ func (a *Account) assignValues(columns []string, values []any) error {
 				a.BanReason = new(string)
 				*a.BanReason = value.String
 			}
+		case account.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				a.DeletedAt = new(time.Time)
+				*a.DeletedAt = value.Time
+			}
+		case account.FieldErasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field erased_at", values[i])
+			} else if value.Valid {
+				a.ErasedAt = new(time.Time)
+				*a.ErasedAt = value.Time
+			}
+		case account.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				a.Version = int(value.Int64)
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -127,6 +283,31 @@ func (a *Account) Value(name string) (ent.Value, error) {
 	return a.selectValues.Get(name)
 }
 
+// QueryDevices queries the "devices" edge of the Account entity.
+func (a *Account) QueryDevices() *DeviceQuery {
+	return NewAccountClient(a.config).QueryDevices(a)
+}
+
+// QueryAuditEntries queries the "audit_entries" edge of the Account entity.
+func (a *Account) QueryAuditEntries() *AuditEntryQuery {
+	return NewAccountClient(a.config).QueryAuditEntries(a)
+}
+
+// QueryUsernameChanges queries the "username_changes" edge of the Account entity.
+func (a *Account) QueryUsernameChanges() *UsernameChangeQuery {
+	return NewAccountClient(a.config).QueryUsernameChanges(a)
+}
+
+// QueryEmailVerifications queries the "email_verifications" edge of the Account entity.
+func (a *Account) QueryEmailVerifications() *EmailVerificationQuery {
+	return NewAccountClient(a.config).QueryEmailVerifications(a)
+}
+
+// QueryLoginAttempts queries the "login_attempts" edge of the Account entity.
+func (a *Account) QueryLoginAttempts() *LoginAttemptQuery {
+	return NewAccountClient(a.config).QueryLoginAttempts(a)
+}
+
 // Update returns a builder for updating this Account.
 // Note that you need to call Account.Unwrap() before calling this method if this Account
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -150,16 +331,50 @@ func (a *Account) String() string {
 	var builder strings.Builder
 	builder.WriteString("Account(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
-	builder.WriteString("username=")
-	builder.WriteString(a.Username)
+	if v := a.Username; v != nil {
+		builder.WriteString("username=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := a.UsernameCanonical; v != nil {
+		builder.WriteString("username_canonical=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := a.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := a.EmailCanonical; v != nil {
+		builder.WriteString("email_canonical=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := a.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := a.UsernameChangedAt; v != nil {
+		builder.WriteString("username_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("hardware_id=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("hardware_id_digest=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("access_level=")
 	builder.WriteString(fmt.Sprintf("%v", a.AccessLevel))
 	builder.WriteString(", ")
+	if v := a.LastLoginAt; v != nil {
+		builder.WriteString("last_login_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -172,6 +387,19 @@ func (a *Account) String() string {
 		builder.WriteString("ban_reason=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := a.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := a.ErasedAt; v != nil {
+		builder.WriteString("erased_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", a.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	domain "github.com/intezya/auth_service/internal/domain/account"
 )
 
@@ -16,32 +17,107 @@ const (
 	FieldID = "id"
 	// FieldUsername holds the string denoting the username field in the database.
 	FieldUsername = "username"
+	// FieldUsernameCanonical holds the string denoting the username_canonical field in the database.
+	FieldUsernameCanonical = "username_canonical"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldEmailCanonical holds the string denoting the email_canonical field in the database.
+	FieldEmailCanonical = "email_canonical"
+	// FieldEmailVerifiedAt holds the string denoting the email_verified_at field in the database.
+	FieldEmailVerifiedAt = "email_verified_at"
+	// FieldUsernameChangedAt holds the string denoting the username_changed_at field in the database.
+	FieldUsernameChangedAt = "username_changed_at"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
 	// FieldHardwareID holds the string denoting the hardware_id field in the database.
 	FieldHardwareID = "hardware_id"
+	// FieldHardwareIDDigest holds the string denoting the hardware_id_digest field in the database.
+	FieldHardwareIDDigest = "hardware_id_digest"
 	// FieldAccessLevel holds the string denoting the access_level field in the database.
 	FieldAccessLevel = "access_level"
+	// FieldLastLoginAt holds the string denoting the last_login_at field in the database.
+	FieldLastLoginAt = "last_login_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldBannedUntil holds the string denoting the banned_until field in the database.
 	FieldBannedUntil = "banned_until"
 	// FieldBanReason holds the string denoting the ban_reason field in the database.
 	FieldBanReason = "ban_reason"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldErasedAt holds the string denoting the erased_at field in the database.
+	FieldErasedAt = "erased_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// EdgeDevices holds the string denoting the devices edge name in mutations.
+	EdgeDevices = "devices"
+	// EdgeAuditEntries holds the string denoting the audit_entries edge name in mutations.
+	EdgeAuditEntries = "audit_entries"
+	// EdgeUsernameChanges holds the string denoting the username_changes edge name in mutations.
+	EdgeUsernameChanges = "username_changes"
+	// EdgeEmailVerifications holds the string denoting the email_verifications edge name in mutations.
+	EdgeEmailVerifications = "email_verifications"
+	// EdgeLoginAttempts holds the string denoting the login_attempts edge name in mutations.
+	EdgeLoginAttempts = "login_attempts"
 	// Table holds the table name of the account in the database.
 	Table = "accounts"
+	// DevicesTable is the table that holds the devices relation/edge.
+	DevicesTable = "devices"
+	// DevicesInverseTable is the table name for the Device entity.
+	// It exists in this package in order to avoid circular dependency with the "device" package.
+	DevicesInverseTable = "devices"
+	// DevicesColumn is the table column denoting the devices relation/edge.
+	DevicesColumn = "account_id"
+	// AuditEntriesTable is the table that holds the audit_entries relation/edge.
+	AuditEntriesTable = "audit_entries"
+	// AuditEntriesInverseTable is the table name for the AuditEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "auditentry" package.
+	AuditEntriesInverseTable = "audit_entries"
+	// AuditEntriesColumn is the table column denoting the audit_entries relation/edge.
+	AuditEntriesColumn = "account_id"
+	// UsernameChangesTable is the table that holds the username_changes relation/edge.
+	UsernameChangesTable = "username_changes"
+	// UsernameChangesInverseTable is the table name for the UsernameChange entity.
+	// It exists in this package in order to avoid circular dependency with the "usernamechange" package.
+	UsernameChangesInverseTable = "username_changes"
+	// UsernameChangesColumn is the table column denoting the username_changes relation/edge.
+	UsernameChangesColumn = "account_id"
+	// EmailVerificationsTable is the table that holds the email_verifications relation/edge.
+	EmailVerificationsTable = "email_verifications"
+	// EmailVerificationsInverseTable is the table name for the EmailVerification entity.
+	// It exists in this package in order to avoid circular dependency with the "emailverification" package.
+	EmailVerificationsInverseTable = "email_verifications"
+	// EmailVerificationsColumn is the table column denoting the email_verifications relation/edge.
+	EmailVerificationsColumn = "account_id"
+	// LoginAttemptsTable is the table that holds the login_attempts relation/edge.
+	LoginAttemptsTable = "login_attempts"
+	// LoginAttemptsInverseTable is the table name for the LoginAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "loginattempt" package.
+	LoginAttemptsInverseTable = "login_attempts"
+	// LoginAttemptsColumn is the table column denoting the login_attempts relation/edge.
+	LoginAttemptsColumn = "account_id"
 )
 
 // Columns holds all SQL columns for account fields.
 var Columns = []string{
 	FieldID,
 	FieldUsername,
+	FieldUsernameCanonical,
+	FieldEmail,
+	FieldEmailCanonical,
+	FieldEmailVerifiedAt,
+	FieldUsernameChangedAt,
 	FieldPassword,
 	FieldHardwareID,
+	FieldHardwareIDDigest,
 	FieldAccessLevel,
+	FieldLastLoginAt,
 	FieldCreatedAt,
 	FieldBannedUntil,
 	FieldBanReason,
+	FieldDeletedAt,
+	FieldErasedAt,
+	FieldVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -57,12 +133,20 @@ func ValidColumn(column string) bool {
 var (
 	// UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	UsernameValidator func(string) error
+	// UsernameCanonicalValidator is a validator for the "username_canonical" field. It is called by the builders before save.
+	UsernameCanonicalValidator func(string) error
+	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	EmailValidator func(string) error
+	// EmailCanonicalValidator is a validator for the "email_canonical" field. It is called by the builders before save.
+	EmailCanonicalValidator func(string) error
 	// PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	PasswordValidator func(string) error
 	// DefaultAccessLevel holds the default value on creation for the "access_level" field.
 	DefaultAccessLevel func() domain.AccessLevel
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 )
 
 // OrderOption defines the ordering options for the Account queries.
@@ -78,6 +162,31 @@ func ByUsername(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsername, opts...).ToFunc()
 }
 
+// ByUsernameCanonical orders the results by the username_canonical field.
+func ByUsernameCanonical(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsernameCanonical, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByEmailCanonical orders the results by the email_canonical field.
+func ByEmailCanonical(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailCanonical, opts...).ToFunc()
+}
+
+// ByEmailVerifiedAt orders the results by the email_verified_at field.
+func ByEmailVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerifiedAt, opts...).ToFunc()
+}
+
+// ByUsernameChangedAt orders the results by the username_changed_at field.
+func ByUsernameChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsernameChangedAt, opts...).ToFunc()
+}
+
 // ByPassword orders the results by the password field.
 func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPassword, opts...).ToFunc()
@@ -88,11 +197,21 @@ func ByHardwareID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHardwareID, opts...).ToFunc()
 }
 
+// ByHardwareIDDigest orders the results by the hardware_id_digest field.
+func ByHardwareIDDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHardwareIDDigest, opts...).ToFunc()
+}
+
 // ByAccessLevel orders the results by the access_level field.
 func ByAccessLevel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccessLevel, opts...).ToFunc()
 }
 
+// ByLastLoginAt orders the results by the last_login_at field.
+func ByLastLoginAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastLoginAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -107,3 +226,123 @@ func ByBannedUntil(opts ...sql.OrderTermOption) OrderOption {
 func ByBanReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBanReason, opts...).ToFunc()
 }
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByErasedAt orders the results by the erased_at field.
+func ByErasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErasedAt, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByDevicesCount orders the results by devices count.
+func ByDevicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDevicesStep(), opts...)
+	}
+}
+
+// ByDevices orders the results by devices terms.
+func ByDevices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDevicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAuditEntriesCount orders the results by audit_entries count.
+func ByAuditEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuditEntriesStep(), opts...)
+	}
+}
+
+// ByAuditEntries orders the results by audit_entries terms.
+func ByAuditEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuditEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUsernameChangesCount orders the results by username_changes count.
+func ByUsernameChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUsernameChangesStep(), opts...)
+	}
+}
+
+// ByUsernameChanges orders the results by username_changes terms.
+func ByUsernameChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsernameChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmailVerificationsCount orders the results by email_verifications count.
+func ByEmailVerificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailVerificationsStep(), opts...)
+	}
+}
+
+// ByEmailVerifications orders the results by email_verifications terms.
+func ByEmailVerifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailVerificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLoginAttemptsCount orders the results by login_attempts count.
+func ByLoginAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLoginAttemptsStep(), opts...)
+	}
+}
+
+// ByLoginAttempts orders the results by login_attempts terms.
+func ByLoginAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoginAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newDevicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DevicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DevicesTable, DevicesColumn),
+	)
+}
+func newAuditEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuditEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuditEntriesTable, AuditEntriesColumn),
+	)
+}
+func newUsernameChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsernameChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UsernameChangesTable, UsernameChangesColumn),
+	)
+}
+func newEmailVerificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailVerificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmailVerificationsTable, EmailVerificationsColumn),
+	)
+}
+func newLoginAttemptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoginAttemptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LoginAttemptsTable, LoginAttemptsColumn),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	domain "github.com/intezya/auth_service/internal/domain/account"
 	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
 )
@@ -60,6 +61,31 @@ func Username(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldUsername, v))
 }
 
+// UsernameCanonical applies equality check predicate on the "username_canonical" field. It's identical to UsernameCanonicalEQ.
+func UsernameCanonical(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldUsernameCanonical, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailCanonical applies equality check predicate on the "email_canonical" field. It's identical to EmailCanonicalEQ.
+func EmailCanonical(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldEmailCanonical, v))
+}
+
+// EmailVerifiedAt applies equality check predicate on the "email_verified_at" field. It's identical to EmailVerifiedAtEQ.
+func EmailVerifiedAt(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldEmailVerifiedAt, v))
+}
+
+// UsernameChangedAt applies equality check predicate on the "username_changed_at" field. It's identical to UsernameChangedAtEQ.
+func UsernameChangedAt(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldUsernameChangedAt, v))
+}
+
 // Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
 func Password(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldPassword, v))
@@ -70,11 +96,21 @@ func HardwareID(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldHardwareID, v))
 }
 
+// HardwareIDDigest applies equality check predicate on the "hardware_id_digest" field. It's identical to HardwareIDDigestEQ.
+func HardwareIDDigest(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldHardwareIDDigest, v))
+}
+
 // AccessLevel applies equality check predicate on the "access_level" field. It's identical to AccessLevelEQ.
 func AccessLevel(v domain.AccessLevel) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldAccessLevel, v))
 }
 
+// LastLoginAt applies equality check predicate on the "last_login_at" field. It's identical to LastLoginAtEQ.
+func LastLoginAt(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldLastLoginAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
@@ -90,6 +126,21 @@ func BanReason(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldBanReason, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ErasedAt applies equality check predicate on the "erased_at" field. It's identical to ErasedAtEQ.
+func ErasedAt(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldErasedAt, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldVersion, v))
+}
+
 // UsernameEQ applies the EQ predicate on the "username" field.
 func UsernameEQ(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldUsername, v))
@@ -145,6 +196,16 @@ func UsernameHasSuffix(v string) predicate.Account {
 	return predicate.Account(sql.FieldHasSuffix(FieldUsername, v))
 }
 
+// UsernameIsNil applies the IsNil predicate on the "username" field.
+func UsernameIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldUsername))
+}
+
+// UsernameNotNil applies the NotNil predicate on the "username" field.
+func UsernameNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldUsername))
+}
+
 // UsernameEqualFold applies the EqualFold predicate on the "username" field.
 func UsernameEqualFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldEqualFold(FieldUsername, v))
@@ -155,6 +216,331 @@ func UsernameContainsFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldUsername, v))
 }
 
+// UsernameCanonicalEQ applies the EQ predicate on the "username_canonical" field.
+func UsernameCanonicalEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalNEQ applies the NEQ predicate on the "username_canonical" field.
+func UsernameCanonicalNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalIn applies the In predicate on the "username_canonical" field.
+func UsernameCanonicalIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldUsernameCanonical, vs...))
+}
+
+// UsernameCanonicalNotIn applies the NotIn predicate on the "username_canonical" field.
+func UsernameCanonicalNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldUsernameCanonical, vs...))
+}
+
+// UsernameCanonicalGT applies the GT predicate on the "username_canonical" field.
+func UsernameCanonicalGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalGTE applies the GTE predicate on the "username_canonical" field.
+func UsernameCanonicalGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalLT applies the LT predicate on the "username_canonical" field.
+func UsernameCanonicalLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalLTE applies the LTE predicate on the "username_canonical" field.
+func UsernameCanonicalLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalContains applies the Contains predicate on the "username_canonical" field.
+func UsernameCanonicalContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalHasPrefix applies the HasPrefix predicate on the "username_canonical" field.
+func UsernameCanonicalHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalHasSuffix applies the HasSuffix predicate on the "username_canonical" field.
+func UsernameCanonicalHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalIsNil applies the IsNil predicate on the "username_canonical" field.
+func UsernameCanonicalIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldUsernameCanonical))
+}
+
+// UsernameCanonicalNotNil applies the NotNil predicate on the "username_canonical" field.
+func UsernameCanonicalNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldUsernameCanonical))
+}
+
+// UsernameCanonicalEqualFold applies the EqualFold predicate on the "username_canonical" field.
+func UsernameCanonicalEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalContainsFold applies the ContainsFold predicate on the "username_canonical" field.
+func UsernameCanonicalContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldUsernameCanonical, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// EmailCanonicalEQ applies the EQ predicate on the "email_canonical" field.
+func EmailCanonicalEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldEmailCanonical, v))
+}
+
+// EmailCanonicalNEQ applies the NEQ predicate on the "email_canonical" field.
+func EmailCanonicalNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldEmailCanonical, v))
+}
+
+// EmailCanonicalIn applies the In predicate on the "email_canonical" field.
+func EmailCanonicalIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldEmailCanonical, vs...))
+}
+
+// EmailCanonicalNotIn applies the NotIn predicate on the "email_canonical" field.
+func EmailCanonicalNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldEmailCanonical, vs...))
+}
+
+// EmailCanonicalGT applies the GT predicate on the "email_canonical" field.
+func EmailCanonicalGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldEmailCanonical, v))
+}
+
+// EmailCanonicalGTE applies the GTE predicate on the "email_canonical" field.
+func EmailCanonicalGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldEmailCanonical, v))
+}
+
+// EmailCanonicalLT applies the LT predicate on the "email_canonical" field.
+func EmailCanonicalLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldEmailCanonical, v))
+}
+
+// EmailCanonicalLTE applies the LTE predicate on the "email_canonical" field.
+func EmailCanonicalLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldEmailCanonical, v))
+}
+
+// EmailCanonicalContains applies the Contains predicate on the "email_canonical" field.
+func EmailCanonicalContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldEmailCanonical, v))
+}
+
+// EmailCanonicalHasPrefix applies the HasPrefix predicate on the "email_canonical" field.
+func EmailCanonicalHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldEmailCanonical, v))
+}
+
+// EmailCanonicalHasSuffix applies the HasSuffix predicate on the "email_canonical" field.
+func EmailCanonicalHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldEmailCanonical, v))
+}
+
+// EmailCanonicalIsNil applies the IsNil predicate on the "email_canonical" field.
+func EmailCanonicalIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldEmailCanonical))
+}
+
+// EmailCanonicalNotNil applies the NotNil predicate on the "email_canonical" field.
+func EmailCanonicalNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldEmailCanonical))
+}
+
+// EmailCanonicalEqualFold applies the EqualFold predicate on the "email_canonical" field.
+func EmailCanonicalEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldEmailCanonical, v))
+}
+
+// EmailCanonicalContainsFold applies the ContainsFold predicate on the "email_canonical" field.
+func EmailCanonicalContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldEmailCanonical, v))
+}
+
+// EmailVerifiedAtEQ applies the EQ predicate on the "email_verified_at" field.
+func EmailVerifiedAtEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtNEQ applies the NEQ predicate on the "email_verified_at" field.
+func EmailVerifiedAtNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtIn applies the In predicate on the "email_verified_at" field.
+func EmailVerifiedAtIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldEmailVerifiedAt, vs...))
+}
+
+// EmailVerifiedAtNotIn applies the NotIn predicate on the "email_verified_at" field.
+func EmailVerifiedAtNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldEmailVerifiedAt, vs...))
+}
+
+// EmailVerifiedAtGT applies the GT predicate on the "email_verified_at" field.
+func EmailVerifiedAtGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtGTE applies the GTE predicate on the "email_verified_at" field.
+func EmailVerifiedAtGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtLT applies the LT predicate on the "email_verified_at" field.
+func EmailVerifiedAtLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtLTE applies the LTE predicate on the "email_verified_at" field.
+func EmailVerifiedAtLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtIsNil applies the IsNil predicate on the "email_verified_at" field.
+func EmailVerifiedAtIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldEmailVerifiedAt))
+}
+
+// EmailVerifiedAtNotNil applies the NotNil predicate on the "email_verified_at" field.
+func EmailVerifiedAtNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldEmailVerifiedAt))
+}
+
+// UsernameChangedAtEQ applies the EQ predicate on the "username_changed_at" field.
+func UsernameChangedAtEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtNEQ applies the NEQ predicate on the "username_changed_at" field.
+func UsernameChangedAtNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtIn applies the In predicate on the "username_changed_at" field.
+func UsernameChangedAtIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldUsernameChangedAt, vs...))
+}
+
+// UsernameChangedAtNotIn applies the NotIn predicate on the "username_changed_at" field.
+func UsernameChangedAtNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldUsernameChangedAt, vs...))
+}
+
+// UsernameChangedAtGT applies the GT predicate on the "username_changed_at" field.
+func UsernameChangedAtGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtGTE applies the GTE predicate on the "username_changed_at" field.
+func UsernameChangedAtGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtLT applies the LT predicate on the "username_changed_at" field.
+func UsernameChangedAtLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtLTE applies the LTE predicate on the "username_changed_at" field.
+func UsernameChangedAtLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldUsernameChangedAt, v))
+}
+
+// UsernameChangedAtIsNil applies the IsNil predicate on the "username_changed_at" field.
+func UsernameChangedAtIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldUsernameChangedAt))
+}
+
+// UsernameChangedAtNotNil applies the NotNil predicate on the "username_changed_at" field.
+func UsernameChangedAtNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldUsernameChangedAt))
+}
+
 // PasswordEQ applies the EQ predicate on the "password" field.
 func PasswordEQ(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldPassword, v))
@@ -210,6 +596,16 @@ func PasswordHasSuffix(v string) predicate.Account {
 	return predicate.Account(sql.FieldHasSuffix(FieldPassword, v))
 }
 
+// PasswordIsNil applies the IsNil predicate on the "password" field.
+func PasswordIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldPassword))
+}
+
+// PasswordNotNil applies the NotNil predicate on the "password" field.
+func PasswordNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldPassword))
+}
+
 // PasswordEqualFold applies the EqualFold predicate on the "password" field.
 func PasswordEqualFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldEqualFold(FieldPassword, v))
@@ -295,6 +691,81 @@ func HardwareIDContainsFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldHardwareID, v))
 }
 
+// HardwareIDDigestEQ applies the EQ predicate on the "hardware_id_digest" field.
+func HardwareIDDigestEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestNEQ applies the NEQ predicate on the "hardware_id_digest" field.
+func HardwareIDDigestNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestIn applies the In predicate on the "hardware_id_digest" field.
+func HardwareIDDigestIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldHardwareIDDigest, vs...))
+}
+
+// HardwareIDDigestNotIn applies the NotIn predicate on the "hardware_id_digest" field.
+func HardwareIDDigestNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldHardwareIDDigest, vs...))
+}
+
+// HardwareIDDigestGT applies the GT predicate on the "hardware_id_digest" field.
+func HardwareIDDigestGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestGTE applies the GTE predicate on the "hardware_id_digest" field.
+func HardwareIDDigestGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestLT applies the LT predicate on the "hardware_id_digest" field.
+func HardwareIDDigestLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestLTE applies the LTE predicate on the "hardware_id_digest" field.
+func HardwareIDDigestLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestContains applies the Contains predicate on the "hardware_id_digest" field.
+func HardwareIDDigestContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestHasPrefix applies the HasPrefix predicate on the "hardware_id_digest" field.
+func HardwareIDDigestHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestHasSuffix applies the HasSuffix predicate on the "hardware_id_digest" field.
+func HardwareIDDigestHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestIsNil applies the IsNil predicate on the "hardware_id_digest" field.
+func HardwareIDDigestIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldHardwareIDDigest))
+}
+
+// HardwareIDDigestNotNil applies the NotNil predicate on the "hardware_id_digest" field.
+func HardwareIDDigestNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldHardwareIDDigest))
+}
+
+// HardwareIDDigestEqualFold applies the EqualFold predicate on the "hardware_id_digest" field.
+func HardwareIDDigestEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestContainsFold applies the ContainsFold predicate on the "hardware_id_digest" field.
+func HardwareIDDigestContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldHardwareIDDigest, v))
+}
+
 // AccessLevelEQ applies the EQ predicate on the "access_level" field.
 func AccessLevelEQ(v domain.AccessLevel) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldAccessLevel, v))
@@ -365,6 +836,56 @@ func AccessLevelContainsFold(v domain.AccessLevel) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldAccessLevel, vc))
 }
 
+// LastLoginAtEQ applies the EQ predicate on the "last_login_at" field.
+func LastLoginAtEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldLastLoginAt, v))
+}
+
+// LastLoginAtNEQ applies the NEQ predicate on the "last_login_at" field.
+func LastLoginAtNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldLastLoginAt, v))
+}
+
+// LastLoginAtIn applies the In predicate on the "last_login_at" field.
+func LastLoginAtIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldLastLoginAt, vs...))
+}
+
+// LastLoginAtNotIn applies the NotIn predicate on the "last_login_at" field.
+func LastLoginAtNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldLastLoginAt, vs...))
+}
+
+// LastLoginAtGT applies the GT predicate on the "last_login_at" field.
+func LastLoginAtGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldLastLoginAt, v))
+}
+
+// LastLoginAtGTE applies the GTE predicate on the "last_login_at" field.
+func LastLoginAtGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldLastLoginAt, v))
+}
+
+// LastLoginAtLT applies the LT predicate on the "last_login_at" field.
+func LastLoginAtLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldLastLoginAt, v))
+}
+
+// LastLoginAtLTE applies the LTE predicate on the "last_login_at" field.
+func LastLoginAtLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldLastLoginAt, v))
+}
+
+// LastLoginAtIsNil applies the IsNil predicate on the "last_login_at" field.
+func LastLoginAtIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldLastLoginAt))
+}
+
+// LastLoginAtNotNil applies the NotNil predicate on the "last_login_at" field.
+func LastLoginAtNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldLastLoginAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
@@ -530,6 +1051,261 @@ func BanReasonContainsFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldBanReason, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ErasedAtEQ applies the EQ predicate on the "erased_at" field.
+func ErasedAtEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldErasedAt, v))
+}
+
+// ErasedAtNEQ applies the NEQ predicate on the "erased_at" field.
+func ErasedAtNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldErasedAt, v))
+}
+
+// ErasedAtIn applies the In predicate on the "erased_at" field.
+func ErasedAtIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldErasedAt, vs...))
+}
+
+// ErasedAtNotIn applies the NotIn predicate on the "erased_at" field.
+func ErasedAtNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldErasedAt, vs...))
+}
+
+// ErasedAtGT applies the GT predicate on the "erased_at" field.
+func ErasedAtGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldErasedAt, v))
+}
+
+// ErasedAtGTE applies the GTE predicate on the "erased_at" field.
+func ErasedAtGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldErasedAt, v))
+}
+
+// ErasedAtLT applies the LT predicate on the "erased_at" field.
+func ErasedAtLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldErasedAt, v))
+}
+
+// ErasedAtLTE applies the LTE predicate on the "erased_at" field.
+func ErasedAtLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldErasedAt, v))
+}
+
+// ErasedAtIsNil applies the IsNil predicate on the "erased_at" field.
+func ErasedAtIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldErasedAt))
+}
+
+// ErasedAtNotNil applies the NotNil predicate on the "erased_at" field.
+func ErasedAtNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldErasedAt))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldVersion, v))
+}
+
+// HasDevices applies the HasEdge predicate on the "devices" edge.
+func HasDevices() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DevicesTable, DevicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDevicesWith applies the HasEdge predicate on the "devices" edge with a given conditions (other predicates).
+func HasDevicesWith(preds ...predicate.Device) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newDevicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAuditEntries applies the HasEdge predicate on the "audit_entries" edge.
+func HasAuditEntries() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuditEntriesTable, AuditEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuditEntriesWith applies the HasEdge predicate on the "audit_entries" edge with a given conditions (other predicates).
+func HasAuditEntriesWith(preds ...predicate.AuditEntry) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newAuditEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUsernameChanges applies the HasEdge predicate on the "username_changes" edge.
+func HasUsernameChanges() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UsernameChangesTable, UsernameChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUsernameChangesWith applies the HasEdge predicate on the "username_changes" edge with a given conditions (other predicates).
+func HasUsernameChangesWith(preds ...predicate.UsernameChange) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newUsernameChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmailVerifications applies the HasEdge predicate on the "email_verifications" edge.
+func HasEmailVerifications() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EmailVerificationsTable, EmailVerificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmailVerificationsWith applies the HasEdge predicate on the "email_verifications" edge with a given conditions (other predicates).
+func HasEmailVerificationsWith(preds ...predicate.EmailVerification) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newEmailVerificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLoginAttempts applies the HasEdge predicate on the "login_attempts" edge.
+func HasLoginAttempts() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LoginAttemptsTable, LoginAttemptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoginAttemptsWith applies the HasEdge predicate on the "login_attempts" edge with a given conditions (other predicates).
+func HasLoginAttemptsWith(preds ...predicate.LoginAttempt) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newLoginAttemptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Account) predicate.Account {
 	return predicate.Account(sql.AndPredicates(predicates...))
@@ -12,6 +12,11 @@ import (
 	"entgo.io/ent/schema/field"
 	domain "github.com/intezya/auth_service/internal/domain/account"
 	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/auditentry"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/device"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/emailverification"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/loginattempt"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
 )
 
 // AccountCreate is the builder for creating a Account entity.
@@ -27,12 +32,98 @@ func (ac *AccountCreate) SetUsername(s string) *AccountCreate {
 	return ac
 }
 
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableUsername(s *string) *AccountCreate {
+	if s != nil {
+		ac.SetUsername(*s)
+	}
+	return ac
+}
+
+// SetUsernameCanonical sets the "username_canonical" field.
+func (ac *AccountCreate) SetUsernameCanonical(s string) *AccountCreate {
+	ac.mutation.SetUsernameCanonical(s)
+	return ac
+}
+
+// SetNillableUsernameCanonical sets the "username_canonical" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableUsernameCanonical(s *string) *AccountCreate {
+	if s != nil {
+		ac.SetUsernameCanonical(*s)
+	}
+	return ac
+}
+
+// SetEmail sets the "email" field.
+func (ac *AccountCreate) SetEmail(s string) *AccountCreate {
+	ac.mutation.SetEmail(s)
+	return ac
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableEmail(s *string) *AccountCreate {
+	if s != nil {
+		ac.SetEmail(*s)
+	}
+	return ac
+}
+
+// SetEmailCanonical sets the "email_canonical" field.
+func (ac *AccountCreate) SetEmailCanonical(s string) *AccountCreate {
+	ac.mutation.SetEmailCanonical(s)
+	return ac
+}
+
+// SetNillableEmailCanonical sets the "email_canonical" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableEmailCanonical(s *string) *AccountCreate {
+	if s != nil {
+		ac.SetEmailCanonical(*s)
+	}
+	return ac
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (ac *AccountCreate) SetEmailVerifiedAt(t time.Time) *AccountCreate {
+	ac.mutation.SetEmailVerifiedAt(t)
+	return ac
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableEmailVerifiedAt(t *time.Time) *AccountCreate {
+	if t != nil {
+		ac.SetEmailVerifiedAt(*t)
+	}
+	return ac
+}
+
+// SetUsernameChangedAt sets the "username_changed_at" field.
+func (ac *AccountCreate) SetUsernameChangedAt(t time.Time) *AccountCreate {
+	ac.mutation.SetUsernameChangedAt(t)
+	return ac
+}
+
+// SetNillableUsernameChangedAt sets the "username_changed_at" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableUsernameChangedAt(t *time.Time) *AccountCreate {
+	if t != nil {
+		ac.SetUsernameChangedAt(*t)
+	}
+	return ac
+}
+
 // SetPassword sets the "password" field.
 func (ac *AccountCreate) SetPassword(s string) *AccountCreate {
 	ac.mutation.SetPassword(s)
 	return ac
 }
 
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (ac *AccountCreate) SetNillablePassword(s *string) *AccountCreate {
+	if s != nil {
+		ac.SetPassword(*s)
+	}
+	return ac
+}
+
 // SetHardwareID sets the "hardware_id" field.
 func (ac *AccountCreate) SetHardwareID(s string) *AccountCreate {
 	ac.mutation.SetHardwareID(s)
@@ -47,6 +138,20 @@ func (ac *AccountCreate) SetNillableHardwareID(s *string) *AccountCreate {
 	return ac
 }
 
+// SetHardwareIDDigest sets the "hardware_id_digest" field.
+func (ac *AccountCreate) SetHardwareIDDigest(s string) *AccountCreate {
+	ac.mutation.SetHardwareIDDigest(s)
+	return ac
+}
+
+// SetNillableHardwareIDDigest sets the "hardware_id_digest" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableHardwareIDDigest(s *string) *AccountCreate {
+	if s != nil {
+		ac.SetHardwareIDDigest(*s)
+	}
+	return ac
+}
+
 // SetAccessLevel sets the "access_level" field.
 func (ac *AccountCreate) SetAccessLevel(dl domain.AccessLevel) *AccountCreate {
 	ac.mutation.SetAccessLevel(dl)
@@ -61,6 +166,20 @@ func (ac *AccountCreate) SetNillableAccessLevel(dl *domain.AccessLevel) *Account
 	return ac
 }
 
+// SetLastLoginAt sets the "last_login_at" field.
+func (ac *AccountCreate) SetLastLoginAt(t time.Time) *AccountCreate {
+	ac.mutation.SetLastLoginAt(t)
+	return ac
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableLastLoginAt(t *time.Time) *AccountCreate {
+	if t != nil {
+		ac.SetLastLoginAt(*t)
+	}
+	return ac
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ac *AccountCreate) SetCreatedAt(t time.Time) *AccountCreate {
 	ac.mutation.SetCreatedAt(t)
@@ -103,12 +222,129 @@ func (ac *AccountCreate) SetNillableBanReason(s *string) *AccountCreate {
 	return ac
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ac *AccountCreate) SetDeletedAt(t time.Time) *AccountCreate {
+	ac.mutation.SetDeletedAt(t)
+	return ac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableDeletedAt(t *time.Time) *AccountCreate {
+	if t != nil {
+		ac.SetDeletedAt(*t)
+	}
+	return ac
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (ac *AccountCreate) SetErasedAt(t time.Time) *AccountCreate {
+	ac.mutation.SetErasedAt(t)
+	return ac
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableErasedAt(t *time.Time) *AccountCreate {
+	if t != nil {
+		ac.SetErasedAt(*t)
+	}
+	return ac
+}
+
+// SetVersion sets the "version" field.
+func (ac *AccountCreate) SetVersion(i int) *AccountCreate {
+	ac.mutation.SetVersion(i)
+	return ac
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableVersion(i *int) *AccountCreate {
+	if i != nil {
+		ac.SetVersion(*i)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *AccountCreate) SetID(i int) *AccountCreate {
 	ac.mutation.SetID(i)
 	return ac
 }
 
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (ac *AccountCreate) AddDeviceIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddDeviceIDs(ids...)
+	return ac
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (ac *AccountCreate) AddDevices(d ...*Device) *AccountCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return ac.AddDeviceIDs(ids...)
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (ac *AccountCreate) AddAuditEntryIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddAuditEntryIDs(ids...)
+	return ac
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (ac *AccountCreate) AddAuditEntries(a ...*AuditEntry) *AccountCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddAuditEntryIDs(ids...)
+}
+
+// AddUsernameChangeIDs adds the "username_changes" edge to the UsernameChange entity by IDs.
+func (ac *AccountCreate) AddUsernameChangeIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddUsernameChangeIDs(ids...)
+	return ac
+}
+
+// AddUsernameChanges adds the "username_changes" edges to the UsernameChange entity.
+func (ac *AccountCreate) AddUsernameChanges(u ...*UsernameChange) *AccountCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ac.AddUsernameChangeIDs(ids...)
+}
+
+// AddEmailVerificationIDs adds the "email_verifications" edge to the EmailVerification entity by IDs.
+func (ac *AccountCreate) AddEmailVerificationIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddEmailVerificationIDs(ids...)
+	return ac
+}
+
+// AddEmailVerifications adds the "email_verifications" edges to the EmailVerification entity.
+func (ac *AccountCreate) AddEmailVerifications(e ...*EmailVerification) *AccountCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ac.AddEmailVerificationIDs(ids...)
+}
+
+// AddLoginAttemptIDs adds the "login_attempts" edge to the LoginAttempt entity by IDs.
+func (ac *AccountCreate) AddLoginAttemptIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddLoginAttemptIDs(ids...)
+	return ac
+}
+
+// AddLoginAttempts adds the "login_attempts" edges to the LoginAttempt entity.
+func (ac *AccountCreate) AddLoginAttempts(l ...*LoginAttempt) *AccountCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ac.AddLoginAttemptIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (ac *AccountCreate) Mutation() *AccountMutation {
 	return ac.mutation
@@ -152,20 +388,33 @@ func (ac *AccountCreate) defaults() {
 		v := account.DefaultCreatedAt()
 		ac.mutation.SetCreatedAt(v)
 	}
+	if _, ok := ac.mutation.Version(); !ok {
+		v := account.DefaultVersion
+		ac.mutation.SetVersion(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (ac *AccountCreate) check() error {
-	if _, ok := ac.mutation.Username(); !ok {
-		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "Account.username"`)}
-	}
 	if v, ok := ac.mutation.Username(); ok {
 		if err := account.UsernameValidator(v); err != nil {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "Account.username": %w`, err)}
 		}
 	}
-	if _, ok := ac.mutation.Password(); !ok {
-		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "Account.password"`)}
+	if v, ok := ac.mutation.UsernameCanonical(); ok {
+		if err := account.UsernameCanonicalValidator(v); err != nil {
+			return &ValidationError{Name: "username_canonical", err: fmt.Errorf(`ent: validator failed for field "Account.username_canonical": %w`, err)}
+		}
+	}
+	if v, ok := ac.mutation.Email(); ok {
+		if err := account.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)}
+		}
+	}
+	if v, ok := ac.mutation.EmailCanonical(); ok {
+		if err := account.EmailCanonicalValidator(v); err != nil {
+			return &ValidationError{Name: "email_canonical", err: fmt.Errorf(`ent: validator failed for field "Account.email_canonical": %w`, err)}
+		}
 	}
 	if v, ok := ac.mutation.Password(); ok {
 		if err := account.PasswordValidator(v); err != nil {
@@ -178,6 +427,9 @@ func (ac *AccountCreate) check() error {
 	if _, ok := ac.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Account.created_at"`)}
 	}
+	if _, ok := ac.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Account.version"`)}
+	}
 	return nil
 }
 
@@ -212,20 +464,48 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 	}
 	if value, ok := ac.mutation.Username(); ok {
 		_spec.SetField(account.FieldUsername, field.TypeString, value)
-		_node.Username = value
+		_node.Username = &value
+	}
+	if value, ok := ac.mutation.UsernameCanonical(); ok {
+		_spec.SetField(account.FieldUsernameCanonical, field.TypeString, value)
+		_node.UsernameCanonical = &value
+	}
+	if value, ok := ac.mutation.Email(); ok {
+		_spec.SetField(account.FieldEmail, field.TypeString, value)
+		_node.Email = &value
+	}
+	if value, ok := ac.mutation.EmailCanonical(); ok {
+		_spec.SetField(account.FieldEmailCanonical, field.TypeString, value)
+		_node.EmailCanonical = &value
+	}
+	if value, ok := ac.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(account.FieldEmailVerifiedAt, field.TypeTime, value)
+		_node.EmailVerifiedAt = &value
+	}
+	if value, ok := ac.mutation.UsernameChangedAt(); ok {
+		_spec.SetField(account.FieldUsernameChangedAt, field.TypeTime, value)
+		_node.UsernameChangedAt = &value
 	}
 	if value, ok := ac.mutation.Password(); ok {
 		_spec.SetField(account.FieldPassword, field.TypeString, value)
-		_node.Password = value
+		_node.Password = &value
 	}
 	if value, ok := ac.mutation.HardwareID(); ok {
 		_spec.SetField(account.FieldHardwareID, field.TypeString, value)
 		_node.HardwareID = &value
 	}
+	if value, ok := ac.mutation.HardwareIDDigest(); ok {
+		_spec.SetField(account.FieldHardwareIDDigest, field.TypeString, value)
+		_node.HardwareIDDigest = &value
+	}
 	if value, ok := ac.mutation.AccessLevel(); ok {
 		_spec.SetField(account.FieldAccessLevel, field.TypeString, value)
 		_node.AccessLevel = value
 	}
+	if value, ok := ac.mutation.LastLoginAt(); ok {
+		_spec.SetField(account.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = &value
+	}
 	if value, ok := ac.mutation.CreatedAt(); ok {
 		_spec.SetField(account.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -238,6 +518,98 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldBanReason, field.TypeString, value)
 		_node.BanReason = &value
 	}
+	if value, ok := ac.mutation.DeletedAt(); ok {
+		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := ac.mutation.ErasedAt(); ok {
+		_spec.SetField(account.FieldErasedAt, field.TypeTime, value)
+		_node.ErasedAt = &value
+	}
+	if value, ok := ac.mutation.Version(); ok {
+		_spec.SetField(account.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if nodes := ac.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DevicesTable,
+			Columns: []string{account.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuditEntriesTable,
+			Columns: []string{account.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.UsernameChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UsernameChangesTable,
+			Columns: []string{account.UsernameChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.EmailVerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailVerificationsTable,
+			Columns: []string{account.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.LoginAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LoginAttemptsTable,
+			Columns: []string{account.LoginAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
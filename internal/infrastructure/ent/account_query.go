@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,16 +13,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/auditentry"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/device"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/emailverification"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/loginattempt"
 	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
 )
 
 // AccountQuery is the builder for querying Account entities.
 type AccountQuery struct {
 	config
-	ctx        *QueryContext
-	order      []account.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Account
+	ctx                    *QueryContext
+	order                  []account.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Account
+	withDevices            *DeviceQuery
+	withAuditEntries       *AuditEntryQuery
+	withUsernameChanges    *UsernameChangeQuery
+	withEmailVerifications *EmailVerificationQuery
+	withLoginAttempts      *LoginAttemptQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +69,116 @@ func (aq *AccountQuery) Order(o ...account.OrderOption) *AccountQuery {
 	return aq
 }
 
+// QueryDevices chains the current query on the "devices" edge.
+func (aq *AccountQuery) QueryDevices() *DeviceQuery {
+	query := (&DeviceClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.DevicesTable, account.DevicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuditEntries chains the current query on the "audit_entries" edge.
+func (aq *AccountQuery) QueryAuditEntries() *AuditEntryQuery {
+	query := (&AuditEntryClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(auditentry.Table, auditentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.AuditEntriesTable, account.AuditEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUsernameChanges chains the current query on the "username_changes" edge.
+func (aq *AccountQuery) QueryUsernameChanges() *UsernameChangeQuery {
+	query := (&UsernameChangeClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(usernamechange.Table, usernamechange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.UsernameChangesTable, account.UsernameChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEmailVerifications chains the current query on the "email_verifications" edge.
+func (aq *AccountQuery) QueryEmailVerifications() *EmailVerificationQuery {
+	query := (&EmailVerificationClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(emailverification.Table, emailverification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.EmailVerificationsTable, account.EmailVerificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLoginAttempts chains the current query on the "login_attempts" edge.
+func (aq *AccountQuery) QueryLoginAttempts() *LoginAttemptQuery {
+	query := (&LoginAttemptClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(loginattempt.Table, loginattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.LoginAttemptsTable, account.LoginAttemptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Account entity from the query.
 // Returns a *NotFoundError when no Account was found.
 func (aq *AccountQuery) First(ctx context.Context) (*Account, error) {
@@ -245,17 +366,77 @@ func (aq *AccountQuery) Clone() *AccountQuery {
 		return nil
 	}
 	return &AccountQuery{
-		config:     aq.config,
-		ctx:        aq.ctx.Clone(),
-		order:      append([]account.OrderOption{}, aq.order...),
-		inters:     append([]Interceptor{}, aq.inters...),
-		predicates: append([]predicate.Account{}, aq.predicates...),
+		config:                 aq.config,
+		ctx:                    aq.ctx.Clone(),
+		order:                  append([]account.OrderOption{}, aq.order...),
+		inters:                 append([]Interceptor{}, aq.inters...),
+		predicates:             append([]predicate.Account{}, aq.predicates...),
+		withDevices:            aq.withDevices.Clone(),
+		withAuditEntries:       aq.withAuditEntries.Clone(),
+		withUsernameChanges:    aq.withUsernameChanges.Clone(),
+		withEmailVerifications: aq.withEmailVerifications.Clone(),
+		withLoginAttempts:      aq.withLoginAttempts.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
 		path: aq.path,
 	}
 }
 
+// WithDevices tells the query-builder to eager-load the nodes that are connected to
+// the "devices" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithDevices(opts ...func(*DeviceQuery)) *AccountQuery {
+	query := (&DeviceClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withDevices = query
+	return aq
+}
+
+// WithAuditEntries tells the query-builder to eager-load the nodes that are connected to
+// the "audit_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithAuditEntries(opts ...func(*AuditEntryQuery)) *AccountQuery {
+	query := (&AuditEntryClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withAuditEntries = query
+	return aq
+}
+
+// WithUsernameChanges tells the query-builder to eager-load the nodes that are connected to
+// the "username_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithUsernameChanges(opts ...func(*UsernameChangeQuery)) *AccountQuery {
+	query := (&UsernameChangeClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withUsernameChanges = query
+	return aq
+}
+
+// WithEmailVerifications tells the query-builder to eager-load the nodes that are connected to
+// the "email_verifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithEmailVerifications(opts ...func(*EmailVerificationQuery)) *AccountQuery {
+	query := (&EmailVerificationClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withEmailVerifications = query
+	return aq
+}
+
+// WithLoginAttempts tells the query-builder to eager-load the nodes that are connected to
+// the "login_attempts" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithLoginAttempts(opts ...func(*LoginAttemptQuery)) *AccountQuery {
+	query := (&LoginAttemptClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withLoginAttempts = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +513,15 @@ func (aq *AccountQuery) prepareQuery(ctx context.Context) error {
 
 func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Account, error) {
 	var (
-		nodes = []*Account{}
-		_spec = aq.querySpec()
+		nodes       = []*Account{}
+		_spec       = aq.querySpec()
+		loadedTypes = [5]bool{
+			aq.withDevices != nil,
+			aq.withAuditEntries != nil,
+			aq.withUsernameChanges != nil,
+			aq.withEmailVerifications != nil,
+			aq.withLoginAttempts != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Account).scanValues(nil, columns)
@@ -341,6 +529,7 @@ func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Account{config: aq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +541,197 @@ func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := aq.withDevices; query != nil {
+		if err := aq.loadDevices(ctx, query, nodes,
+			func(n *Account) { n.Edges.Devices = []*Device{} },
+			func(n *Account, e *Device) { n.Edges.Devices = append(n.Edges.Devices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withAuditEntries; query != nil {
+		if err := aq.loadAuditEntries(ctx, query, nodes,
+			func(n *Account) { n.Edges.AuditEntries = []*AuditEntry{} },
+			func(n *Account, e *AuditEntry) { n.Edges.AuditEntries = append(n.Edges.AuditEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withUsernameChanges; query != nil {
+		if err := aq.loadUsernameChanges(ctx, query, nodes,
+			func(n *Account) { n.Edges.UsernameChanges = []*UsernameChange{} },
+			func(n *Account, e *UsernameChange) { n.Edges.UsernameChanges = append(n.Edges.UsernameChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withEmailVerifications; query != nil {
+		if err := aq.loadEmailVerifications(ctx, query, nodes,
+			func(n *Account) { n.Edges.EmailVerifications = []*EmailVerification{} },
+			func(n *Account, e *EmailVerification) {
+				n.Edges.EmailVerifications = append(n.Edges.EmailVerifications, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withLoginAttempts; query != nil {
+		if err := aq.loadLoginAttempts(ctx, query, nodes,
+			func(n *Account) { n.Edges.LoginAttempts = []*LoginAttempt{} },
+			func(n *Account, e *LoginAttempt) { n.Edges.LoginAttempts = append(n.Edges.LoginAttempts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (aq *AccountQuery) loadDevices(ctx context.Context, query *DeviceQuery, nodes []*Account, init func(*Account), assign func(*Account, *Device)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(device.FieldAccountID)
+	}
+	query.Where(predicate.Device(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.DevicesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadAuditEntries(ctx context.Context, query *AuditEntryQuery, nodes []*Account, init func(*Account), assign func(*Account, *AuditEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(auditentry.FieldAccountID)
+	}
+	query.Where(predicate.AuditEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.AuditEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadUsernameChanges(ctx context.Context, query *UsernameChangeQuery, nodes []*Account, init func(*Account), assign func(*Account, *UsernameChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(usernamechange.FieldAccountID)
+	}
+	query.Where(predicate.UsernameChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.UsernameChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadEmailVerifications(ctx context.Context, query *EmailVerificationQuery, nodes []*Account, init func(*Account), assign func(*Account, *EmailVerification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(emailverification.FieldAccountID)
+	}
+	query.Where(predicate.EmailVerification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.EmailVerificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadLoginAttempts(ctx context.Context, query *LoginAttemptQuery, nodes []*Account, init func(*Account), assign func(*Account, *LoginAttempt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(loginattempt.FieldAccountID)
+	}
+	query.Where(predicate.LoginAttempt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.LoginAttemptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (aq *AccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
 	_spec.Node.Columns = aq.ctx.Fields
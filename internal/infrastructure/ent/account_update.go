@@ -13,7 +13,12 @@ import (
 	"entgo.io/ent/schema/field"
 	domain "github.com/intezya/auth_service/internal/domain/account"
 	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/auditentry"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/device"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/emailverification"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/loginattempt"
 	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
 )
 
 // AccountUpdate is the builder for updating Account entities.
@@ -43,6 +48,112 @@ func (au *AccountUpdate) SetNillableUsername(s *string) *AccountUpdate {
 	return au
 }
 
+// ClearUsername clears the value of the "username" field.
+func (au *AccountUpdate) ClearUsername() *AccountUpdate {
+	au.mutation.ClearUsername()
+	return au
+}
+
+// SetUsernameCanonical sets the "username_canonical" field.
+func (au *AccountUpdate) SetUsernameCanonical(s string) *AccountUpdate {
+	au.mutation.SetUsernameCanonical(s)
+	return au
+}
+
+// SetNillableUsernameCanonical sets the "username_canonical" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableUsernameCanonical(s *string) *AccountUpdate {
+	if s != nil {
+		au.SetUsernameCanonical(*s)
+	}
+	return au
+}
+
+// ClearUsernameCanonical clears the value of the "username_canonical" field.
+func (au *AccountUpdate) ClearUsernameCanonical() *AccountUpdate {
+	au.mutation.ClearUsernameCanonical()
+	return au
+}
+
+// SetEmail sets the "email" field.
+func (au *AccountUpdate) SetEmail(s string) *AccountUpdate {
+	au.mutation.SetEmail(s)
+	return au
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableEmail(s *string) *AccountUpdate {
+	if s != nil {
+		au.SetEmail(*s)
+	}
+	return au
+}
+
+// ClearEmail clears the value of the "email" field.
+func (au *AccountUpdate) ClearEmail() *AccountUpdate {
+	au.mutation.ClearEmail()
+	return au
+}
+
+// SetEmailCanonical sets the "email_canonical" field.
+func (au *AccountUpdate) SetEmailCanonical(s string) *AccountUpdate {
+	au.mutation.SetEmailCanonical(s)
+	return au
+}
+
+// SetNillableEmailCanonical sets the "email_canonical" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableEmailCanonical(s *string) *AccountUpdate {
+	if s != nil {
+		au.SetEmailCanonical(*s)
+	}
+	return au
+}
+
+// ClearEmailCanonical clears the value of the "email_canonical" field.
+func (au *AccountUpdate) ClearEmailCanonical() *AccountUpdate {
+	au.mutation.ClearEmailCanonical()
+	return au
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (au *AccountUpdate) SetEmailVerifiedAt(t time.Time) *AccountUpdate {
+	au.mutation.SetEmailVerifiedAt(t)
+	return au
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableEmailVerifiedAt(t *time.Time) *AccountUpdate {
+	if t != nil {
+		au.SetEmailVerifiedAt(*t)
+	}
+	return au
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (au *AccountUpdate) ClearEmailVerifiedAt() *AccountUpdate {
+	au.mutation.ClearEmailVerifiedAt()
+	return au
+}
+
+// SetUsernameChangedAt sets the "username_changed_at" field.
+func (au *AccountUpdate) SetUsernameChangedAt(t time.Time) *AccountUpdate {
+	au.mutation.SetUsernameChangedAt(t)
+	return au
+}
+
+// SetNillableUsernameChangedAt sets the "username_changed_at" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableUsernameChangedAt(t *time.Time) *AccountUpdate {
+	if t != nil {
+		au.SetUsernameChangedAt(*t)
+	}
+	return au
+}
+
+// ClearUsernameChangedAt clears the value of the "username_changed_at" field.
+func (au *AccountUpdate) ClearUsernameChangedAt() *AccountUpdate {
+	au.mutation.ClearUsernameChangedAt()
+	return au
+}
+
 // SetPassword sets the "password" field.
 func (au *AccountUpdate) SetPassword(s string) *AccountUpdate {
 	au.mutation.SetPassword(s)
@@ -57,6 +168,12 @@ func (au *AccountUpdate) SetNillablePassword(s *string) *AccountUpdate {
 	return au
 }
 
+// ClearPassword clears the value of the "password" field.
+func (au *AccountUpdate) ClearPassword() *AccountUpdate {
+	au.mutation.ClearPassword()
+	return au
+}
+
 // SetHardwareID sets the "hardware_id" field.
 func (au *AccountUpdate) SetHardwareID(s string) *AccountUpdate {
 	au.mutation.SetHardwareID(s)
@@ -77,6 +194,26 @@ func (au *AccountUpdate) ClearHardwareID() *AccountUpdate {
 	return au
 }
 
+// SetHardwareIDDigest sets the "hardware_id_digest" field.
+func (au *AccountUpdate) SetHardwareIDDigest(s string) *AccountUpdate {
+	au.mutation.SetHardwareIDDigest(s)
+	return au
+}
+
+// SetNillableHardwareIDDigest sets the "hardware_id_digest" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableHardwareIDDigest(s *string) *AccountUpdate {
+	if s != nil {
+		au.SetHardwareIDDigest(*s)
+	}
+	return au
+}
+
+// ClearHardwareIDDigest clears the value of the "hardware_id_digest" field.
+func (au *AccountUpdate) ClearHardwareIDDigest() *AccountUpdate {
+	au.mutation.ClearHardwareIDDigest()
+	return au
+}
+
 // SetAccessLevel sets the "access_level" field.
 func (au *AccountUpdate) SetAccessLevel(dl domain.AccessLevel) *AccountUpdate {
 	au.mutation.SetAccessLevel(dl)
@@ -91,6 +228,26 @@ func (au *AccountUpdate) SetNillableAccessLevel(dl *domain.AccessLevel) *Account
 	return au
 }
 
+// SetLastLoginAt sets the "last_login_at" field.
+func (au *AccountUpdate) SetLastLoginAt(t time.Time) *AccountUpdate {
+	au.mutation.SetLastLoginAt(t)
+	return au
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableLastLoginAt(t *time.Time) *AccountUpdate {
+	if t != nil {
+		au.SetLastLoginAt(*t)
+	}
+	return au
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (au *AccountUpdate) ClearLastLoginAt() *AccountUpdate {
+	au.mutation.ClearLastLoginAt()
+	return au
+}
+
 // SetBannedUntil sets the "banned_until" field.
 func (au *AccountUpdate) SetBannedUntil(t time.Time) *AccountUpdate {
 	au.mutation.SetBannedUntil(t)
@@ -131,11 +288,252 @@ func (au *AccountUpdate) ClearBanReason() *AccountUpdate {
 	return au
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (au *AccountUpdate) SetDeletedAt(t time.Time) *AccountUpdate {
+	au.mutation.SetDeletedAt(t)
+	return au
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableDeletedAt(t *time.Time) *AccountUpdate {
+	if t != nil {
+		au.SetDeletedAt(*t)
+	}
+	return au
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (au *AccountUpdate) ClearDeletedAt() *AccountUpdate {
+	au.mutation.ClearDeletedAt()
+	return au
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (au *AccountUpdate) SetErasedAt(t time.Time) *AccountUpdate {
+	au.mutation.SetErasedAt(t)
+	return au
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableErasedAt(t *time.Time) *AccountUpdate {
+	if t != nil {
+		au.SetErasedAt(*t)
+	}
+	return au
+}
+
+// ClearErasedAt clears the value of the "erased_at" field.
+func (au *AccountUpdate) ClearErasedAt() *AccountUpdate {
+	au.mutation.ClearErasedAt()
+	return au
+}
+
+// SetVersion sets the "version" field.
+func (au *AccountUpdate) SetVersion(i int) *AccountUpdate {
+	au.mutation.ResetVersion()
+	au.mutation.SetVersion(i)
+	return au
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableVersion(i *int) *AccountUpdate {
+	if i != nil {
+		au.SetVersion(*i)
+	}
+	return au
+}
+
+// AddVersion adds i to the "version" field.
+func (au *AccountUpdate) AddVersion(i int) *AccountUpdate {
+	au.mutation.AddVersion(i)
+	return au
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (au *AccountUpdate) AddDeviceIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddDeviceIDs(ids...)
+	return au
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (au *AccountUpdate) AddDevices(d ...*Device) *AccountUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return au.AddDeviceIDs(ids...)
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (au *AccountUpdate) AddAuditEntryIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddAuditEntryIDs(ids...)
+	return au
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (au *AccountUpdate) AddAuditEntries(a ...*AuditEntry) *AccountUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddAuditEntryIDs(ids...)
+}
+
+// AddUsernameChangeIDs adds the "username_changes" edge to the UsernameChange entity by IDs.
+func (au *AccountUpdate) AddUsernameChangeIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddUsernameChangeIDs(ids...)
+	return au
+}
+
+// AddUsernameChanges adds the "username_changes" edges to the UsernameChange entity.
+func (au *AccountUpdate) AddUsernameChanges(u ...*UsernameChange) *AccountUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return au.AddUsernameChangeIDs(ids...)
+}
+
+// AddEmailVerificationIDs adds the "email_verifications" edge to the EmailVerification entity by IDs.
+func (au *AccountUpdate) AddEmailVerificationIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddEmailVerificationIDs(ids...)
+	return au
+}
+
+// AddEmailVerifications adds the "email_verifications" edges to the EmailVerification entity.
+func (au *AccountUpdate) AddEmailVerifications(e ...*EmailVerification) *AccountUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return au.AddEmailVerificationIDs(ids...)
+}
+
+// AddLoginAttemptIDs adds the "login_attempts" edge to the LoginAttempt entity by IDs.
+func (au *AccountUpdate) AddLoginAttemptIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddLoginAttemptIDs(ids...)
+	return au
+}
+
+// AddLoginAttempts adds the "login_attempts" edges to the LoginAttempt entity.
+func (au *AccountUpdate) AddLoginAttempts(l ...*LoginAttempt) *AccountUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return au.AddLoginAttemptIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (au *AccountUpdate) Mutation() *AccountMutation {
 	return au.mutation
 }
 
+// ClearDevices clears all "devices" edges to the Device entity.
+func (au *AccountUpdate) ClearDevices() *AccountUpdate {
+	au.mutation.ClearDevices()
+	return au
+}
+
+// RemoveDeviceIDs removes the "devices" edge to Device entities by IDs.
+func (au *AccountUpdate) RemoveDeviceIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveDeviceIDs(ids...)
+	return au
+}
+
+// RemoveDevices removes "devices" edges to Device entities.
+func (au *AccountUpdate) RemoveDevices(d ...*Device) *AccountUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return au.RemoveDeviceIDs(ids...)
+}
+
+// ClearAuditEntries clears all "audit_entries" edges to the AuditEntry entity.
+func (au *AccountUpdate) ClearAuditEntries() *AccountUpdate {
+	au.mutation.ClearAuditEntries()
+	return au
+}
+
+// RemoveAuditEntryIDs removes the "audit_entries" edge to AuditEntry entities by IDs.
+func (au *AccountUpdate) RemoveAuditEntryIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveAuditEntryIDs(ids...)
+	return au
+}
+
+// RemoveAuditEntries removes "audit_entries" edges to AuditEntry entities.
+func (au *AccountUpdate) RemoveAuditEntries(a ...*AuditEntry) *AccountUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveAuditEntryIDs(ids...)
+}
+
+// ClearUsernameChanges clears all "username_changes" edges to the UsernameChange entity.
+func (au *AccountUpdate) ClearUsernameChanges() *AccountUpdate {
+	au.mutation.ClearUsernameChanges()
+	return au
+}
+
+// RemoveUsernameChangeIDs removes the "username_changes" edge to UsernameChange entities by IDs.
+func (au *AccountUpdate) RemoveUsernameChangeIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveUsernameChangeIDs(ids...)
+	return au
+}
+
+// RemoveUsernameChanges removes "username_changes" edges to UsernameChange entities.
+func (au *AccountUpdate) RemoveUsernameChanges(u ...*UsernameChange) *AccountUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return au.RemoveUsernameChangeIDs(ids...)
+}
+
+// ClearEmailVerifications clears all "email_verifications" edges to the EmailVerification entity.
+func (au *AccountUpdate) ClearEmailVerifications() *AccountUpdate {
+	au.mutation.ClearEmailVerifications()
+	return au
+}
+
+// RemoveEmailVerificationIDs removes the "email_verifications" edge to EmailVerification entities by IDs.
+func (au *AccountUpdate) RemoveEmailVerificationIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveEmailVerificationIDs(ids...)
+	return au
+}
+
+// RemoveEmailVerifications removes "email_verifications" edges to EmailVerification entities.
+func (au *AccountUpdate) RemoveEmailVerifications(e ...*EmailVerification) *AccountUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return au.RemoveEmailVerificationIDs(ids...)
+}
+
+// ClearLoginAttempts clears all "login_attempts" edges to the LoginAttempt entity.
+func (au *AccountUpdate) ClearLoginAttempts() *AccountUpdate {
+	au.mutation.ClearLoginAttempts()
+	return au
+}
+
+// RemoveLoginAttemptIDs removes the "login_attempts" edge to LoginAttempt entities by IDs.
+func (au *AccountUpdate) RemoveLoginAttemptIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveLoginAttemptIDs(ids...)
+	return au
+}
+
+// RemoveLoginAttempts removes "login_attempts" edges to LoginAttempt entities.
+func (au *AccountUpdate) RemoveLoginAttempts(l ...*LoginAttempt) *AccountUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return au.RemoveLoginAttemptIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *AccountUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
@@ -170,6 +568,21 @@ func (au *AccountUpdate) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "Account.username": %w`, err)}
 		}
 	}
+	if v, ok := au.mutation.UsernameCanonical(); ok {
+		if err := account.UsernameCanonicalValidator(v); err != nil {
+			return &ValidationError{Name: "username_canonical", err: fmt.Errorf(`ent: validator failed for field "Account.username_canonical": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Email(); ok {
+		if err := account.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.EmailCanonical(); ok {
+		if err := account.EmailCanonicalValidator(v); err != nil {
+			return &ValidationError{Name: "email_canonical", err: fmt.Errorf(`ent: validator failed for field "Account.email_canonical": %w`, err)}
+		}
+	}
 	if v, ok := au.mutation.Password(); ok {
 		if err := account.PasswordValidator(v); err != nil {
 			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "Account.password": %w`, err)}
@@ -193,18 +606,66 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Username(); ok {
 		_spec.SetField(account.FieldUsername, field.TypeString, value)
 	}
+	if au.mutation.UsernameCleared() {
+		_spec.ClearField(account.FieldUsername, field.TypeString)
+	}
+	if value, ok := au.mutation.UsernameCanonical(); ok {
+		_spec.SetField(account.FieldUsernameCanonical, field.TypeString, value)
+	}
+	if au.mutation.UsernameCanonicalCleared() {
+		_spec.ClearField(account.FieldUsernameCanonical, field.TypeString)
+	}
+	if value, ok := au.mutation.Email(); ok {
+		_spec.SetField(account.FieldEmail, field.TypeString, value)
+	}
+	if au.mutation.EmailCleared() {
+		_spec.ClearField(account.FieldEmail, field.TypeString)
+	}
+	if value, ok := au.mutation.EmailCanonical(); ok {
+		_spec.SetField(account.FieldEmailCanonical, field.TypeString, value)
+	}
+	if au.mutation.EmailCanonicalCleared() {
+		_spec.ClearField(account.FieldEmailCanonical, field.TypeString)
+	}
+	if value, ok := au.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(account.FieldEmailVerifiedAt, field.TypeTime, value)
+	}
+	if au.mutation.EmailVerifiedAtCleared() {
+		_spec.ClearField(account.FieldEmailVerifiedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.UsernameChangedAt(); ok {
+		_spec.SetField(account.FieldUsernameChangedAt, field.TypeTime, value)
+	}
+	if au.mutation.UsernameChangedAtCleared() {
+		_spec.ClearField(account.FieldUsernameChangedAt, field.TypeTime)
+	}
 	if value, ok := au.mutation.Password(); ok {
 		_spec.SetField(account.FieldPassword, field.TypeString, value)
 	}
+	if au.mutation.PasswordCleared() {
+		_spec.ClearField(account.FieldPassword, field.TypeString)
+	}
 	if value, ok := au.mutation.HardwareID(); ok {
 		_spec.SetField(account.FieldHardwareID, field.TypeString, value)
 	}
 	if au.mutation.HardwareIDCleared() {
 		_spec.ClearField(account.FieldHardwareID, field.TypeString)
 	}
+	if value, ok := au.mutation.HardwareIDDigest(); ok {
+		_spec.SetField(account.FieldHardwareIDDigest, field.TypeString, value)
+	}
+	if au.mutation.HardwareIDDigestCleared() {
+		_spec.ClearField(account.FieldHardwareIDDigest, field.TypeString)
+	}
 	if value, ok := au.mutation.AccessLevel(); ok {
 		_spec.SetField(account.FieldAccessLevel, field.TypeString, value)
 	}
+	if value, ok := au.mutation.LastLoginAt(); ok {
+		_spec.SetField(account.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if au.mutation.LastLoginAtCleared() {
+		_spec.ClearField(account.FieldLastLoginAt, field.TypeTime)
+	}
 	if value, ok := au.mutation.BannedUntil(); ok {
 		_spec.SetField(account.FieldBannedUntil, field.TypeTime, value)
 	}
@@ -214,43 +675,392 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.BanReason(); ok {
 		_spec.SetField(account.FieldBanReason, field.TypeString, value)
 	}
-	if au.mutation.BanReasonCleared() {
-		_spec.ClearField(account.FieldBanReason, field.TypeString)
+	if au.mutation.BanReasonCleared() {
+		_spec.ClearField(account.FieldBanReason, field.TypeString)
+	}
+	if value, ok := au.mutation.DeletedAt(); ok {
+		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+	}
+	if au.mutation.DeletedAtCleared() {
+		_spec.ClearField(account.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.ErasedAt(); ok {
+		_spec.SetField(account.FieldErasedAt, field.TypeTime, value)
+	}
+	if au.mutation.ErasedAtCleared() {
+		_spec.ClearField(account.FieldErasedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.Version(); ok {
+		_spec.SetField(account.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedVersion(); ok {
+		_spec.AddField(account.FieldVersion, field.TypeInt, value)
+	}
+	if au.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DevicesTable,
+			Columns: []string{account.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedDevicesIDs(); len(nodes) > 0 && !au.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DevicesTable,
+			Columns: []string{account.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DevicesTable,
+			Columns: []string{account.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuditEntriesTable,
+			Columns: []string{account.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedAuditEntriesIDs(); len(nodes) > 0 && !au.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuditEntriesTable,
+			Columns: []string{account.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuditEntriesTable,
+			Columns: []string{account.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.UsernameChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UsernameChangesTable,
+			Columns: []string{account.UsernameChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedUsernameChangesIDs(); len(nodes) > 0 && !au.mutation.UsernameChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UsernameChangesTable,
+			Columns: []string{account.UsernameChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.UsernameChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UsernameChangesTable,
+			Columns: []string{account.UsernameChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.EmailVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailVerificationsTable,
+			Columns: []string{account.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedEmailVerificationsIDs(); len(nodes) > 0 && !au.mutation.EmailVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailVerificationsTable,
+			Columns: []string{account.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.EmailVerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailVerificationsTable,
+			Columns: []string{account.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.LoginAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LoginAttemptsTable,
+			Columns: []string{account.LoginAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedLoginAttemptsIDs(); len(nodes) > 0 && !au.mutation.LoginAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LoginAttemptsTable,
+			Columns: []string{account.LoginAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.LoginAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LoginAttemptsTable,
+			Columns: []string{account.LoginAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{account.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AccountUpdateOne is the builder for updating a single Account entity.
+type AccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccountMutation
+}
+
+// SetUsername sets the "username" field.
+func (auo *AccountUpdateOne) SetUsername(s string) *AccountUpdateOne {
+	auo.mutation.SetUsername(s)
+	return auo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableUsername(s *string) *AccountUpdateOne {
+	if s != nil {
+		auo.SetUsername(*s)
+	}
+	return auo
+}
+
+// ClearUsername clears the value of the "username" field.
+func (auo *AccountUpdateOne) ClearUsername() *AccountUpdateOne {
+	auo.mutation.ClearUsername()
+	return auo
+}
+
+// SetUsernameCanonical sets the "username_canonical" field.
+func (auo *AccountUpdateOne) SetUsernameCanonical(s string) *AccountUpdateOne {
+	auo.mutation.SetUsernameCanonical(s)
+	return auo
+}
+
+// SetNillableUsernameCanonical sets the "username_canonical" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableUsernameCanonical(s *string) *AccountUpdateOne {
+	if s != nil {
+		auo.SetUsernameCanonical(*s)
+	}
+	return auo
+}
+
+// ClearUsernameCanonical clears the value of the "username_canonical" field.
+func (auo *AccountUpdateOne) ClearUsernameCanonical() *AccountUpdateOne {
+	auo.mutation.ClearUsernameCanonical()
+	return auo
+}
+
+// SetEmail sets the "email" field.
+func (auo *AccountUpdateOne) SetEmail(s string) *AccountUpdateOne {
+	auo.mutation.SetEmail(s)
+	return auo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableEmail(s *string) *AccountUpdateOne {
+	if s != nil {
+		auo.SetEmail(*s)
+	}
+	return auo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (auo *AccountUpdateOne) ClearEmail() *AccountUpdateOne {
+	auo.mutation.ClearEmail()
+	return auo
+}
+
+// SetEmailCanonical sets the "email_canonical" field.
+func (auo *AccountUpdateOne) SetEmailCanonical(s string) *AccountUpdateOne {
+	auo.mutation.SetEmailCanonical(s)
+	return auo
+}
+
+// SetNillableEmailCanonical sets the "email_canonical" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableEmailCanonical(s *string) *AccountUpdateOne {
+	if s != nil {
+		auo.SetEmailCanonical(*s)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{account.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return auo
+}
+
+// ClearEmailCanonical clears the value of the "email_canonical" field.
+func (auo *AccountUpdateOne) ClearEmailCanonical() *AccountUpdateOne {
+	auo.mutation.ClearEmailCanonical()
+	return auo
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (auo *AccountUpdateOne) SetEmailVerifiedAt(t time.Time) *AccountUpdateOne {
+	auo.mutation.SetEmailVerifiedAt(t)
+	return auo
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableEmailVerifiedAt(t *time.Time) *AccountUpdateOne {
+	if t != nil {
+		auo.SetEmailVerifiedAt(*t)
 	}
-	au.mutation.done = true
-	return n, nil
+	return auo
 }
 
-// AccountUpdateOne is the builder for updating a single Account entity.
-type AccountUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *AccountMutation
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (auo *AccountUpdateOne) ClearEmailVerifiedAt() *AccountUpdateOne {
+	auo.mutation.ClearEmailVerifiedAt()
+	return auo
 }
 
-// SetUsername sets the "username" field.
-func (auo *AccountUpdateOne) SetUsername(s string) *AccountUpdateOne {
-	auo.mutation.SetUsername(s)
+// SetUsernameChangedAt sets the "username_changed_at" field.
+func (auo *AccountUpdateOne) SetUsernameChangedAt(t time.Time) *AccountUpdateOne {
+	auo.mutation.SetUsernameChangedAt(t)
 	return auo
 }
 
-// SetNillableUsername sets the "username" field if the given value is not nil.
-func (auo *AccountUpdateOne) SetNillableUsername(s *string) *AccountUpdateOne {
-	if s != nil {
-		auo.SetUsername(*s)
+// SetNillableUsernameChangedAt sets the "username_changed_at" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableUsernameChangedAt(t *time.Time) *AccountUpdateOne {
+	if t != nil {
+		auo.SetUsernameChangedAt(*t)
 	}
 	return auo
 }
 
+// ClearUsernameChangedAt clears the value of the "username_changed_at" field.
+func (auo *AccountUpdateOne) ClearUsernameChangedAt() *AccountUpdateOne {
+	auo.mutation.ClearUsernameChangedAt()
+	return auo
+}
+
 // SetPassword sets the "password" field.
 func (auo *AccountUpdateOne) SetPassword(s string) *AccountUpdateOne {
 	auo.mutation.SetPassword(s)
@@ -265,6 +1075,12 @@ func (auo *AccountUpdateOne) SetNillablePassword(s *string) *AccountUpdateOne {
 	return auo
 }
 
+// ClearPassword clears the value of the "password" field.
+func (auo *AccountUpdateOne) ClearPassword() *AccountUpdateOne {
+	auo.mutation.ClearPassword()
+	return auo
+}
+
 // SetHardwareID sets the "hardware_id" field.
 func (auo *AccountUpdateOne) SetHardwareID(s string) *AccountUpdateOne {
 	auo.mutation.SetHardwareID(s)
@@ -285,6 +1101,26 @@ func (auo *AccountUpdateOne) ClearHardwareID() *AccountUpdateOne {
 	return auo
 }
 
+// SetHardwareIDDigest sets the "hardware_id_digest" field.
+func (auo *AccountUpdateOne) SetHardwareIDDigest(s string) *AccountUpdateOne {
+	auo.mutation.SetHardwareIDDigest(s)
+	return auo
+}
+
+// SetNillableHardwareIDDigest sets the "hardware_id_digest" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableHardwareIDDigest(s *string) *AccountUpdateOne {
+	if s != nil {
+		auo.SetHardwareIDDigest(*s)
+	}
+	return auo
+}
+
+// ClearHardwareIDDigest clears the value of the "hardware_id_digest" field.
+func (auo *AccountUpdateOne) ClearHardwareIDDigest() *AccountUpdateOne {
+	auo.mutation.ClearHardwareIDDigest()
+	return auo
+}
+
 // SetAccessLevel sets the "access_level" field.
 func (auo *AccountUpdateOne) SetAccessLevel(dl domain.AccessLevel) *AccountUpdateOne {
 	auo.mutation.SetAccessLevel(dl)
@@ -299,6 +1135,26 @@ func (auo *AccountUpdateOne) SetNillableAccessLevel(dl *domain.AccessLevel) *Acc
 	return auo
 }
 
+// SetLastLoginAt sets the "last_login_at" field.
+func (auo *AccountUpdateOne) SetLastLoginAt(t time.Time) *AccountUpdateOne {
+	auo.mutation.SetLastLoginAt(t)
+	return auo
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableLastLoginAt(t *time.Time) *AccountUpdateOne {
+	if t != nil {
+		auo.SetLastLoginAt(*t)
+	}
+	return auo
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (auo *AccountUpdateOne) ClearLastLoginAt() *AccountUpdateOne {
+	auo.mutation.ClearLastLoginAt()
+	return auo
+}
+
 // SetBannedUntil sets the "banned_until" field.
 func (auo *AccountUpdateOne) SetBannedUntil(t time.Time) *AccountUpdateOne {
 	auo.mutation.SetBannedUntil(t)
@@ -339,11 +1195,252 @@ func (auo *AccountUpdateOne) ClearBanReason() *AccountUpdateOne {
 	return auo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (auo *AccountUpdateOne) SetDeletedAt(t time.Time) *AccountUpdateOne {
+	auo.mutation.SetDeletedAt(t)
+	return auo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableDeletedAt(t *time.Time) *AccountUpdateOne {
+	if t != nil {
+		auo.SetDeletedAt(*t)
+	}
+	return auo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (auo *AccountUpdateOne) ClearDeletedAt() *AccountUpdateOne {
+	auo.mutation.ClearDeletedAt()
+	return auo
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (auo *AccountUpdateOne) SetErasedAt(t time.Time) *AccountUpdateOne {
+	auo.mutation.SetErasedAt(t)
+	return auo
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableErasedAt(t *time.Time) *AccountUpdateOne {
+	if t != nil {
+		auo.SetErasedAt(*t)
+	}
+	return auo
+}
+
+// ClearErasedAt clears the value of the "erased_at" field.
+func (auo *AccountUpdateOne) ClearErasedAt() *AccountUpdateOne {
+	auo.mutation.ClearErasedAt()
+	return auo
+}
+
+// SetVersion sets the "version" field.
+func (auo *AccountUpdateOne) SetVersion(i int) *AccountUpdateOne {
+	auo.mutation.ResetVersion()
+	auo.mutation.SetVersion(i)
+	return auo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableVersion(i *int) *AccountUpdateOne {
+	if i != nil {
+		auo.SetVersion(*i)
+	}
+	return auo
+}
+
+// AddVersion adds i to the "version" field.
+func (auo *AccountUpdateOne) AddVersion(i int) *AccountUpdateOne {
+	auo.mutation.AddVersion(i)
+	return auo
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (auo *AccountUpdateOne) AddDeviceIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddDeviceIDs(ids...)
+	return auo
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (auo *AccountUpdateOne) AddDevices(d ...*Device) *AccountUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return auo.AddDeviceIDs(ids...)
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (auo *AccountUpdateOne) AddAuditEntryIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddAuditEntryIDs(ids...)
+	return auo
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (auo *AccountUpdateOne) AddAuditEntries(a ...*AuditEntry) *AccountUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddAuditEntryIDs(ids...)
+}
+
+// AddUsernameChangeIDs adds the "username_changes" edge to the UsernameChange entity by IDs.
+func (auo *AccountUpdateOne) AddUsernameChangeIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddUsernameChangeIDs(ids...)
+	return auo
+}
+
+// AddUsernameChanges adds the "username_changes" edges to the UsernameChange entity.
+func (auo *AccountUpdateOne) AddUsernameChanges(u ...*UsernameChange) *AccountUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return auo.AddUsernameChangeIDs(ids...)
+}
+
+// AddEmailVerificationIDs adds the "email_verifications" edge to the EmailVerification entity by IDs.
+func (auo *AccountUpdateOne) AddEmailVerificationIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddEmailVerificationIDs(ids...)
+	return auo
+}
+
+// AddEmailVerifications adds the "email_verifications" edges to the EmailVerification entity.
+func (auo *AccountUpdateOne) AddEmailVerifications(e ...*EmailVerification) *AccountUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return auo.AddEmailVerificationIDs(ids...)
+}
+
+// AddLoginAttemptIDs adds the "login_attempts" edge to the LoginAttempt entity by IDs.
+func (auo *AccountUpdateOne) AddLoginAttemptIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddLoginAttemptIDs(ids...)
+	return auo
+}
+
+// AddLoginAttempts adds the "login_attempts" edges to the LoginAttempt entity.
+func (auo *AccountUpdateOne) AddLoginAttempts(l ...*LoginAttempt) *AccountUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return auo.AddLoginAttemptIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (auo *AccountUpdateOne) Mutation() *AccountMutation {
 	return auo.mutation
 }
 
+// ClearDevices clears all "devices" edges to the Device entity.
+func (auo *AccountUpdateOne) ClearDevices() *AccountUpdateOne {
+	auo.mutation.ClearDevices()
+	return auo
+}
+
+// RemoveDeviceIDs removes the "devices" edge to Device entities by IDs.
+func (auo *AccountUpdateOne) RemoveDeviceIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveDeviceIDs(ids...)
+	return auo
+}
+
+// RemoveDevices removes "devices" edges to Device entities.
+func (auo *AccountUpdateOne) RemoveDevices(d ...*Device) *AccountUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return auo.RemoveDeviceIDs(ids...)
+}
+
+// ClearAuditEntries clears all "audit_entries" edges to the AuditEntry entity.
+func (auo *AccountUpdateOne) ClearAuditEntries() *AccountUpdateOne {
+	auo.mutation.ClearAuditEntries()
+	return auo
+}
+
+// RemoveAuditEntryIDs removes the "audit_entries" edge to AuditEntry entities by IDs.
+func (auo *AccountUpdateOne) RemoveAuditEntryIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveAuditEntryIDs(ids...)
+	return auo
+}
+
+// RemoveAuditEntries removes "audit_entries" edges to AuditEntry entities.
+func (auo *AccountUpdateOne) RemoveAuditEntries(a ...*AuditEntry) *AccountUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveAuditEntryIDs(ids...)
+}
+
+// ClearUsernameChanges clears all "username_changes" edges to the UsernameChange entity.
+func (auo *AccountUpdateOne) ClearUsernameChanges() *AccountUpdateOne {
+	auo.mutation.ClearUsernameChanges()
+	return auo
+}
+
+// RemoveUsernameChangeIDs removes the "username_changes" edge to UsernameChange entities by IDs.
+func (auo *AccountUpdateOne) RemoveUsernameChangeIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveUsernameChangeIDs(ids...)
+	return auo
+}
+
+// RemoveUsernameChanges removes "username_changes" edges to UsernameChange entities.
+func (auo *AccountUpdateOne) RemoveUsernameChanges(u ...*UsernameChange) *AccountUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return auo.RemoveUsernameChangeIDs(ids...)
+}
+
+// ClearEmailVerifications clears all "email_verifications" edges to the EmailVerification entity.
+func (auo *AccountUpdateOne) ClearEmailVerifications() *AccountUpdateOne {
+	auo.mutation.ClearEmailVerifications()
+	return auo
+}
+
+// RemoveEmailVerificationIDs removes the "email_verifications" edge to EmailVerification entities by IDs.
+func (auo *AccountUpdateOne) RemoveEmailVerificationIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveEmailVerificationIDs(ids...)
+	return auo
+}
+
+// RemoveEmailVerifications removes "email_verifications" edges to EmailVerification entities.
+func (auo *AccountUpdateOne) RemoveEmailVerifications(e ...*EmailVerification) *AccountUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return auo.RemoveEmailVerificationIDs(ids...)
+}
+
+// ClearLoginAttempts clears all "login_attempts" edges to the LoginAttempt entity.
+func (auo *AccountUpdateOne) ClearLoginAttempts() *AccountUpdateOne {
+	auo.mutation.ClearLoginAttempts()
+	return auo
+}
+
+// RemoveLoginAttemptIDs removes the "login_attempts" edge to LoginAttempt entities by IDs.
+func (auo *AccountUpdateOne) RemoveLoginAttemptIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveLoginAttemptIDs(ids...)
+	return auo
+}
+
+// RemoveLoginAttempts removes "login_attempts" edges to LoginAttempt entities.
+func (auo *AccountUpdateOne) RemoveLoginAttempts(l ...*LoginAttempt) *AccountUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return auo.RemoveLoginAttemptIDs(ids...)
+}
+
 // Where appends a list predicates to the AccountUpdate builder.
 func (auo *AccountUpdateOne) Where(ps ...predicate.Account) *AccountUpdateOne {
 	auo.mutation.Where(ps...)
@@ -391,6 +1488,21 @@ func (auo *AccountUpdateOne) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "Account.username": %w`, err)}
 		}
 	}
+	if v, ok := auo.mutation.UsernameCanonical(); ok {
+		if err := account.UsernameCanonicalValidator(v); err != nil {
+			return &ValidationError{Name: "username_canonical", err: fmt.Errorf(`ent: validator failed for field "Account.username_canonical": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Email(); ok {
+		if err := account.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.EmailCanonical(); ok {
+		if err := account.EmailCanonicalValidator(v); err != nil {
+			return &ValidationError{Name: "email_canonical", err: fmt.Errorf(`ent: validator failed for field "Account.email_canonical": %w`, err)}
+		}
+	}
 	if v, ok := auo.mutation.Password(); ok {
 		if err := account.PasswordValidator(v); err != nil {
 			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "Account.password": %w`, err)}
@@ -431,18 +1543,66 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 	if value, ok := auo.mutation.Username(); ok {
 		_spec.SetField(account.FieldUsername, field.TypeString, value)
 	}
+	if auo.mutation.UsernameCleared() {
+		_spec.ClearField(account.FieldUsername, field.TypeString)
+	}
+	if value, ok := auo.mutation.UsernameCanonical(); ok {
+		_spec.SetField(account.FieldUsernameCanonical, field.TypeString, value)
+	}
+	if auo.mutation.UsernameCanonicalCleared() {
+		_spec.ClearField(account.FieldUsernameCanonical, field.TypeString)
+	}
+	if value, ok := auo.mutation.Email(); ok {
+		_spec.SetField(account.FieldEmail, field.TypeString, value)
+	}
+	if auo.mutation.EmailCleared() {
+		_spec.ClearField(account.FieldEmail, field.TypeString)
+	}
+	if value, ok := auo.mutation.EmailCanonical(); ok {
+		_spec.SetField(account.FieldEmailCanonical, field.TypeString, value)
+	}
+	if auo.mutation.EmailCanonicalCleared() {
+		_spec.ClearField(account.FieldEmailCanonical, field.TypeString)
+	}
+	if value, ok := auo.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(account.FieldEmailVerifiedAt, field.TypeTime, value)
+	}
+	if auo.mutation.EmailVerifiedAtCleared() {
+		_spec.ClearField(account.FieldEmailVerifiedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.UsernameChangedAt(); ok {
+		_spec.SetField(account.FieldUsernameChangedAt, field.TypeTime, value)
+	}
+	if auo.mutation.UsernameChangedAtCleared() {
+		_spec.ClearField(account.FieldUsernameChangedAt, field.TypeTime)
+	}
 	if value, ok := auo.mutation.Password(); ok {
 		_spec.SetField(account.FieldPassword, field.TypeString, value)
 	}
+	if auo.mutation.PasswordCleared() {
+		_spec.ClearField(account.FieldPassword, field.TypeString)
+	}
 	if value, ok := auo.mutation.HardwareID(); ok {
 		_spec.SetField(account.FieldHardwareID, field.TypeString, value)
 	}
 	if auo.mutation.HardwareIDCleared() {
 		_spec.ClearField(account.FieldHardwareID, field.TypeString)
 	}
+	if value, ok := auo.mutation.HardwareIDDigest(); ok {
+		_spec.SetField(account.FieldHardwareIDDigest, field.TypeString, value)
+	}
+	if auo.mutation.HardwareIDDigestCleared() {
+		_spec.ClearField(account.FieldHardwareIDDigest, field.TypeString)
+	}
 	if value, ok := auo.mutation.AccessLevel(); ok {
 		_spec.SetField(account.FieldAccessLevel, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.LastLoginAt(); ok {
+		_spec.SetField(account.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if auo.mutation.LastLoginAtCleared() {
+		_spec.ClearField(account.FieldLastLoginAt, field.TypeTime)
+	}
 	if value, ok := auo.mutation.BannedUntil(); ok {
 		_spec.SetField(account.FieldBannedUntil, field.TypeTime, value)
 	}
@@ -455,6 +1615,249 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 	if auo.mutation.BanReasonCleared() {
 		_spec.ClearField(account.FieldBanReason, field.TypeString)
 	}
+	if value, ok := auo.mutation.DeletedAt(); ok {
+		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+	}
+	if auo.mutation.DeletedAtCleared() {
+		_spec.ClearField(account.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.ErasedAt(); ok {
+		_spec.SetField(account.FieldErasedAt, field.TypeTime, value)
+	}
+	if auo.mutation.ErasedAtCleared() {
+		_spec.ClearField(account.FieldErasedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.Version(); ok {
+		_spec.SetField(account.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedVersion(); ok {
+		_spec.AddField(account.FieldVersion, field.TypeInt, value)
+	}
+	if auo.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DevicesTable,
+			Columns: []string{account.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedDevicesIDs(); len(nodes) > 0 && !auo.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DevicesTable,
+			Columns: []string{account.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.DevicesTable,
+			Columns: []string{account.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuditEntriesTable,
+			Columns: []string{account.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedAuditEntriesIDs(); len(nodes) > 0 && !auo.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuditEntriesTable,
+			Columns: []string{account.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuditEntriesTable,
+			Columns: []string{account.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.UsernameChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UsernameChangesTable,
+			Columns: []string{account.UsernameChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedUsernameChangesIDs(); len(nodes) > 0 && !auo.mutation.UsernameChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UsernameChangesTable,
+			Columns: []string{account.UsernameChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.UsernameChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.UsernameChangesTable,
+			Columns: []string{account.UsernameChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.EmailVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailVerificationsTable,
+			Columns: []string{account.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedEmailVerificationsIDs(); len(nodes) > 0 && !auo.mutation.EmailVerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailVerificationsTable,
+			Columns: []string{account.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.EmailVerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailVerificationsTable,
+			Columns: []string{account.EmailVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.LoginAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LoginAttemptsTable,
+			Columns: []string{account.LoginAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedLoginAttemptsIDs(); len(nodes) > 0 && !auo.mutation.LoginAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LoginAttemptsTable,
+			Columns: []string{account.LoginAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.LoginAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.LoginAttemptsTable,
+			Columns: []string{account.LoginAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Account{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
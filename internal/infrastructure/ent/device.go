@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/device"
+)
+
+// Device is the model entity for the Device schema.
+type Device struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// HardwareID holds the value of the "hardware_id" field.
+	HardwareID string `json:"-"`
+	// HardwareIDDigest holds the value of the "hardware_id_digest" field.
+	HardwareIDDigest string `json:"-"`
+	// Status holds the value of the "status" field.
+	Status domain.DeviceStatus `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ApprovedAt holds the value of the "approved_at" field.
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeviceQuery when eager-loading is set.
+	Edges        DeviceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DeviceEdges holds the relations/edges for other nodes in the graph.
+type DeviceEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Device) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case device.FieldStatus:
+			values[i] = new(domain.DeviceStatus)
+		case device.FieldID, device.FieldAccountID:
+			values[i] = new(sql.NullInt64)
+		case device.FieldHardwareID, device.FieldHardwareIDDigest:
+			values[i] = new(sql.NullString)
+		case device.FieldCreatedAt, device.FieldApprovedAt, device.FieldLastSeenAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Device fields.
+func (d *Device) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case device.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			d.ID = int(value.Int64)
+		case device.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				d.AccountID = int(value.Int64)
+			}
+		case device.FieldHardwareID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware_id", values[i])
+			} else if value.Valid {
+				d.HardwareID = value.String
+			}
+		case device.FieldHardwareIDDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware_id_digest", values[i])
+			} else if value.Valid {
+				d.HardwareIDDigest = value.String
+			}
+		case device.FieldStatus:
+			if value, ok := values[i].(*domain.DeviceStatus); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value != nil {
+				d.Status = *value
+			}
+		case device.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case device.FieldApprovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_at", values[i])
+			} else if value.Valid {
+				d.ApprovedAt = new(time.Time)
+				*d.ApprovedAt = value.Time
+			}
+		case device.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				d.LastSeenAt = new(time.Time)
+				*d.LastSeenAt = value.Time
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Device.
+// This includes values selected through modifiers, order, etc.
+func (d *Device) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Device entity.
+func (d *Device) QueryAccount() *AccountQuery {
+	return NewDeviceClient(d.config).QueryAccount(d)
+}
+
+// Update returns a builder for updating this Device.
+// Note that you need to call Device.Unwrap() before calling this method if this Device
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Device) Update() *DeviceUpdateOne {
+	return NewDeviceClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Device entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Device) Unwrap() *Device {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Device is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Device) String() string {
+	var builder strings.Builder
+	builder.WriteString("Device(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("hardware_id=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("hardware_id_digest=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", d.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := d.ApprovedAt; v != nil {
+		builder.WriteString("approved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Devices is a parsable slice of Device.
+type Devices []*Device
@@ -0,0 +1,133 @@
+// Code generated by ent, DO NOT EDIT.
+
+package device
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+)
+
+const (
+	// Label holds the string label denoting the device type in the database.
+	Label = "device"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldHardwareID holds the string denoting the hardware_id field in the database.
+	FieldHardwareID = "hardware_id"
+	// FieldHardwareIDDigest holds the string denoting the hardware_id_digest field in the database.
+	FieldHardwareIDDigest = "hardware_id_digest"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldApprovedAt holds the string denoting the approved_at field in the database.
+	FieldApprovedAt = "approved_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// Table holds the table name of the device in the database.
+	Table = "devices"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "devices"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+)
+
+// Columns holds all SQL columns for device fields.
+var Columns = []string{
+	FieldID,
+	FieldAccountID,
+	FieldHardwareID,
+	FieldHardwareIDDigest,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldApprovedAt,
+	FieldLastSeenAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// HardwareIDValidator is a validator for the "hardware_id" field. It is called by the builders before save.
+	HardwareIDValidator func(string) error
+	// HardwareIDDigestValidator is a validator for the "hardware_id_digest" field. It is called by the builders before save.
+	HardwareIDDigestValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus func() domain.DeviceStatus
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Device queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByHardwareID orders the results by the hardware_id field.
+func ByHardwareID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHardwareID, opts...).ToFunc()
+}
+
+// ByHardwareIDDigest orders the results by the hardware_id_digest field.
+func ByHardwareIDDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHardwareIDDigest, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByApprovedAt orders the results by the approved_at field.
+func ByApprovedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package device
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldID, id))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldAccountID, v))
+}
+
+// HardwareID applies equality check predicate on the "hardware_id" field. It's identical to HardwareIDEQ.
+func HardwareID(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldHardwareID, v))
+}
+
+// HardwareIDDigest applies equality check predicate on the "hardware_id_digest" field. It's identical to HardwareIDDigestEQ.
+func HardwareIDDigest(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldHardwareIDDigest, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v domain.DeviceStatus) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ApprovedAt applies equality check predicate on the "approved_at" field. It's identical to ApprovedAtEQ.
+func ApprovedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// HardwareIDEQ applies the EQ predicate on the "hardware_id" field.
+func HardwareIDEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldHardwareID, v))
+}
+
+// HardwareIDNEQ applies the NEQ predicate on the "hardware_id" field.
+func HardwareIDNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldHardwareID, v))
+}
+
+// HardwareIDIn applies the In predicate on the "hardware_id" field.
+func HardwareIDIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldHardwareID, vs...))
+}
+
+// HardwareIDNotIn applies the NotIn predicate on the "hardware_id" field.
+func HardwareIDNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldHardwareID, vs...))
+}
+
+// HardwareIDGT applies the GT predicate on the "hardware_id" field.
+func HardwareIDGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldHardwareID, v))
+}
+
+// HardwareIDGTE applies the GTE predicate on the "hardware_id" field.
+func HardwareIDGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldHardwareID, v))
+}
+
+// HardwareIDLT applies the LT predicate on the "hardware_id" field.
+func HardwareIDLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldHardwareID, v))
+}
+
+// HardwareIDLTE applies the LTE predicate on the "hardware_id" field.
+func HardwareIDLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldHardwareID, v))
+}
+
+// HardwareIDContains applies the Contains predicate on the "hardware_id" field.
+func HardwareIDContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldHardwareID, v))
+}
+
+// HardwareIDHasPrefix applies the HasPrefix predicate on the "hardware_id" field.
+func HardwareIDHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldHardwareID, v))
+}
+
+// HardwareIDHasSuffix applies the HasSuffix predicate on the "hardware_id" field.
+func HardwareIDHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldHardwareID, v))
+}
+
+// HardwareIDEqualFold applies the EqualFold predicate on the "hardware_id" field.
+func HardwareIDEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldHardwareID, v))
+}
+
+// HardwareIDContainsFold applies the ContainsFold predicate on the "hardware_id" field.
+func HardwareIDContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldHardwareID, v))
+}
+
+// HardwareIDDigestEQ applies the EQ predicate on the "hardware_id_digest" field.
+func HardwareIDDigestEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestNEQ applies the NEQ predicate on the "hardware_id_digest" field.
+func HardwareIDDigestNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestIn applies the In predicate on the "hardware_id_digest" field.
+func HardwareIDDigestIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldHardwareIDDigest, vs...))
+}
+
+// HardwareIDDigestNotIn applies the NotIn predicate on the "hardware_id_digest" field.
+func HardwareIDDigestNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldHardwareIDDigest, vs...))
+}
+
+// HardwareIDDigestGT applies the GT predicate on the "hardware_id_digest" field.
+func HardwareIDDigestGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestGTE applies the GTE predicate on the "hardware_id_digest" field.
+func HardwareIDDigestGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestLT applies the LT predicate on the "hardware_id_digest" field.
+func HardwareIDDigestLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestLTE applies the LTE predicate on the "hardware_id_digest" field.
+func HardwareIDDigestLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestContains applies the Contains predicate on the "hardware_id_digest" field.
+func HardwareIDDigestContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestHasPrefix applies the HasPrefix predicate on the "hardware_id_digest" field.
+func HardwareIDDigestHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestHasSuffix applies the HasSuffix predicate on the "hardware_id_digest" field.
+func HardwareIDDigestHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestEqualFold applies the EqualFold predicate on the "hardware_id_digest" field.
+func HardwareIDDigestEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldHardwareIDDigest, v))
+}
+
+// HardwareIDDigestContainsFold applies the ContainsFold predicate on the "hardware_id_digest" field.
+func HardwareIDDigestContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldHardwareIDDigest, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v domain.DeviceStatus) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v domain.DeviceStatus) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...domain.DeviceStatus) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...domain.DeviceStatus) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v domain.DeviceStatus) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v domain.DeviceStatus) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v domain.DeviceStatus) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v domain.DeviceStatus) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v domain.DeviceStatus) predicate.Device {
+	vc := v.String()
+	return predicate.Device(sql.FieldContains(FieldStatus, vc))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v domain.DeviceStatus) predicate.Device {
+	vc := v.String()
+	return predicate.Device(sql.FieldHasPrefix(FieldStatus, vc))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v domain.DeviceStatus) predicate.Device {
+	vc := v.String()
+	return predicate.Device(sql.FieldHasSuffix(FieldStatus, vc))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v domain.DeviceStatus) predicate.Device {
+	vc := v.String()
+	return predicate.Device(sql.FieldEqualFold(FieldStatus, vc))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v domain.DeviceStatus) predicate.Device {
+	vc := v.String()
+	return predicate.Device(sql.FieldContainsFold(FieldStatus, vc))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ApprovedAtEQ applies the EQ predicate on the "approved_at" field.
+func ApprovedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtNEQ applies the NEQ predicate on the "approved_at" field.
+func ApprovedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtIn applies the In predicate on the "approved_at" field.
+func ApprovedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtNotIn applies the NotIn predicate on the "approved_at" field.
+func ApprovedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtGT applies the GT predicate on the "approved_at" field.
+func ApprovedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldApprovedAt, v))
+}
+
+// ApprovedAtGTE applies the GTE predicate on the "approved_at" field.
+func ApprovedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtLT applies the LT predicate on the "approved_at" field.
+func ApprovedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldApprovedAt, v))
+}
+
+// ApprovedAtLTE applies the LTE predicate on the "approved_at" field.
+func ApprovedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtIsNil applies the IsNil predicate on the "approved_at" field.
+func ApprovedAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldApprovedAt))
+}
+
+// ApprovedAtNotNil applies the NotNil predicate on the "approved_at" field.
+func ApprovedAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldApprovedAt))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIsNil applies the IsNil predicate on the "last_seen_at" field.
+func LastSeenAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastSeenAt))
+}
+
+// LastSeenAtNotNil applies the NotNil predicate on the "last_seen_at" field.
+func LastSeenAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastSeenAt))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Device) predicate.Device {
+	return predicate.Device(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Device) predicate.Device {
+	return predicate.Device(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Device) predicate.Device {
+	return predicate.Device(sql.NotPredicates(p))
+}
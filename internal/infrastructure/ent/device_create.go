@@ -0,0 +1,347 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/device"
+)
+
+// DeviceCreate is the builder for creating a Device entity.
+type DeviceCreate struct {
+	config
+	mutation *DeviceMutation
+	hooks    []Hook
+}
+
+// SetAccountID sets the "account_id" field.
+func (dc *DeviceCreate) SetAccountID(i int) *DeviceCreate {
+	dc.mutation.SetAccountID(i)
+	return dc
+}
+
+// SetHardwareID sets the "hardware_id" field.
+func (dc *DeviceCreate) SetHardwareID(s string) *DeviceCreate {
+	dc.mutation.SetHardwareID(s)
+	return dc
+}
+
+// SetHardwareIDDigest sets the "hardware_id_digest" field.
+func (dc *DeviceCreate) SetHardwareIDDigest(s string) *DeviceCreate {
+	dc.mutation.SetHardwareIDDigest(s)
+	return dc
+}
+
+// SetStatus sets the "status" field.
+func (dc *DeviceCreate) SetStatus(ds domain.DeviceStatus) *DeviceCreate {
+	dc.mutation.SetStatus(ds)
+	return dc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableStatus(ds *domain.DeviceStatus) *DeviceCreate {
+	if ds != nil {
+		dc.SetStatus(*ds)
+	}
+	return dc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DeviceCreate) SetCreatedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableCreatedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (dc *DeviceCreate) SetApprovedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetApprovedAt(t)
+	return dc
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableApprovedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetApprovedAt(*t)
+	}
+	return dc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (dc *DeviceCreate) SetLastSeenAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetLastSeenAt(t)
+	return dc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastSeenAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetLastSeenAt(*t)
+	}
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DeviceCreate) SetID(i int) *DeviceCreate {
+	dc.mutation.SetID(i)
+	return dc
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (dc *DeviceCreate) SetAccount(a *Account) *DeviceCreate {
+	return dc.SetAccountID(a.ID)
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (dc *DeviceCreate) Mutation() *DeviceMutation {
+	return dc.mutation
+}
+
+// Save creates the Device in the database.
+func (dc *DeviceCreate) Save(ctx context.Context) (*Device, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DeviceCreate) SaveX(ctx context.Context) *Device {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DeviceCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DeviceCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DeviceCreate) defaults() {
+	if _, ok := dc.mutation.Status(); !ok {
+		v := device.DefaultStatus()
+		dc.mutation.SetStatus(v)
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := device.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DeviceCreate) check() error {
+	if _, ok := dc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Device.account_id"`)}
+	}
+	if _, ok := dc.mutation.HardwareID(); !ok {
+		return &ValidationError{Name: "hardware_id", err: errors.New(`ent: missing required field "Device.hardware_id"`)}
+	}
+	if v, ok := dc.mutation.HardwareID(); ok {
+		if err := device.HardwareIDValidator(v); err != nil {
+			return &ValidationError{Name: "hardware_id", err: fmt.Errorf(`ent: validator failed for field "Device.hardware_id": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.HardwareIDDigest(); !ok {
+		return &ValidationError{Name: "hardware_id_digest", err: errors.New(`ent: missing required field "Device.hardware_id_digest"`)}
+	}
+	if v, ok := dc.mutation.HardwareIDDigest(); ok {
+		if err := device.HardwareIDDigestValidator(v); err != nil {
+			return &ValidationError{Name: "hardware_id_digest", err: fmt.Errorf(`ent: validator failed for field "Device.hardware_id_digest": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Device.status"`)}
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Device.created_at"`)}
+	}
+	if len(dc.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Device.account"`)}
+	}
+	return nil
+}
+
+func (dc *DeviceCreate) sqlSave(ctx context.Context) (*Device, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Device{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(device.Table, sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt))
+	)
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dc.mutation.HardwareID(); ok {
+		_spec.SetField(device.FieldHardwareID, field.TypeString, value)
+		_node.HardwareID = value
+	}
+	if value, ok := dc.mutation.HardwareIDDigest(); ok {
+		_spec.SetField(device.FieldHardwareIDDigest, field.TypeString, value)
+		_node.HardwareIDDigest = value
+	}
+	if value, ok := dc.mutation.Status(); ok {
+		_spec.SetField(device.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(device.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.ApprovedAt(); ok {
+		_spec.SetField(device.FieldApprovedAt, field.TypeTime, value)
+		_node.ApprovedAt = &value
+	}
+	if value, ok := dc.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
+	if nodes := dc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.AccountTable,
+			Columns: []string{device.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DeviceCreateBulk is the builder for creating many Device entities in bulk.
+type DeviceCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceCreate
+}
+
+// Save creates the Device entities in the database.
+func (dcb *DeviceCreateBulk) Save(ctx context.Context) ([]*Device, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Device, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DeviceCreateBulk) SaveX(ctx context.Context) []*Device {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DeviceCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DeviceCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/device"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+)
+
+// DeviceUpdate is the builder for updating Device entities.
+type DeviceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeviceMutation
+}
+
+// Where appends a list predicates to the DeviceUpdate builder.
+func (du *DeviceUpdate) Where(ps ...predicate.Device) *DeviceUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetHardwareID sets the "hardware_id" field.
+func (du *DeviceUpdate) SetHardwareID(s string) *DeviceUpdate {
+	du.mutation.SetHardwareID(s)
+	return du
+}
+
+// SetNillableHardwareID sets the "hardware_id" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableHardwareID(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetHardwareID(*s)
+	}
+	return du
+}
+
+// SetHardwareIDDigest sets the "hardware_id_digest" field.
+func (du *DeviceUpdate) SetHardwareIDDigest(s string) *DeviceUpdate {
+	du.mutation.SetHardwareIDDigest(s)
+	return du
+}
+
+// SetNillableHardwareIDDigest sets the "hardware_id_digest" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableHardwareIDDigest(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetHardwareIDDigest(*s)
+	}
+	return du
+}
+
+// SetStatus sets the "status" field.
+func (du *DeviceUpdate) SetStatus(ds domain.DeviceStatus) *DeviceUpdate {
+	du.mutation.SetStatus(ds)
+	return du
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableStatus(ds *domain.DeviceStatus) *DeviceUpdate {
+	if ds != nil {
+		du.SetStatus(*ds)
+	}
+	return du
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (du *DeviceUpdate) SetApprovedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetApprovedAt(t)
+	return du
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableApprovedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetApprovedAt(*t)
+	}
+	return du
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (du *DeviceUpdate) ClearApprovedAt() *DeviceUpdate {
+	du.mutation.ClearApprovedAt()
+	return du
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (du *DeviceUpdate) SetLastSeenAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetLastSeenAt(t)
+	return du
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastSeenAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetLastSeenAt(*t)
+	}
+	return du
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (du *DeviceUpdate) ClearLastSeenAt() *DeviceUpdate {
+	du.mutation.ClearLastSeenAt()
+	return du
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (du *DeviceUpdate) Mutation() *DeviceMutation {
+	return du.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DeviceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DeviceUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DeviceUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DeviceUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DeviceUpdate) check() error {
+	if v, ok := du.mutation.HardwareID(); ok {
+		if err := device.HardwareIDValidator(v); err != nil {
+			return &ValidationError{Name: "hardware_id", err: fmt.Errorf(`ent: validator failed for field "Device.hardware_id": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.HardwareIDDigest(); ok {
+		if err := device.HardwareIDDigestValidator(v); err != nil {
+			return &ValidationError{Name: "hardware_id_digest", err: fmt.Errorf(`ent: validator failed for field "Device.hardware_id_digest": %w`, err)}
+		}
+	}
+	if du.mutation.AccountCleared() && len(du.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Device.account"`)
+	}
+	return nil
+}
+
+func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(device.Table, device.Columns, sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.HardwareID(); ok {
+		_spec.SetField(device.FieldHardwareID, field.TypeString, value)
+	}
+	if value, ok := du.mutation.HardwareIDDigest(); ok {
+		_spec.SetField(device.FieldHardwareIDDigest, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Status(); ok {
+		_spec.SetField(device.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := du.mutation.ApprovedAt(); ok {
+		_spec.SetField(device.FieldApprovedAt, field.TypeTime, value)
+	}
+	if du.mutation.ApprovedAtCleared() {
+		_spec.ClearField(device.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if du.mutation.LastSeenAtCleared() {
+		_spec.ClearField(device.FieldLastSeenAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DeviceUpdateOne is the builder for updating a single Device entity.
+type DeviceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeviceMutation
+}
+
+// SetHardwareID sets the "hardware_id" field.
+func (duo *DeviceUpdateOne) SetHardwareID(s string) *DeviceUpdateOne {
+	duo.mutation.SetHardwareID(s)
+	return duo
+}
+
+// SetNillableHardwareID sets the "hardware_id" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableHardwareID(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetHardwareID(*s)
+	}
+	return duo
+}
+
+// SetHardwareIDDigest sets the "hardware_id_digest" field.
+func (duo *DeviceUpdateOne) SetHardwareIDDigest(s string) *DeviceUpdateOne {
+	duo.mutation.SetHardwareIDDigest(s)
+	return duo
+}
+
+// SetNillableHardwareIDDigest sets the "hardware_id_digest" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableHardwareIDDigest(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetHardwareIDDigest(*s)
+	}
+	return duo
+}
+
+// SetStatus sets the "status" field.
+func (duo *DeviceUpdateOne) SetStatus(ds domain.DeviceStatus) *DeviceUpdateOne {
+	duo.mutation.SetStatus(ds)
+	return duo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableStatus(ds *domain.DeviceStatus) *DeviceUpdateOne {
+	if ds != nil {
+		duo.SetStatus(*ds)
+	}
+	return duo
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (duo *DeviceUpdateOne) SetApprovedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetApprovedAt(t)
+	return duo
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableApprovedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetApprovedAt(*t)
+	}
+	return duo
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (duo *DeviceUpdateOne) ClearApprovedAt() *DeviceUpdateOne {
+	duo.mutation.ClearApprovedAt()
+	return duo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (duo *DeviceUpdateOne) SetLastSeenAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetLastSeenAt(t)
+	return duo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastSeenAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetLastSeenAt(*t)
+	}
+	return duo
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (duo *DeviceUpdateOne) ClearLastSeenAt() *DeviceUpdateOne {
+	duo.mutation.ClearLastSeenAt()
+	return duo
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (duo *DeviceUpdateOne) Mutation() *DeviceMutation {
+	return duo.mutation
+}
+
+// Where appends a list predicates to the DeviceUpdate builder.
+func (duo *DeviceUpdateOne) Where(ps ...predicate.Device) *DeviceUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DeviceUpdateOne) Select(field string, fields ...string) *DeviceUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Device entity.
+func (duo *DeviceUpdateOne) Save(ctx context.Context) (*Device, error) {
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DeviceUpdateOne) SaveX(ctx context.Context) *Device {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DeviceUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DeviceUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DeviceUpdateOne) check() error {
+	if v, ok := duo.mutation.HardwareID(); ok {
+		if err := device.HardwareIDValidator(v); err != nil {
+			return &ValidationError{Name: "hardware_id", err: fmt.Errorf(`ent: validator failed for field "Device.hardware_id": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.HardwareIDDigest(); ok {
+		if err := device.HardwareIDDigestValidator(v); err != nil {
+			return &ValidationError{Name: "hardware_id_digest", err: fmt.Errorf(`ent: validator failed for field "Device.hardware_id_digest": %w`, err)}
+		}
+	}
+	if duo.mutation.AccountCleared() && len(duo.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Device.account"`)
+	}
+	return nil
+}
+
+func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(device.Table, device.Columns, sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Device.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, device.FieldID)
+		for _, f := range fields {
+			if !device.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != device.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.HardwareID(); ok {
+		_spec.SetField(device.FieldHardwareID, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.HardwareIDDigest(); ok {
+		_spec.SetField(device.FieldHardwareIDDigest, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Status(); ok {
+		_spec.SetField(device.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.ApprovedAt(); ok {
+		_spec.SetField(device.FieldApprovedAt, field.TypeTime, value)
+	}
+	if duo.mutation.ApprovedAtCleared() {
+		_spec.ClearField(device.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if duo.mutation.LastSeenAtCleared() {
+		_spec.ClearField(device.FieldLastSeenAt, field.TypeTime)
+	}
+	_node = &Device{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
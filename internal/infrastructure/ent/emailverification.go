@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/emailverification"
+)
+
+// EmailVerification is the model entity for the EmailVerification schema.
+type EmailVerification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// CodeDigest holds the value of the "code_digest" field.
+	CodeDigest string `json:"-"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmailVerificationQuery when eager-loading is set.
+	Edges        EmailVerificationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EmailVerificationEdges holds the relations/edges for other nodes in the graph.
+type EmailVerificationEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailVerificationEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailVerification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailverification.FieldID, emailverification.FieldAccountID, emailverification.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case emailverification.FieldEmail, emailverification.FieldCodeDigest:
+			values[i] = new(sql.NullString)
+		case emailverification.FieldExpiresAt, emailverification.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailVerification fields.
+func (ev *EmailVerification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailverification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ev.ID = int(value.Int64)
+		case emailverification.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				ev.AccountID = int(value.Int64)
+			}
+		case emailverification.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ev.Email = value.String
+			}
+		case emailverification.FieldCodeDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_digest", values[i])
+			} else if value.Valid {
+				ev.CodeDigest = value.String
+			}
+		case emailverification.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				ev.Attempts = int(value.Int64)
+			}
+		case emailverification.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ev.ExpiresAt = value.Time
+			}
+		case emailverification.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ev.CreatedAt = value.Time
+			}
+		default:
+			ev.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailVerification.
+// This includes values selected through modifiers, order, etc.
+func (ev *EmailVerification) Value(name string) (ent.Value, error) {
+	return ev.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the EmailVerification entity.
+func (ev *EmailVerification) QueryAccount() *AccountQuery {
+	return NewEmailVerificationClient(ev.config).QueryAccount(ev)
+}
+
+// Update returns a builder for updating this EmailVerification.
+// Note that you need to call EmailVerification.Unwrap() before calling this method if this EmailVerification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ev *EmailVerification) Update() *EmailVerificationUpdateOne {
+	return NewEmailVerificationClient(ev.config).UpdateOne(ev)
+}
+
+// Unwrap unwraps the EmailVerification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ev *EmailVerification) Unwrap() *EmailVerification {
+	_tx, ok := ev.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailVerification is not a transactional entity")
+	}
+	ev.config.driver = _tx.drv
+	return ev
+}
+
+// String implements the fmt.Stringer.
+func (ev *EmailVerification) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailVerification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ev.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", ev.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(ev.Email)
+	builder.WriteString(", ")
+	builder.WriteString("code_digest=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", ev.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ev.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ev.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailVerifications is a parsable slice of EmailVerification.
+type EmailVerifications []*EmailVerification
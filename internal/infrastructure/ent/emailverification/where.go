@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailverification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldID, id))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldEmail, v))
+}
+
+// CodeDigest applies equality check predicate on the "code_digest" field. It's identical to CodeDigestEQ.
+func CodeDigest(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCodeDigest, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldAttempts, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// CodeDigestEQ applies the EQ predicate on the "code_digest" field.
+func CodeDigestEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCodeDigest, v))
+}
+
+// CodeDigestNEQ applies the NEQ predicate on the "code_digest" field.
+func CodeDigestNEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldCodeDigest, v))
+}
+
+// CodeDigestIn applies the In predicate on the "code_digest" field.
+func CodeDigestIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldCodeDigest, vs...))
+}
+
+// CodeDigestNotIn applies the NotIn predicate on the "code_digest" field.
+func CodeDigestNotIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldCodeDigest, vs...))
+}
+
+// CodeDigestGT applies the GT predicate on the "code_digest" field.
+func CodeDigestGT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldCodeDigest, v))
+}
+
+// CodeDigestGTE applies the GTE predicate on the "code_digest" field.
+func CodeDigestGTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldCodeDigest, v))
+}
+
+// CodeDigestLT applies the LT predicate on the "code_digest" field.
+func CodeDigestLT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldCodeDigest, v))
+}
+
+// CodeDigestLTE applies the LTE predicate on the "code_digest" field.
+func CodeDigestLTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldCodeDigest, v))
+}
+
+// CodeDigestContains applies the Contains predicate on the "code_digest" field.
+func CodeDigestContains(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContains(FieldCodeDigest, v))
+}
+
+// CodeDigestHasPrefix applies the HasPrefix predicate on the "code_digest" field.
+func CodeDigestHasPrefix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasPrefix(FieldCodeDigest, v))
+}
+
+// CodeDigestHasSuffix applies the HasSuffix predicate on the "code_digest" field.
+func CodeDigestHasSuffix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasSuffix(FieldCodeDigest, v))
+}
+
+// CodeDigestEqualFold applies the EqualFold predicate on the "code_digest" field.
+func CodeDigestEqualFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEqualFold(FieldCodeDigest, v))
+}
+
+// CodeDigestContainsFold applies the ContainsFold predicate on the "code_digest" field.
+func CodeDigestContainsFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContainsFold(FieldCodeDigest, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.EmailVerification {
+	return predicate.EmailVerification(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.EmailVerification {
+	return predicate.EmailVerification(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailVerification) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailVerification) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailVerification) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.NotPredicates(p))
+}
@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/emailverification"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+)
+
+// EmailVerificationUpdate is the builder for updating EmailVerification entities.
+type EmailVerificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailVerificationMutation
+}
+
+// Where appends a list predicates to the EmailVerificationUpdate builder.
+func (evu *EmailVerificationUpdate) Where(ps ...predicate.EmailVerification) *EmailVerificationUpdate {
+	evu.mutation.Where(ps...)
+	return evu
+}
+
+// SetAttempts sets the "attempts" field.
+func (evu *EmailVerificationUpdate) SetAttempts(i int) *EmailVerificationUpdate {
+	evu.mutation.ResetAttempts()
+	evu.mutation.SetAttempts(i)
+	return evu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (evu *EmailVerificationUpdate) SetNillableAttempts(i *int) *EmailVerificationUpdate {
+	if i != nil {
+		evu.SetAttempts(*i)
+	}
+	return evu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (evu *EmailVerificationUpdate) AddAttempts(i int) *EmailVerificationUpdate {
+	evu.mutation.AddAttempts(i)
+	return evu
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (evu *EmailVerificationUpdate) Mutation() *EmailVerificationMutation {
+	return evu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (evu *EmailVerificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, evu.sqlSave, evu.mutation, evu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evu *EmailVerificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := evu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (evu *EmailVerificationUpdate) Exec(ctx context.Context) error {
+	_, err := evu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evu *EmailVerificationUpdate) ExecX(ctx context.Context) {
+	if err := evu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evu *EmailVerificationUpdate) check() error {
+	if evu.mutation.AccountCleared() && len(evu.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailVerification.account"`)
+	}
+	return nil
+}
+
+func (evu *EmailVerificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := evu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailverification.Table, emailverification.Columns, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt))
+	if ps := evu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := evu.mutation.Attempts(); ok {
+		_spec.SetField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := evu.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, evu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	evu.mutation.done = true
+	return n, nil
+}
+
+// EmailVerificationUpdateOne is the builder for updating a single EmailVerification entity.
+type EmailVerificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailVerificationMutation
+}
+
+// SetAttempts sets the "attempts" field.
+func (evuo *EmailVerificationUpdateOne) SetAttempts(i int) *EmailVerificationUpdateOne {
+	evuo.mutation.ResetAttempts()
+	evuo.mutation.SetAttempts(i)
+	return evuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (evuo *EmailVerificationUpdateOne) SetNillableAttempts(i *int) *EmailVerificationUpdateOne {
+	if i != nil {
+		evuo.SetAttempts(*i)
+	}
+	return evuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (evuo *EmailVerificationUpdateOne) AddAttempts(i int) *EmailVerificationUpdateOne {
+	evuo.mutation.AddAttempts(i)
+	return evuo
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (evuo *EmailVerificationUpdateOne) Mutation() *EmailVerificationMutation {
+	return evuo.mutation
+}
+
+// Where appends a list predicates to the EmailVerificationUpdate builder.
+func (evuo *EmailVerificationUpdateOne) Where(ps ...predicate.EmailVerification) *EmailVerificationUpdateOne {
+	evuo.mutation.Where(ps...)
+	return evuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (evuo *EmailVerificationUpdateOne) Select(field string, fields ...string) *EmailVerificationUpdateOne {
+	evuo.fields = append([]string{field}, fields...)
+	return evuo
+}
+
+// Save executes the query and returns the updated EmailVerification entity.
+func (evuo *EmailVerificationUpdateOne) Save(ctx context.Context) (*EmailVerification, error) {
+	return withHooks(ctx, evuo.sqlSave, evuo.mutation, evuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evuo *EmailVerificationUpdateOne) SaveX(ctx context.Context) *EmailVerification {
+	node, err := evuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (evuo *EmailVerificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := evuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evuo *EmailVerificationUpdateOne) ExecX(ctx context.Context) {
+	if err := evuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evuo *EmailVerificationUpdateOne) check() error {
+	if evuo.mutation.AccountCleared() && len(evuo.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailVerification.account"`)
+	}
+	return nil
+}
+
+func (evuo *EmailVerificationUpdateOne) sqlSave(ctx context.Context) (_node *EmailVerification, err error) {
+	if err := evuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailverification.Table, emailverification.Columns, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt))
+	id, ok := evuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailVerification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := evuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailverification.FieldID)
+		for _, f := range fields {
+			if !emailverification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := evuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := evuo.mutation.Attempts(); ok {
+		_spec.SetField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := evuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	_node = &EmailVerification{config: evuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, evuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	evuo.mutation.done = true
+	return _node, nil
+}
@@ -13,6 +13,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/auditentry"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/device"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/emailverification"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/loginattempt"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/unknownloginattempt"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +79,13 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			account.Table: account.ValidColumn,
+			account.Table:             account.ValidColumn,
+			auditentry.Table:          auditentry.ValidColumn,
+			device.Table:              device.ValidColumn,
+			emailverification.Table:   emailverification.ValidColumn,
+			loginattempt.Table:        loginattempt.ValidColumn,
+			unknownloginattempt.Table: unknownloginattempt.ValidColumn,
+			usernamechange.Table:      usernamechange.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/loginattempt"
+)
+
+// LoginAttempt is the model entity for the LoginAttempt schema.
+type LoginAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP *string `json:"ip,omitempty"`
+	// HardwareIDMatch holds the value of the "hardware_id_match" field.
+	HardwareIDMatch string `json:"hardware_id_match,omitempty"`
+	// Success holds the value of the "success" field.
+	Success bool `json:"success,omitempty"`
+	// FailureReason holds the value of the "failure_reason" field.
+	FailureReason *string `json:"failure_reason,omitempty"`
+	// Asn holds the value of the "asn" field.
+	Asn *uint `json:"asn,omitempty"`
+	// Country holds the value of the "country" field.
+	Country *string `json:"country,omitempty"`
+	// Latitude holds the value of the "latitude" field.
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude *float64 `json:"longitude,omitempty"`
+	// RiskScore holds the value of the "risk_score" field.
+	RiskScore *int `json:"risk_score,omitempty"`
+	// RiskDecision holds the value of the "risk_decision" field.
+	RiskDecision *string `json:"risk_decision,omitempty"`
+	// RiskSignals holds the value of the "risk_signals" field.
+	RiskSignals []string `json:"risk_signals,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoginAttemptQuery when eager-loading is set.
+	Edges        LoginAttemptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LoginAttemptEdges holds the relations/edges for other nodes in the graph.
+type LoginAttemptEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoginAttemptEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginattempt.FieldRiskSignals:
+			values[i] = new([]byte)
+		case loginattempt.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case loginattempt.FieldLatitude, loginattempt.FieldLongitude:
+			values[i] = new(sql.NullFloat64)
+		case loginattempt.FieldID, loginattempt.FieldAccountID, loginattempt.FieldAsn, loginattempt.FieldRiskScore:
+			values[i] = new(sql.NullInt64)
+		case loginattempt.FieldIP, loginattempt.FieldHardwareIDMatch, loginattempt.FieldFailureReason, loginattempt.FieldCountry, loginattempt.FieldRiskDecision:
+			values[i] = new(sql.NullString)
+		case loginattempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginAttempt fields.
+func (la *LoginAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			la.ID = int(value.Int64)
+		case loginattempt.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				la.AccountID = int(value.Int64)
+			}
+		case loginattempt.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				la.IP = new(string)
+				*la.IP = value.String
+			}
+		case loginattempt.FieldHardwareIDMatch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware_id_match", values[i])
+			} else if value.Valid {
+				la.HardwareIDMatch = value.String
+			}
+		case loginattempt.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				la.Success = value.Bool
+			}
+		case loginattempt.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				la.FailureReason = new(string)
+				*la.FailureReason = value.String
+			}
+		case loginattempt.FieldAsn:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field asn", values[i])
+			} else if value.Valid {
+				la.Asn = new(uint)
+				*la.Asn = uint(value.Int64)
+			}
+		case loginattempt.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				la.Country = new(string)
+				*la.Country = value.String
+			}
+		case loginattempt.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				la.Latitude = new(float64)
+				*la.Latitude = value.Float64
+			}
+		case loginattempt.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				la.Longitude = new(float64)
+				*la.Longitude = value.Float64
+			}
+		case loginattempt.FieldRiskScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_score", values[i])
+			} else if value.Valid {
+				la.RiskScore = new(int)
+				*la.RiskScore = int(value.Int64)
+			}
+		case loginattempt.FieldRiskDecision:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_decision", values[i])
+			} else if value.Valid {
+				la.RiskDecision = new(string)
+				*la.RiskDecision = value.String
+			}
+		case loginattempt.FieldRiskSignals:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_signals", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &la.RiskSignals); err != nil {
+					return fmt.Errorf("unmarshal field risk_signals: %w", err)
+				}
+			}
+		case loginattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				la.CreatedAt = value.Time
+			}
+		default:
+			la.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginAttempt.
+// This includes values selected through modifiers, order, etc.
+func (la *LoginAttempt) Value(name string) (ent.Value, error) {
+	return la.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the LoginAttempt entity.
+func (la *LoginAttempt) QueryAccount() *AccountQuery {
+	return NewLoginAttemptClient(la.config).QueryAccount(la)
+}
+
+// Update returns a builder for updating this LoginAttempt.
+// Note that you need to call LoginAttempt.Unwrap() before calling this method if this LoginAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (la *LoginAttempt) Update() *LoginAttemptUpdateOne {
+	return NewLoginAttemptClient(la.config).UpdateOne(la)
+}
+
+// Unwrap unwraps the LoginAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (la *LoginAttempt) Unwrap() *LoginAttempt {
+	_tx, ok := la.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginAttempt is not a transactional entity")
+	}
+	la.config.driver = _tx.drv
+	return la
+}
+
+// String implements the fmt.Stringer.
+func (la *LoginAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", la.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", la.AccountID))
+	builder.WriteString(", ")
+	if v := la.IP; v != nil {
+		builder.WriteString("ip=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("hardware_id_match=")
+	builder.WriteString(la.HardwareIDMatch)
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", la.Success))
+	builder.WriteString(", ")
+	if v := la.FailureReason; v != nil {
+		builder.WriteString("failure_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := la.Asn; v != nil {
+		builder.WriteString("asn=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := la.Country; v != nil {
+		builder.WriteString("country=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := la.Latitude; v != nil {
+		builder.WriteString("latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := la.Longitude; v != nil {
+		builder.WriteString("longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := la.RiskScore; v != nil {
+		builder.WriteString("risk_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := la.RiskDecision; v != nil {
+		builder.WriteString("risk_decision=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("risk_signals=")
+	builder.WriteString(fmt.Sprintf("%v", la.RiskSignals))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(la.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginAttempts is a parsable slice of LoginAttempt.
+type LoginAttempts []*LoginAttempt
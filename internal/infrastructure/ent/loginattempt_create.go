@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/loginattempt"
+)
+
+// LoginAttemptCreate is the builder for creating a LoginAttempt entity.
+type LoginAttemptCreate struct {
+	config
+	mutation *LoginAttemptMutation
+	hooks    []Hook
+}
+
+// SetAccountID sets the "account_id" field.
+func (lac *LoginAttemptCreate) SetAccountID(i int) *LoginAttemptCreate {
+	lac.mutation.SetAccountID(i)
+	return lac
+}
+
+// SetIP sets the "ip" field.
+func (lac *LoginAttemptCreate) SetIP(s string) *LoginAttemptCreate {
+	lac.mutation.SetIP(s)
+	return lac
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableIP(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetIP(*s)
+	}
+	return lac
+}
+
+// SetHardwareIDMatch sets the "hardware_id_match" field.
+func (lac *LoginAttemptCreate) SetHardwareIDMatch(s string) *LoginAttemptCreate {
+	lac.mutation.SetHardwareIDMatch(s)
+	return lac
+}
+
+// SetSuccess sets the "success" field.
+func (lac *LoginAttemptCreate) SetSuccess(b bool) *LoginAttemptCreate {
+	lac.mutation.SetSuccess(b)
+	return lac
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (lac *LoginAttemptCreate) SetFailureReason(s string) *LoginAttemptCreate {
+	lac.mutation.SetFailureReason(s)
+	return lac
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableFailureReason(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetFailureReason(*s)
+	}
+	return lac
+}
+
+// SetAsn sets the "asn" field.
+func (lac *LoginAttemptCreate) SetAsn(u uint) *LoginAttemptCreate {
+	lac.mutation.SetAsn(u)
+	return lac
+}
+
+// SetNillableAsn sets the "asn" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableAsn(u *uint) *LoginAttemptCreate {
+	if u != nil {
+		lac.SetAsn(*u)
+	}
+	return lac
+}
+
+// SetCountry sets the "country" field.
+func (lac *LoginAttemptCreate) SetCountry(s string) *LoginAttemptCreate {
+	lac.mutation.SetCountry(s)
+	return lac
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableCountry(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetCountry(*s)
+	}
+	return lac
+}
+
+// SetLatitude sets the "latitude" field.
+func (lac *LoginAttemptCreate) SetLatitude(f float64) *LoginAttemptCreate {
+	lac.mutation.SetLatitude(f)
+	return lac
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableLatitude(f *float64) *LoginAttemptCreate {
+	if f != nil {
+		lac.SetLatitude(*f)
+	}
+	return lac
+}
+
+// SetLongitude sets the "longitude" field.
+func (lac *LoginAttemptCreate) SetLongitude(f float64) *LoginAttemptCreate {
+	lac.mutation.SetLongitude(f)
+	return lac
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableLongitude(f *float64) *LoginAttemptCreate {
+	if f != nil {
+		lac.SetLongitude(*f)
+	}
+	return lac
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (lac *LoginAttemptCreate) SetRiskScore(i int) *LoginAttemptCreate {
+	lac.mutation.SetRiskScore(i)
+	return lac
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableRiskScore(i *int) *LoginAttemptCreate {
+	if i != nil {
+		lac.SetRiskScore(*i)
+	}
+	return lac
+}
+
+// SetRiskDecision sets the "risk_decision" field.
+func (lac *LoginAttemptCreate) SetRiskDecision(s string) *LoginAttemptCreate {
+	lac.mutation.SetRiskDecision(s)
+	return lac
+}
+
+// SetNillableRiskDecision sets the "risk_decision" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableRiskDecision(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetRiskDecision(*s)
+	}
+	return lac
+}
+
+// SetRiskSignals sets the "risk_signals" field.
+func (lac *LoginAttemptCreate) SetRiskSignals(s []string) *LoginAttemptCreate {
+	lac.mutation.SetRiskSignals(s)
+	return lac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lac *LoginAttemptCreate) SetCreatedAt(t time.Time) *LoginAttemptCreate {
+	lac.mutation.SetCreatedAt(t)
+	return lac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableCreatedAt(t *time.Time) *LoginAttemptCreate {
+	if t != nil {
+		lac.SetCreatedAt(*t)
+	}
+	return lac
+}
+
+// SetID sets the "id" field.
+func (lac *LoginAttemptCreate) SetID(i int) *LoginAttemptCreate {
+	lac.mutation.SetID(i)
+	return lac
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (lac *LoginAttemptCreate) SetAccount(a *Account) *LoginAttemptCreate {
+	return lac.SetAccountID(a.ID)
+}
+
+// Mutation returns the LoginAttemptMutation object of the builder.
+func (lac *LoginAttemptCreate) Mutation() *LoginAttemptMutation {
+	return lac.mutation
+}
+
+// Save creates the LoginAttempt in the database.
+func (lac *LoginAttemptCreate) Save(ctx context.Context) (*LoginAttempt, error) {
+	lac.defaults()
+	return withHooks(ctx, lac.sqlSave, lac.mutation, lac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lac *LoginAttemptCreate) SaveX(ctx context.Context) *LoginAttempt {
+	v, err := lac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lac *LoginAttemptCreate) Exec(ctx context.Context) error {
+	_, err := lac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lac *LoginAttemptCreate) ExecX(ctx context.Context) {
+	if err := lac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lac *LoginAttemptCreate) defaults() {
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		v := loginattempt.DefaultCreatedAt()
+		lac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lac *LoginAttemptCreate) check() error {
+	if _, ok := lac.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "LoginAttempt.account_id"`)}
+	}
+	if _, ok := lac.mutation.HardwareIDMatch(); !ok {
+		return &ValidationError{Name: "hardware_id_match", err: errors.New(`ent: missing required field "LoginAttempt.hardware_id_match"`)}
+	}
+	if _, ok := lac.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "LoginAttempt.success"`)}
+	}
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginAttempt.created_at"`)}
+	}
+	if len(lac.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "LoginAttempt.account"`)}
+	}
+	return nil
+}
+
+func (lac *LoginAttemptCreate) sqlSave(ctx context.Context) (*LoginAttempt, error) {
+	if err := lac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	lac.mutation.id = &_node.ID
+	lac.mutation.done = true
+	return _node, nil
+}
+
+func (lac *LoginAttemptCreate) createSpec() (*LoginAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginAttempt{config: lac.config}
+		_spec = sqlgraph.NewCreateSpec(loginattempt.Table, sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt))
+	)
+	if id, ok := lac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lac.mutation.IP(); ok {
+		_spec.SetField(loginattempt.FieldIP, field.TypeString, value)
+		_node.IP = &value
+	}
+	if value, ok := lac.mutation.HardwareIDMatch(); ok {
+		_spec.SetField(loginattempt.FieldHardwareIDMatch, field.TypeString, value)
+		_node.HardwareIDMatch = value
+	}
+	if value, ok := lac.mutation.Success(); ok {
+		_spec.SetField(loginattempt.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := lac.mutation.FailureReason(); ok {
+		_spec.SetField(loginattempt.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = &value
+	}
+	if value, ok := lac.mutation.Asn(); ok {
+		_spec.SetField(loginattempt.FieldAsn, field.TypeUint, value)
+		_node.Asn = &value
+	}
+	if value, ok := lac.mutation.Country(); ok {
+		_spec.SetField(loginattempt.FieldCountry, field.TypeString, value)
+		_node.Country = &value
+	}
+	if value, ok := lac.mutation.Latitude(); ok {
+		_spec.SetField(loginattempt.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = &value
+	}
+	if value, ok := lac.mutation.Longitude(); ok {
+		_spec.SetField(loginattempt.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = &value
+	}
+	if value, ok := lac.mutation.RiskScore(); ok {
+		_spec.SetField(loginattempt.FieldRiskScore, field.TypeInt, value)
+		_node.RiskScore = &value
+	}
+	if value, ok := lac.mutation.RiskDecision(); ok {
+		_spec.SetField(loginattempt.FieldRiskDecision, field.TypeString, value)
+		_node.RiskDecision = &value
+	}
+	if value, ok := lac.mutation.RiskSignals(); ok {
+		_spec.SetField(loginattempt.FieldRiskSignals, field.TypeJSON, value)
+		_node.RiskSignals = value
+	}
+	if value, ok := lac.mutation.CreatedAt(); ok {
+		_spec.SetField(loginattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := lac.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loginattempt.AccountTable,
+			Columns: []string{loginattempt.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LoginAttemptCreateBulk is the builder for creating many LoginAttempt entities in bulk.
+type LoginAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*LoginAttemptCreate
+}
+
+// Save creates the LoginAttempt entities in the database.
+func (lacb *LoginAttemptCreateBulk) Save(ctx context.Context) ([]*LoginAttempt, error) {
+	if lacb.err != nil {
+		return nil, lacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lacb.builders))
+	nodes := make([]*LoginAttempt, len(lacb.builders))
+	mutators := make([]Mutator, len(lacb.builders))
+	for i := range lacb.builders {
+		func(i int, root context.Context) {
+			builder := lacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lacb *LoginAttemptCreateBulk) SaveX(ctx context.Context) []*LoginAttempt {
+	v, err := lacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lacb *LoginAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := lacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lacb *LoginAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := lacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -11,25 +12,270 @@ var (
 	// AccountsColumns holds the columns for the "accounts" table.
 	AccountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "username", Type: field.TypeString, Unique: true},
-		{Name: "password", Type: field.TypeString},
-		{Name: "hardware_id", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "username", Type: field.TypeString, Nullable: true},
+		{Name: "username_canonical", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "email_canonical", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "username_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "password", Type: field.TypeString, Nullable: true},
+		{Name: "hardware_id", Type: field.TypeString, Nullable: true},
+		{Name: "hardware_id_digest", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "access_level", Type: field.TypeString},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "banned_until", Type: field.TypeTime, Nullable: true},
 		{Name: "ban_reason", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "erased_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 0},
 	}
 	// AccountsTable holds the schema information for the "accounts" table.
 	AccountsTable = &schema.Table{
 		Name:       "accounts",
 		Columns:    AccountsColumns,
 		PrimaryKey: []*schema.Column{AccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "account_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[15]},
+			},
+			{
+				Name:    "account_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[12], AccountsColumns[0]},
+			},
+			{
+				Name:    "account_access_level_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[10], AccountsColumns[0]},
+			},
+			{
+				Name:    "account_banned_until",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[13]},
+			},
+			{
+				Name:    "account_username_canonical",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "varchar_pattern_ops",
+				},
+			},
+		},
+	}
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_entries_accounts_audit_entries",
+				Columns:    []*schema.Column{AuditEntriesColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_account_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[5], AuditEntriesColumns[4]},
+			},
+		},
+	}
+	// DevicesColumns holds the columns for the "devices" table.
+	DevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hardware_id", Type: field.TypeString},
+		{Name: "hardware_id_digest", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "approved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeInt},
+	}
+	// DevicesTable holds the schema information for the "devices" table.
+	DevicesTable = &schema.Table{
+		Name:       "devices",
+		Columns:    DevicesColumns,
+		PrimaryKey: []*schema.Column{DevicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "devices_accounts_devices",
+				Columns:    []*schema.Column{DevicesColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// EmailVerificationsColumns holds the columns for the "email_verifications" table.
+	EmailVerificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "code_digest", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+	}
+	// EmailVerificationsTable holds the schema information for the "email_verifications" table.
+	EmailVerificationsTable = &schema.Table{
+		Name:       "email_verifications",
+		Columns:    EmailVerificationsColumns,
+		PrimaryKey: []*schema.Column{EmailVerificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_verifications_accounts_email_verifications",
+				Columns:    []*schema.Column{EmailVerificationsColumns[6]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailverification_account_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailVerificationsColumns[6], EmailVerificationsColumns[5]},
+			},
+		},
+	}
+	// LoginAttemptsColumns holds the columns for the "login_attempts" table.
+	LoginAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "hardware_id_match", Type: field.TypeString},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true},
+		{Name: "asn", Type: field.TypeUint, Nullable: true},
+		{Name: "country", Type: field.TypeString, Nullable: true},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "risk_score", Type: field.TypeInt, Nullable: true},
+		{Name: "risk_decision", Type: field.TypeString, Nullable: true},
+		{Name: "risk_signals", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+	}
+	// LoginAttemptsTable holds the schema information for the "login_attempts" table.
+	LoginAttemptsTable = &schema.Table{
+		Name:       "login_attempts",
+		Columns:    LoginAttemptsColumns,
+		PrimaryKey: []*schema.Column{LoginAttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "login_attempts_accounts_login_attempts",
+				Columns:    []*schema.Column{LoginAttemptsColumns[13]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginattempt_account_id_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[13], LoginAttemptsColumns[12], LoginAttemptsColumns[0]},
+			},
+			{
+				Name:    "loginattempt_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[12]},
+			},
+			{
+				Name:    "loginattempt_ip_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[1], LoginAttemptsColumns[12]},
+			},
+		},
+	}
+	// UnknownLoginAttemptsColumns holds the columns for the "unknown_login_attempts" table.
+	UnknownLoginAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "ip", Type: field.TypeString},
+		{Name: "login_digest", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UnknownLoginAttemptsTable holds the schema information for the "unknown_login_attempts" table.
+	UnknownLoginAttemptsTable = &schema.Table{
+		Name:       "unknown_login_attempts",
+		Columns:    UnknownLoginAttemptsColumns,
+		PrimaryKey: []*schema.Column{UnknownLoginAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unknownloginattempt_ip_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UnknownLoginAttemptsColumns[1], UnknownLoginAttemptsColumns[3]},
+			},
+			{
+				Name:    "unknownloginattempt_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UnknownLoginAttemptsColumns[3]},
+			},
+		},
+	}
+	// UsernameChangesColumns holds the columns for the "username_changes" table.
+	UsernameChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString},
+		{Name: "username_canonical", Type: field.TypeString},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "reserved_until", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+	}
+	// UsernameChangesTable holds the schema information for the "username_changes" table.
+	UsernameChangesTable = &schema.Table{
+		Name:       "username_changes",
+		Columns:    UsernameChangesColumns,
+		PrimaryKey: []*schema.Column{UsernameChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "username_changes_accounts_username_changes",
+				Columns:    []*schema.Column{UsernameChangesColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usernamechange_username_canonical_changed_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsernameChangesColumns[2], UsernameChangesColumns[3]},
+			},
+			{
+				Name:    "usernamechange_account_id_changed_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsernameChangesColumns[5], UsernameChangesColumns[3]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccountsTable,
+		AuditEntriesTable,
+		DevicesTable,
+		EmailVerificationsTable,
+		LoginAttemptsTable,
+		UnknownLoginAttemptsTable,
+		UsernameChangesTable,
 	}
 )
 
 func init() {
+	AuditEntriesTable.ForeignKeys[0].RefTable = AccountsTable
+	DevicesTable.ForeignKeys[0].RefTable = AccountsTable
+	EmailVerificationsTable.ForeignKeys[0].RefTable = AccountsTable
+	LoginAttemptsTable.ForeignKeys[0].RefTable = AccountsTable
+	UsernameChangesTable.ForeignKeys[0].RefTable = AccountsTable
 }
@@ -8,3 +8,21 @@ import (
 
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
+
+// AuditEntry is the predicate function for auditentry builders.
+type AuditEntry func(*sql.Selector)
+
+// Device is the predicate function for device builders.
+type Device func(*sql.Selector)
+
+// EmailVerification is the predicate function for emailverification builders.
+type EmailVerification func(*sql.Selector)
+
+// LoginAttempt is the predicate function for loginattempt builders.
+type LoginAttempt func(*sql.Selector)
+
+// UnknownLoginAttempt is the predicate function for unknownloginattempt builders.
+type UnknownLoginAttempt func(*sql.Selector)
+
+// UsernameChange is the predicate function for usernamechange builders.
+type UsernameChange func(*sql.Selector)
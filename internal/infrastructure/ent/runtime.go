@@ -8,6 +8,12 @@ import (
 	"github.com/intezya/auth_service/dbschema"
 	domain "github.com/intezya/auth_service/internal/domain/account"
 	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/auditentry"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/device"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/emailverification"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/loginattempt"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/unknownloginattempt"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -20,16 +26,108 @@ func init() {
 	accountDescUsername := accountFields[1].Descriptor()
 	// account.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	account.UsernameValidator = accountDescUsername.Validators[0].(func(string) error)
+	// accountDescUsernameCanonical is the schema descriptor for username_canonical field.
+	accountDescUsernameCanonical := accountFields[2].Descriptor()
+	// account.UsernameCanonicalValidator is a validator for the "username_canonical" field. It is called by the builders before save.
+	account.UsernameCanonicalValidator = accountDescUsernameCanonical.Validators[0].(func(string) error)
+	// accountDescEmail is the schema descriptor for email field.
+	accountDescEmail := accountFields[3].Descriptor()
+	// account.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	account.EmailValidator = accountDescEmail.Validators[0].(func(string) error)
+	// accountDescEmailCanonical is the schema descriptor for email_canonical field.
+	accountDescEmailCanonical := accountFields[4].Descriptor()
+	// account.EmailCanonicalValidator is a validator for the "email_canonical" field. It is called by the builders before save.
+	account.EmailCanonicalValidator = accountDescEmailCanonical.Validators[0].(func(string) error)
 	// accountDescPassword is the schema descriptor for password field.
-	accountDescPassword := accountFields[2].Descriptor()
+	accountDescPassword := accountFields[7].Descriptor()
 	// account.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	account.PasswordValidator = accountDescPassword.Validators[0].(func(string) error)
 	// accountDescAccessLevel is the schema descriptor for access_level field.
-	accountDescAccessLevel := accountFields[4].Descriptor()
+	accountDescAccessLevel := accountFields[10].Descriptor()
 	// account.DefaultAccessLevel holds the default value on creation for the access_level field.
 	account.DefaultAccessLevel = accountDescAccessLevel.Default.(func() domain.AccessLevel)
 	// accountDescCreatedAt is the schema descriptor for created_at field.
-	accountDescCreatedAt := accountFields[5].Descriptor()
+	accountDescCreatedAt := accountFields[12].Descriptor()
 	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
 	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
+	// accountDescVersion is the schema descriptor for version field.
+	accountDescVersion := accountFields[17].Descriptor()
+	// account.DefaultVersion holds the default value on creation for the version field.
+	account.DefaultVersion = accountDescVersion.Default.(int)
+	auditentryFields := dbschema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescAction is the schema descriptor for action field.
+	auditentryDescAction := auditentryFields[2].Descriptor()
+	// auditentry.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditentry.ActionValidator = auditentryDescAction.Validators[0].(func(string) error)
+	// auditentryDescActor is the schema descriptor for actor field.
+	auditentryDescActor := auditentryFields[3].Descriptor()
+	// auditentry.ActorValidator is a validator for the "actor" field. It is called by the builders before save.
+	auditentry.ActorValidator = auditentryDescActor.Validators[0].(func(string) error)
+	// auditentryDescCreatedAt is the schema descriptor for created_at field.
+	auditentryDescCreatedAt := auditentryFields[5].Descriptor()
+	// auditentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditentry.DefaultCreatedAt = auditentryDescCreatedAt.Default.(func() time.Time)
+	deviceFields := dbschema.Device{}.Fields()
+	_ = deviceFields
+	// deviceDescHardwareID is the schema descriptor for hardware_id field.
+	deviceDescHardwareID := deviceFields[2].Descriptor()
+	// device.HardwareIDValidator is a validator for the "hardware_id" field. It is called by the builders before save.
+	device.HardwareIDValidator = deviceDescHardwareID.Validators[0].(func(string) error)
+	// deviceDescHardwareIDDigest is the schema descriptor for hardware_id_digest field.
+	deviceDescHardwareIDDigest := deviceFields[3].Descriptor()
+	// device.HardwareIDDigestValidator is a validator for the "hardware_id_digest" field. It is called by the builders before save.
+	device.HardwareIDDigestValidator = deviceDescHardwareIDDigest.Validators[0].(func(string) error)
+	// deviceDescStatus is the schema descriptor for status field.
+	deviceDescStatus := deviceFields[4].Descriptor()
+	// device.DefaultStatus holds the default value on creation for the status field.
+	device.DefaultStatus = deviceDescStatus.Default.(func() domain.DeviceStatus)
+	// deviceDescCreatedAt is the schema descriptor for created_at field.
+	deviceDescCreatedAt := deviceFields[5].Descriptor()
+	// device.DefaultCreatedAt holds the default value on creation for the created_at field.
+	device.DefaultCreatedAt = deviceDescCreatedAt.Default.(func() time.Time)
+	emailverificationFields := dbschema.EmailVerification{}.Fields()
+	_ = emailverificationFields
+	// emailverificationDescEmail is the schema descriptor for email field.
+	emailverificationDescEmail := emailverificationFields[2].Descriptor()
+	// emailverification.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	emailverification.EmailValidator = emailverificationDescEmail.Validators[0].(func(string) error)
+	// emailverificationDescCodeDigest is the schema descriptor for code_digest field.
+	emailverificationDescCodeDigest := emailverificationFields[3].Descriptor()
+	// emailverification.CodeDigestValidator is a validator for the "code_digest" field. It is called by the builders before save.
+	emailverification.CodeDigestValidator = emailverificationDescCodeDigest.Validators[0].(func(string) error)
+	// emailverificationDescAttempts is the schema descriptor for attempts field.
+	emailverificationDescAttempts := emailverificationFields[4].Descriptor()
+	// emailverification.DefaultAttempts holds the default value on creation for the attempts field.
+	emailverification.DefaultAttempts = emailverificationDescAttempts.Default.(int)
+	// emailverificationDescCreatedAt is the schema descriptor for created_at field.
+	emailverificationDescCreatedAt := emailverificationFields[6].Descriptor()
+	// emailverification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailverification.DefaultCreatedAt = emailverificationDescCreatedAt.Default.(func() time.Time)
+	loginattemptFields := dbschema.LoginAttempt{}.Fields()
+	_ = loginattemptFields
+	// loginattemptDescCreatedAt is the schema descriptor for created_at field.
+	loginattemptDescCreatedAt := loginattemptFields[13].Descriptor()
+	// loginattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginattempt.DefaultCreatedAt = loginattemptDescCreatedAt.Default.(func() time.Time)
+	unknownloginattemptFields := dbschema.UnknownLoginAttempt{}.Fields()
+	_ = unknownloginattemptFields
+	// unknownloginattemptDescCreatedAt is the schema descriptor for created_at field.
+	unknownloginattemptDescCreatedAt := unknownloginattemptFields[3].Descriptor()
+	// unknownloginattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	unknownloginattempt.DefaultCreatedAt = unknownloginattemptDescCreatedAt.Default.(func() time.Time)
+	usernamechangeFields := dbschema.UsernameChange{}.Fields()
+	_ = usernamechangeFields
+	// usernamechangeDescUsername is the schema descriptor for username field.
+	usernamechangeDescUsername := usernamechangeFields[2].Descriptor()
+	// usernamechange.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	usernamechange.UsernameValidator = usernamechangeDescUsername.Validators[0].(func(string) error)
+	// usernamechangeDescUsernameCanonical is the schema descriptor for username_canonical field.
+	usernamechangeDescUsernameCanonical := usernamechangeFields[3].Descriptor()
+	// usernamechange.UsernameCanonicalValidator is a validator for the "username_canonical" field. It is called by the builders before save.
+	usernamechange.UsernameCanonicalValidator = usernamechangeDescUsernameCanonical.Validators[0].(func(string) error)
+	// usernamechangeDescChangedAt is the schema descriptor for changed_at field.
+	usernamechangeDescChangedAt := usernamechangeFields[4].Descriptor()
+	// usernamechange.DefaultChangedAt holds the default value on creation for the changed_at field.
+	usernamechange.DefaultChangedAt = usernamechangeDescChangedAt.Default.(func() time.Time)
 }
@@ -14,6 +14,18 @@ type Tx struct {
 	config
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
+	// Device is the client for interacting with the Device builders.
+	Device *DeviceClient
+	// EmailVerification is the client for interacting with the EmailVerification builders.
+	EmailVerification *EmailVerificationClient
+	// LoginAttempt is the client for interacting with the LoginAttempt builders.
+	LoginAttempt *LoginAttemptClient
+	// UnknownLoginAttempt is the client for interacting with the UnknownLoginAttempt builders.
+	UnknownLoginAttempt *UnknownLoginAttemptClient
+	// UsernameChange is the client for interacting with the UsernameChange builders.
+	UsernameChange *UsernameChangeClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +158,12 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Account = NewAccountClient(tx.config)
+	tx.AuditEntry = NewAuditEntryClient(tx.config)
+	tx.Device = NewDeviceClient(tx.config)
+	tx.EmailVerification = NewEmailVerificationClient(tx.config)
+	tx.LoginAttempt = NewLoginAttemptClient(tx.config)
+	tx.UnknownLoginAttempt = NewUnknownLoginAttemptClient(tx.config)
+	tx.UsernameChange = NewUsernameChangeClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/unknownloginattempt"
+)
+
+// UnknownLoginAttempt is the model entity for the UnknownLoginAttempt schema.
+type UnknownLoginAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// LoginDigest holds the value of the "login_digest" field.
+	LoginDigest string `json:"login_digest,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UnknownLoginAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case unknownloginattempt.FieldID:
+			values[i] = new(sql.NullInt64)
+		case unknownloginattempt.FieldIP, unknownloginattempt.FieldLoginDigest:
+			values[i] = new(sql.NullString)
+		case unknownloginattempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UnknownLoginAttempt fields.
+func (ula *UnknownLoginAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case unknownloginattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ula.ID = int(value.Int64)
+		case unknownloginattempt.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				ula.IP = value.String
+			}
+		case unknownloginattempt.FieldLoginDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field login_digest", values[i])
+			} else if value.Valid {
+				ula.LoginDigest = value.String
+			}
+		case unknownloginattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ula.CreatedAt = value.Time
+			}
+		default:
+			ula.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UnknownLoginAttempt.
+// This includes values selected through modifiers, order, etc.
+func (ula *UnknownLoginAttempt) Value(name string) (ent.Value, error) {
+	return ula.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UnknownLoginAttempt.
+// Note that you need to call UnknownLoginAttempt.Unwrap() before calling this method if this UnknownLoginAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ula *UnknownLoginAttempt) Update() *UnknownLoginAttemptUpdateOne {
+	return NewUnknownLoginAttemptClient(ula.config).UpdateOne(ula)
+}
+
+// Unwrap unwraps the UnknownLoginAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ula *UnknownLoginAttempt) Unwrap() *UnknownLoginAttempt {
+	_tx, ok := ula.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UnknownLoginAttempt is not a transactional entity")
+	}
+	ula.config.driver = _tx.drv
+	return ula
+}
+
+// String implements the fmt.Stringer.
+func (ula *UnknownLoginAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("UnknownLoginAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ula.ID))
+	builder.WriteString("ip=")
+	builder.WriteString(ula.IP)
+	builder.WriteString(", ")
+	builder.WriteString("login_digest=")
+	builder.WriteString(ula.LoginDigest)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ula.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UnknownLoginAttempts is a parsable slice of UnknownLoginAttempt.
+type UnknownLoginAttempts []*UnknownLoginAttempt
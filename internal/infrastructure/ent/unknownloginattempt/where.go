@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package unknownloginattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldEQ(FieldIP, v))
+}
+
+// LoginDigest applies equality check predicate on the "login_digest" field. It's identical to LoginDigestEQ.
+func LoginDigest(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldEQ(FieldLoginDigest, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldContainsFold(FieldIP, v))
+}
+
+// LoginDigestEQ applies the EQ predicate on the "login_digest" field.
+func LoginDigestEQ(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldEQ(FieldLoginDigest, v))
+}
+
+// LoginDigestNEQ applies the NEQ predicate on the "login_digest" field.
+func LoginDigestNEQ(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldNEQ(FieldLoginDigest, v))
+}
+
+// LoginDigestIn applies the In predicate on the "login_digest" field.
+func LoginDigestIn(vs ...string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldIn(FieldLoginDigest, vs...))
+}
+
+// LoginDigestNotIn applies the NotIn predicate on the "login_digest" field.
+func LoginDigestNotIn(vs ...string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldNotIn(FieldLoginDigest, vs...))
+}
+
+// LoginDigestGT applies the GT predicate on the "login_digest" field.
+func LoginDigestGT(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldGT(FieldLoginDigest, v))
+}
+
+// LoginDigestGTE applies the GTE predicate on the "login_digest" field.
+func LoginDigestGTE(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldGTE(FieldLoginDigest, v))
+}
+
+// LoginDigestLT applies the LT predicate on the "login_digest" field.
+func LoginDigestLT(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldLT(FieldLoginDigest, v))
+}
+
+// LoginDigestLTE applies the LTE predicate on the "login_digest" field.
+func LoginDigestLTE(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldLTE(FieldLoginDigest, v))
+}
+
+// LoginDigestContains applies the Contains predicate on the "login_digest" field.
+func LoginDigestContains(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldContains(FieldLoginDigest, v))
+}
+
+// LoginDigestHasPrefix applies the HasPrefix predicate on the "login_digest" field.
+func LoginDigestHasPrefix(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldHasPrefix(FieldLoginDigest, v))
+}
+
+// LoginDigestHasSuffix applies the HasSuffix predicate on the "login_digest" field.
+func LoginDigestHasSuffix(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldHasSuffix(FieldLoginDigest, v))
+}
+
+// LoginDigestEqualFold applies the EqualFold predicate on the "login_digest" field.
+func LoginDigestEqualFold(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldEqualFold(FieldLoginDigest, v))
+}
+
+// LoginDigestContainsFold applies the ContainsFold predicate on the "login_digest" field.
+func LoginDigestContainsFold(v string) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldContainsFold(FieldLoginDigest, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UnknownLoginAttempt) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UnknownLoginAttempt) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UnknownLoginAttempt) predicate.UnknownLoginAttempt {
+	return predicate.UnknownLoginAttempt(sql.NotPredicates(p))
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/unknownloginattempt"
+)
+
+// UnknownLoginAttemptCreate is the builder for creating a UnknownLoginAttempt entity.
+type UnknownLoginAttemptCreate struct {
+	config
+	mutation *UnknownLoginAttemptMutation
+	hooks    []Hook
+}
+
+// SetIP sets the "ip" field.
+func (ulac *UnknownLoginAttemptCreate) SetIP(s string) *UnknownLoginAttemptCreate {
+	ulac.mutation.SetIP(s)
+	return ulac
+}
+
+// SetLoginDigest sets the "login_digest" field.
+func (ulac *UnknownLoginAttemptCreate) SetLoginDigest(s string) *UnknownLoginAttemptCreate {
+	ulac.mutation.SetLoginDigest(s)
+	return ulac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ulac *UnknownLoginAttemptCreate) SetCreatedAt(t time.Time) *UnknownLoginAttemptCreate {
+	ulac.mutation.SetCreatedAt(t)
+	return ulac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ulac *UnknownLoginAttemptCreate) SetNillableCreatedAt(t *time.Time) *UnknownLoginAttemptCreate {
+	if t != nil {
+		ulac.SetCreatedAt(*t)
+	}
+	return ulac
+}
+
+// SetID sets the "id" field.
+func (ulac *UnknownLoginAttemptCreate) SetID(i int) *UnknownLoginAttemptCreate {
+	ulac.mutation.SetID(i)
+	return ulac
+}
+
+// Mutation returns the UnknownLoginAttemptMutation object of the builder.
+func (ulac *UnknownLoginAttemptCreate) Mutation() *UnknownLoginAttemptMutation {
+	return ulac.mutation
+}
+
+// Save creates the UnknownLoginAttempt in the database.
+func (ulac *UnknownLoginAttemptCreate) Save(ctx context.Context) (*UnknownLoginAttempt, error) {
+	ulac.defaults()
+	return withHooks(ctx, ulac.sqlSave, ulac.mutation, ulac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ulac *UnknownLoginAttemptCreate) SaveX(ctx context.Context) *UnknownLoginAttempt {
+	v, err := ulac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ulac *UnknownLoginAttemptCreate) Exec(ctx context.Context) error {
+	_, err := ulac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ulac *UnknownLoginAttemptCreate) ExecX(ctx context.Context) {
+	if err := ulac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ulac *UnknownLoginAttemptCreate) defaults() {
+	if _, ok := ulac.mutation.CreatedAt(); !ok {
+		v := unknownloginattempt.DefaultCreatedAt()
+		ulac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ulac *UnknownLoginAttemptCreate) check() error {
+	if _, ok := ulac.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "UnknownLoginAttempt.ip"`)}
+	}
+	if _, ok := ulac.mutation.LoginDigest(); !ok {
+		return &ValidationError{Name: "login_digest", err: errors.New(`ent: missing required field "UnknownLoginAttempt.login_digest"`)}
+	}
+	if _, ok := ulac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UnknownLoginAttempt.created_at"`)}
+	}
+	return nil
+}
+
+func (ulac *UnknownLoginAttemptCreate) sqlSave(ctx context.Context) (*UnknownLoginAttempt, error) {
+	if err := ulac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ulac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ulac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	ulac.mutation.id = &_node.ID
+	ulac.mutation.done = true
+	return _node, nil
+}
+
+func (ulac *UnknownLoginAttemptCreate) createSpec() (*UnknownLoginAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UnknownLoginAttempt{config: ulac.config}
+		_spec = sqlgraph.NewCreateSpec(unknownloginattempt.Table, sqlgraph.NewFieldSpec(unknownloginattempt.FieldID, field.TypeInt))
+	)
+	if id, ok := ulac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ulac.mutation.IP(); ok {
+		_spec.SetField(unknownloginattempt.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := ulac.mutation.LoginDigest(); ok {
+		_spec.SetField(unknownloginattempt.FieldLoginDigest, field.TypeString, value)
+		_node.LoginDigest = value
+	}
+	if value, ok := ulac.mutation.CreatedAt(); ok {
+		_spec.SetField(unknownloginattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// UnknownLoginAttemptCreateBulk is the builder for creating many UnknownLoginAttempt entities in bulk.
+type UnknownLoginAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*UnknownLoginAttemptCreate
+}
+
+// Save creates the UnknownLoginAttempt entities in the database.
+func (ulacb *UnknownLoginAttemptCreateBulk) Save(ctx context.Context) ([]*UnknownLoginAttempt, error) {
+	if ulacb.err != nil {
+		return nil, ulacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ulacb.builders))
+	nodes := make([]*UnknownLoginAttempt, len(ulacb.builders))
+	mutators := make([]Mutator, len(ulacb.builders))
+	for i := range ulacb.builders {
+		func(i int, root context.Context) {
+			builder := ulacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UnknownLoginAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ulacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ulacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ulacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ulacb *UnknownLoginAttemptCreateBulk) SaveX(ctx context.Context) []*UnknownLoginAttempt {
+	v, err := ulacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ulacb *UnknownLoginAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := ulacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ulacb *UnknownLoginAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := ulacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/unknownloginattempt"
+)
+
+// UnknownLoginAttemptDelete is the builder for deleting a UnknownLoginAttempt entity.
+type UnknownLoginAttemptDelete struct {
+	config
+	hooks    []Hook
+	mutation *UnknownLoginAttemptMutation
+}
+
+// Where appends a list predicates to the UnknownLoginAttemptDelete builder.
+func (ulad *UnknownLoginAttemptDelete) Where(ps ...predicate.UnknownLoginAttempt) *UnknownLoginAttemptDelete {
+	ulad.mutation.Where(ps...)
+	return ulad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ulad *UnknownLoginAttemptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ulad.sqlExec, ulad.mutation, ulad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ulad *UnknownLoginAttemptDelete) ExecX(ctx context.Context) int {
+	n, err := ulad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ulad *UnknownLoginAttemptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(unknownloginattempt.Table, sqlgraph.NewFieldSpec(unknownloginattempt.FieldID, field.TypeInt))
+	if ps := ulad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ulad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ulad.mutation.done = true
+	return affected, err
+}
+
+// UnknownLoginAttemptDeleteOne is the builder for deleting a single UnknownLoginAttempt entity.
+type UnknownLoginAttemptDeleteOne struct {
+	ulad *UnknownLoginAttemptDelete
+}
+
+// Where appends a list predicates to the UnknownLoginAttemptDelete builder.
+func (ulado *UnknownLoginAttemptDeleteOne) Where(ps ...predicate.UnknownLoginAttempt) *UnknownLoginAttemptDeleteOne {
+	ulado.ulad.mutation.Where(ps...)
+	return ulado
+}
+
+// Exec executes the deletion query.
+func (ulado *UnknownLoginAttemptDeleteOne) Exec(ctx context.Context) error {
+	n, err := ulado.ulad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{unknownloginattempt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ulado *UnknownLoginAttemptDeleteOne) ExecX(ctx context.Context) {
+	if err := ulado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
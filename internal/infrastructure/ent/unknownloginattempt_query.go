@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/unknownloginattempt"
+)
+
+// UnknownLoginAttemptQuery is the builder for querying UnknownLoginAttempt entities.
+type UnknownLoginAttemptQuery struct {
+	config
+	ctx        *QueryContext
+	order      []unknownloginattempt.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UnknownLoginAttempt
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UnknownLoginAttemptQuery builder.
+func (ulaq *UnknownLoginAttemptQuery) Where(ps ...predicate.UnknownLoginAttempt) *UnknownLoginAttemptQuery {
+	ulaq.predicates = append(ulaq.predicates, ps...)
+	return ulaq
+}
+
+// Limit the number of records to be returned by this query.
+func (ulaq *UnknownLoginAttemptQuery) Limit(limit int) *UnknownLoginAttemptQuery {
+	ulaq.ctx.Limit = &limit
+	return ulaq
+}
+
+// Offset to start from.
+func (ulaq *UnknownLoginAttemptQuery) Offset(offset int) *UnknownLoginAttemptQuery {
+	ulaq.ctx.Offset = &offset
+	return ulaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ulaq *UnknownLoginAttemptQuery) Unique(unique bool) *UnknownLoginAttemptQuery {
+	ulaq.ctx.Unique = &unique
+	return ulaq
+}
+
+// Order specifies how the records should be ordered.
+func (ulaq *UnknownLoginAttemptQuery) Order(o ...unknownloginattempt.OrderOption) *UnknownLoginAttemptQuery {
+	ulaq.order = append(ulaq.order, o...)
+	return ulaq
+}
+
+// First returns the first UnknownLoginAttempt entity from the query.
+// Returns a *NotFoundError when no UnknownLoginAttempt was found.
+func (ulaq *UnknownLoginAttemptQuery) First(ctx context.Context) (*UnknownLoginAttempt, error) {
+	nodes, err := ulaq.Limit(1).All(setContextOp(ctx, ulaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{unknownloginattempt.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ulaq *UnknownLoginAttemptQuery) FirstX(ctx context.Context) *UnknownLoginAttempt {
+	node, err := ulaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UnknownLoginAttempt ID from the query.
+// Returns a *NotFoundError when no UnknownLoginAttempt ID was found.
+func (ulaq *UnknownLoginAttemptQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ulaq.Limit(1).IDs(setContextOp(ctx, ulaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{unknownloginattempt.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ulaq *UnknownLoginAttemptQuery) FirstIDX(ctx context.Context) int {
+	id, err := ulaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UnknownLoginAttempt entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UnknownLoginAttempt entity is found.
+// Returns a *NotFoundError when no UnknownLoginAttempt entities are found.
+func (ulaq *UnknownLoginAttemptQuery) Only(ctx context.Context) (*UnknownLoginAttempt, error) {
+	nodes, err := ulaq.Limit(2).All(setContextOp(ctx, ulaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{unknownloginattempt.Label}
+	default:
+		return nil, &NotSingularError{unknownloginattempt.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ulaq *UnknownLoginAttemptQuery) OnlyX(ctx context.Context) *UnknownLoginAttempt {
+	node, err := ulaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UnknownLoginAttempt ID in the query.
+// Returns a *NotSingularError when more than one UnknownLoginAttempt ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ulaq *UnknownLoginAttemptQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ulaq.Limit(2).IDs(setContextOp(ctx, ulaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{unknownloginattempt.Label}
+	default:
+		err = &NotSingularError{unknownloginattempt.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ulaq *UnknownLoginAttemptQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ulaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UnknownLoginAttempts.
+func (ulaq *UnknownLoginAttemptQuery) All(ctx context.Context) ([]*UnknownLoginAttempt, error) {
+	ctx = setContextOp(ctx, ulaq.ctx, ent.OpQueryAll)
+	if err := ulaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UnknownLoginAttempt, *UnknownLoginAttemptQuery]()
+	return withInterceptors[[]*UnknownLoginAttempt](ctx, ulaq, qr, ulaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ulaq *UnknownLoginAttemptQuery) AllX(ctx context.Context) []*UnknownLoginAttempt {
+	nodes, err := ulaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UnknownLoginAttempt IDs.
+func (ulaq *UnknownLoginAttemptQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ulaq.ctx.Unique == nil && ulaq.path != nil {
+		ulaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ulaq.ctx, ent.OpQueryIDs)
+	if err = ulaq.Select(unknownloginattempt.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ulaq *UnknownLoginAttemptQuery) IDsX(ctx context.Context) []int {
+	ids, err := ulaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ulaq *UnknownLoginAttemptQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ulaq.ctx, ent.OpQueryCount)
+	if err := ulaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ulaq, querierCount[*UnknownLoginAttemptQuery](), ulaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ulaq *UnknownLoginAttemptQuery) CountX(ctx context.Context) int {
+	count, err := ulaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ulaq *UnknownLoginAttemptQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ulaq.ctx, ent.OpQueryExist)
+	switch _, err := ulaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ulaq *UnknownLoginAttemptQuery) ExistX(ctx context.Context) bool {
+	exist, err := ulaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UnknownLoginAttemptQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ulaq *UnknownLoginAttemptQuery) Clone() *UnknownLoginAttemptQuery {
+	if ulaq == nil {
+		return nil
+	}
+	return &UnknownLoginAttemptQuery{
+		config:     ulaq.config,
+		ctx:        ulaq.ctx.Clone(),
+		order:      append([]unknownloginattempt.OrderOption{}, ulaq.order...),
+		inters:     append([]Interceptor{}, ulaq.inters...),
+		predicates: append([]predicate.UnknownLoginAttempt{}, ulaq.predicates...),
+		// clone intermediate query.
+		sql:  ulaq.sql.Clone(),
+		path: ulaq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		IP string `json:"ip,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UnknownLoginAttempt.Query().
+//		GroupBy(unknownloginattempt.FieldIP).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ulaq *UnknownLoginAttemptQuery) GroupBy(field string, fields ...string) *UnknownLoginAttemptGroupBy {
+	ulaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UnknownLoginAttemptGroupBy{build: ulaq}
+	grbuild.flds = &ulaq.ctx.Fields
+	grbuild.label = unknownloginattempt.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		IP string `json:"ip,omitempty"`
+//	}
+//
+//	client.UnknownLoginAttempt.Query().
+//		Select(unknownloginattempt.FieldIP).
+//		Scan(ctx, &v)
+func (ulaq *UnknownLoginAttemptQuery) Select(fields ...string) *UnknownLoginAttemptSelect {
+	ulaq.ctx.Fields = append(ulaq.ctx.Fields, fields...)
+	sbuild := &UnknownLoginAttemptSelect{UnknownLoginAttemptQuery: ulaq}
+	sbuild.label = unknownloginattempt.Label
+	sbuild.flds, sbuild.scan = &ulaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UnknownLoginAttemptSelect configured with the given aggregations.
+func (ulaq *UnknownLoginAttemptQuery) Aggregate(fns ...AggregateFunc) *UnknownLoginAttemptSelect {
+	return ulaq.Select().Aggregate(fns...)
+}
+
+func (ulaq *UnknownLoginAttemptQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ulaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ulaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ulaq.ctx.Fields {
+		if !unknownloginattempt.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ulaq.path != nil {
+		prev, err := ulaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ulaq.sql = prev
+	}
+	return nil
+}
+
+func (ulaq *UnknownLoginAttemptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UnknownLoginAttempt, error) {
+	var (
+		nodes = []*UnknownLoginAttempt{}
+		_spec = ulaq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UnknownLoginAttempt).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UnknownLoginAttempt{config: ulaq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ulaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ulaq *UnknownLoginAttemptQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ulaq.querySpec()
+	_spec.Node.Columns = ulaq.ctx.Fields
+	if len(ulaq.ctx.Fields) > 0 {
+		_spec.Unique = ulaq.ctx.Unique != nil && *ulaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ulaq.driver, _spec)
+}
+
+func (ulaq *UnknownLoginAttemptQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(unknownloginattempt.Table, unknownloginattempt.Columns, sqlgraph.NewFieldSpec(unknownloginattempt.FieldID, field.TypeInt))
+	_spec.From = ulaq.sql
+	if unique := ulaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ulaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ulaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, unknownloginattempt.FieldID)
+		for i := range fields {
+			if fields[i] != unknownloginattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ulaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ulaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ulaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ulaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ulaq *UnknownLoginAttemptQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ulaq.driver.Dialect())
+	t1 := builder.Table(unknownloginattempt.Table)
+	columns := ulaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = unknownloginattempt.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ulaq.sql != nil {
+		selector = ulaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ulaq.ctx.Unique != nil && *ulaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ulaq.predicates {
+		p(selector)
+	}
+	for _, p := range ulaq.order {
+		p(selector)
+	}
+	if offset := ulaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ulaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UnknownLoginAttemptGroupBy is the group-by builder for UnknownLoginAttempt entities.
+type UnknownLoginAttemptGroupBy struct {
+	selector
+	build *UnknownLoginAttemptQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ulagb *UnknownLoginAttemptGroupBy) Aggregate(fns ...AggregateFunc) *UnknownLoginAttemptGroupBy {
+	ulagb.fns = append(ulagb.fns, fns...)
+	return ulagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ulagb *UnknownLoginAttemptGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ulagb.build.ctx, ent.OpQueryGroupBy)
+	if err := ulagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UnknownLoginAttemptQuery, *UnknownLoginAttemptGroupBy](ctx, ulagb.build, ulagb, ulagb.build.inters, v)
+}
+
+func (ulagb *UnknownLoginAttemptGroupBy) sqlScan(ctx context.Context, root *UnknownLoginAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ulagb.fns))
+	for _, fn := range ulagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ulagb.flds)+len(ulagb.fns))
+		for _, f := range *ulagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ulagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ulagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UnknownLoginAttemptSelect is the builder for selecting fields of UnknownLoginAttempt entities.
+type UnknownLoginAttemptSelect struct {
+	*UnknownLoginAttemptQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ulas *UnknownLoginAttemptSelect) Aggregate(fns ...AggregateFunc) *UnknownLoginAttemptSelect {
+	ulas.fns = append(ulas.fns, fns...)
+	return ulas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ulas *UnknownLoginAttemptSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ulas.ctx, ent.OpQuerySelect)
+	if err := ulas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UnknownLoginAttemptQuery, *UnknownLoginAttemptSelect](ctx, ulas.UnknownLoginAttemptQuery, ulas, ulas.inters, v)
+}
+
+func (ulas *UnknownLoginAttemptSelect) sqlScan(ctx context.Context, root *UnknownLoginAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ulas.fns))
+	for _, fn := range ulas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ulas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ulas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/unknownloginattempt"
+)
+
+// UnknownLoginAttemptUpdate is the builder for updating UnknownLoginAttempt entities.
+type UnknownLoginAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UnknownLoginAttemptMutation
+}
+
+// Where appends a list predicates to the UnknownLoginAttemptUpdate builder.
+func (ulau *UnknownLoginAttemptUpdate) Where(ps ...predicate.UnknownLoginAttempt) *UnknownLoginAttemptUpdate {
+	ulau.mutation.Where(ps...)
+	return ulau
+}
+
+// Mutation returns the UnknownLoginAttemptMutation object of the builder.
+func (ulau *UnknownLoginAttemptUpdate) Mutation() *UnknownLoginAttemptMutation {
+	return ulau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ulau *UnknownLoginAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ulau.sqlSave, ulau.mutation, ulau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ulau *UnknownLoginAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := ulau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ulau *UnknownLoginAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := ulau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ulau *UnknownLoginAttemptUpdate) ExecX(ctx context.Context) {
+	if err := ulau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ulau *UnknownLoginAttemptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(unknownloginattempt.Table, unknownloginattempt.Columns, sqlgraph.NewFieldSpec(unknownloginattempt.FieldID, field.TypeInt))
+	if ps := ulau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ulau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{unknownloginattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ulau.mutation.done = true
+	return n, nil
+}
+
+// UnknownLoginAttemptUpdateOne is the builder for updating a single UnknownLoginAttempt entity.
+type UnknownLoginAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UnknownLoginAttemptMutation
+}
+
+// Mutation returns the UnknownLoginAttemptMutation object of the builder.
+func (ulauo *UnknownLoginAttemptUpdateOne) Mutation() *UnknownLoginAttemptMutation {
+	return ulauo.mutation
+}
+
+// Where appends a list predicates to the UnknownLoginAttemptUpdate builder.
+func (ulauo *UnknownLoginAttemptUpdateOne) Where(ps ...predicate.UnknownLoginAttempt) *UnknownLoginAttemptUpdateOne {
+	ulauo.mutation.Where(ps...)
+	return ulauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ulauo *UnknownLoginAttemptUpdateOne) Select(field string, fields ...string) *UnknownLoginAttemptUpdateOne {
+	ulauo.fields = append([]string{field}, fields...)
+	return ulauo
+}
+
+// Save executes the query and returns the updated UnknownLoginAttempt entity.
+func (ulauo *UnknownLoginAttemptUpdateOne) Save(ctx context.Context) (*UnknownLoginAttempt, error) {
+	return withHooks(ctx, ulauo.sqlSave, ulauo.mutation, ulauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ulauo *UnknownLoginAttemptUpdateOne) SaveX(ctx context.Context) *UnknownLoginAttempt {
+	node, err := ulauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ulauo *UnknownLoginAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := ulauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ulauo *UnknownLoginAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := ulauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ulauo *UnknownLoginAttemptUpdateOne) sqlSave(ctx context.Context) (_node *UnknownLoginAttempt, err error) {
+	_spec := sqlgraph.NewUpdateSpec(unknownloginattempt.Table, unknownloginattempt.Columns, sqlgraph.NewFieldSpec(unknownloginattempt.FieldID, field.TypeInt))
+	id, ok := ulauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UnknownLoginAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ulauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, unknownloginattempt.FieldID)
+		for _, f := range fields {
+			if !unknownloginattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != unknownloginattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ulauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &UnknownLoginAttempt{config: ulauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ulauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{unknownloginattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ulauo.mutation.done = true
+	return _node, nil
+}
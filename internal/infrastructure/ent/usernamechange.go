@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
+)
+
+// UsernameChange is the model entity for the UsernameChange schema.
+type UsernameChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// UsernameCanonical holds the value of the "username_canonical" field.
+	UsernameCanonical string `json:"username_canonical,omitempty"`
+	// ChangedAt holds the value of the "changed_at" field.
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+	// ReservedUntil holds the value of the "reserved_until" field.
+	ReservedUntil time.Time `json:"reserved_until,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UsernameChangeQuery when eager-loading is set.
+	Edges        UsernameChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UsernameChangeEdges holds the relations/edges for other nodes in the graph.
+type UsernameChangeEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UsernameChangeEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsernameChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usernamechange.FieldID, usernamechange.FieldAccountID:
+			values[i] = new(sql.NullInt64)
+		case usernamechange.FieldUsername, usernamechange.FieldUsernameCanonical:
+			values[i] = new(sql.NullString)
+		case usernamechange.FieldChangedAt, usernamechange.FieldReservedUntil:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsernameChange fields.
+func (uc *UsernameChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usernamechange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			uc.ID = int(value.Int64)
+		case usernamechange.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				uc.AccountID = int(value.Int64)
+			}
+		case usernamechange.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				uc.Username = value.String
+			}
+		case usernamechange.FieldUsernameCanonical:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username_canonical", values[i])
+			} else if value.Valid {
+				uc.UsernameCanonical = value.String
+			}
+		case usernamechange.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				uc.ChangedAt = value.Time
+			}
+		case usernamechange.FieldReservedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved_until", values[i])
+			} else if value.Valid {
+				uc.ReservedUntil = value.Time
+			}
+		default:
+			uc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsernameChange.
+// This includes values selected through modifiers, order, etc.
+func (uc *UsernameChange) Value(name string) (ent.Value, error) {
+	return uc.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the UsernameChange entity.
+func (uc *UsernameChange) QueryAccount() *AccountQuery {
+	return NewUsernameChangeClient(uc.config).QueryAccount(uc)
+}
+
+// Update returns a builder for updating this UsernameChange.
+// Note that you need to call UsernameChange.Unwrap() before calling this method if this UsernameChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uc *UsernameChange) Update() *UsernameChangeUpdateOne {
+	return NewUsernameChangeClient(uc.config).UpdateOne(uc)
+}
+
+// Unwrap unwraps the UsernameChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uc *UsernameChange) Unwrap() *UsernameChange {
+	_tx, ok := uc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsernameChange is not a transactional entity")
+	}
+	uc.config.driver = _tx.drv
+	return uc
+}
+
+// String implements the fmt.Stringer.
+func (uc *UsernameChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsernameChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", uc.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", uc.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(uc.Username)
+	builder.WriteString(", ")
+	builder.WriteString("username_canonical=")
+	builder.WriteString(uc.UsernameCanonical)
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(uc.ChangedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reserved_until=")
+	builder.WriteString(uc.ReservedUntil.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsernameChanges is a parsable slice of UsernameChange.
+type UsernameChanges []*UsernameChange
@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usernamechange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldLTE(FieldID, id))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameCanonical applies equality check predicate on the "username_canonical" field. It's identical to UsernameCanonicalEQ.
+func UsernameCanonical(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldUsernameCanonical, v))
+}
+
+// ChangedAt applies equality check predicate on the "changed_at" field. It's identical to ChangedAtEQ.
+func ChangedAt(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ReservedUntil applies equality check predicate on the "reserved_until" field. It's identical to ReservedUntilEQ.
+func ReservedUntil(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldReservedUntil, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// UsernameCanonicalEQ applies the EQ predicate on the "username_canonical" field.
+func UsernameCanonicalEQ(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalNEQ applies the NEQ predicate on the "username_canonical" field.
+func UsernameCanonicalNEQ(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNEQ(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalIn applies the In predicate on the "username_canonical" field.
+func UsernameCanonicalIn(vs ...string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldIn(FieldUsernameCanonical, vs...))
+}
+
+// UsernameCanonicalNotIn applies the NotIn predicate on the "username_canonical" field.
+func UsernameCanonicalNotIn(vs ...string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNotIn(FieldUsernameCanonical, vs...))
+}
+
+// UsernameCanonicalGT applies the GT predicate on the "username_canonical" field.
+func UsernameCanonicalGT(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldGT(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalGTE applies the GTE predicate on the "username_canonical" field.
+func UsernameCanonicalGTE(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldGTE(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalLT applies the LT predicate on the "username_canonical" field.
+func UsernameCanonicalLT(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldLT(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalLTE applies the LTE predicate on the "username_canonical" field.
+func UsernameCanonicalLTE(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldLTE(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalContains applies the Contains predicate on the "username_canonical" field.
+func UsernameCanonicalContains(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldContains(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalHasPrefix applies the HasPrefix predicate on the "username_canonical" field.
+func UsernameCanonicalHasPrefix(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldHasPrefix(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalHasSuffix applies the HasSuffix predicate on the "username_canonical" field.
+func UsernameCanonicalHasSuffix(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldHasSuffix(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalEqualFold applies the EqualFold predicate on the "username_canonical" field.
+func UsernameCanonicalEqualFold(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEqualFold(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalContainsFold applies the ContainsFold predicate on the "username_canonical" field.
+func UsernameCanonicalContainsFold(v string) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldContainsFold(FieldUsernameCanonical, v))
+}
+
+// ChangedAtEQ applies the EQ predicate on the "changed_at" field.
+func ChangedAtEQ(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ChangedAtNEQ applies the NEQ predicate on the "changed_at" field.
+func ChangedAtNEQ(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNEQ(FieldChangedAt, v))
+}
+
+// ChangedAtIn applies the In predicate on the "changed_at" field.
+func ChangedAtIn(vs ...time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtNotIn applies the NotIn predicate on the "changed_at" field.
+func ChangedAtNotIn(vs ...time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNotIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtGT applies the GT predicate on the "changed_at" field.
+func ChangedAtGT(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldGT(FieldChangedAt, v))
+}
+
+// ChangedAtGTE applies the GTE predicate on the "changed_at" field.
+func ChangedAtGTE(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldGTE(FieldChangedAt, v))
+}
+
+// ChangedAtLT applies the LT predicate on the "changed_at" field.
+func ChangedAtLT(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldLT(FieldChangedAt, v))
+}
+
+// ChangedAtLTE applies the LTE predicate on the "changed_at" field.
+func ChangedAtLTE(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldLTE(FieldChangedAt, v))
+}
+
+// ReservedUntilEQ applies the EQ predicate on the "reserved_until" field.
+func ReservedUntilEQ(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldEQ(FieldReservedUntil, v))
+}
+
+// ReservedUntilNEQ applies the NEQ predicate on the "reserved_until" field.
+func ReservedUntilNEQ(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNEQ(FieldReservedUntil, v))
+}
+
+// ReservedUntilIn applies the In predicate on the "reserved_until" field.
+func ReservedUntilIn(vs ...time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldIn(FieldReservedUntil, vs...))
+}
+
+// ReservedUntilNotIn applies the NotIn predicate on the "reserved_until" field.
+func ReservedUntilNotIn(vs ...time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldNotIn(FieldReservedUntil, vs...))
+}
+
+// ReservedUntilGT applies the GT predicate on the "reserved_until" field.
+func ReservedUntilGT(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldGT(FieldReservedUntil, v))
+}
+
+// ReservedUntilGTE applies the GTE predicate on the "reserved_until" field.
+func ReservedUntilGTE(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldGTE(FieldReservedUntil, v))
+}
+
+// ReservedUntilLT applies the LT predicate on the "reserved_until" field.
+func ReservedUntilLT(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldLT(FieldReservedUntil, v))
+}
+
+// ReservedUntilLTE applies the LTE predicate on the "reserved_until" field.
+func ReservedUntilLTE(v time.Time) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.FieldLTE(FieldReservedUntil, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.UsernameChange {
+	return predicate.UsernameChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.UsernameChange {
+	return predicate.UsernameChange(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsernameChange) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsernameChange) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsernameChange) predicate.UsernameChange {
+	return predicate.UsernameChange(sql.NotPredicates(p))
+}
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
+)
+
+// UsernameChangeCreate is the builder for creating a UsernameChange entity.
+type UsernameChangeCreate struct {
+	config
+	mutation *UsernameChangeMutation
+	hooks    []Hook
+}
+
+// SetAccountID sets the "account_id" field.
+func (ucc *UsernameChangeCreate) SetAccountID(i int) *UsernameChangeCreate {
+	ucc.mutation.SetAccountID(i)
+	return ucc
+}
+
+// SetUsername sets the "username" field.
+func (ucc *UsernameChangeCreate) SetUsername(s string) *UsernameChangeCreate {
+	ucc.mutation.SetUsername(s)
+	return ucc
+}
+
+// SetUsernameCanonical sets the "username_canonical" field.
+func (ucc *UsernameChangeCreate) SetUsernameCanonical(s string) *UsernameChangeCreate {
+	ucc.mutation.SetUsernameCanonical(s)
+	return ucc
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (ucc *UsernameChangeCreate) SetChangedAt(t time.Time) *UsernameChangeCreate {
+	ucc.mutation.SetChangedAt(t)
+	return ucc
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (ucc *UsernameChangeCreate) SetNillableChangedAt(t *time.Time) *UsernameChangeCreate {
+	if t != nil {
+		ucc.SetChangedAt(*t)
+	}
+	return ucc
+}
+
+// SetReservedUntil sets the "reserved_until" field.
+func (ucc *UsernameChangeCreate) SetReservedUntil(t time.Time) *UsernameChangeCreate {
+	ucc.mutation.SetReservedUntil(t)
+	return ucc
+}
+
+// SetID sets the "id" field.
+func (ucc *UsernameChangeCreate) SetID(i int) *UsernameChangeCreate {
+	ucc.mutation.SetID(i)
+	return ucc
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (ucc *UsernameChangeCreate) SetAccount(a *Account) *UsernameChangeCreate {
+	return ucc.SetAccountID(a.ID)
+}
+
+// Mutation returns the UsernameChangeMutation object of the builder.
+func (ucc *UsernameChangeCreate) Mutation() *UsernameChangeMutation {
+	return ucc.mutation
+}
+
+// Save creates the UsernameChange in the database.
+func (ucc *UsernameChangeCreate) Save(ctx context.Context) (*UsernameChange, error) {
+	ucc.defaults()
+	return withHooks(ctx, ucc.sqlSave, ucc.mutation, ucc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ucc *UsernameChangeCreate) SaveX(ctx context.Context) *UsernameChange {
+	v, err := ucc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ucc *UsernameChangeCreate) Exec(ctx context.Context) error {
+	_, err := ucc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucc *UsernameChangeCreate) ExecX(ctx context.Context) {
+	if err := ucc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ucc *UsernameChangeCreate) defaults() {
+	if _, ok := ucc.mutation.ChangedAt(); !ok {
+		v := usernamechange.DefaultChangedAt()
+		ucc.mutation.SetChangedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucc *UsernameChangeCreate) check() error {
+	if _, ok := ucc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "UsernameChange.account_id"`)}
+	}
+	if _, ok := ucc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "UsernameChange.username"`)}
+	}
+	if v, ok := ucc.mutation.Username(); ok {
+		if err := usernamechange.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsernameChange.username": %w`, err)}
+		}
+	}
+	if _, ok := ucc.mutation.UsernameCanonical(); !ok {
+		return &ValidationError{Name: "username_canonical", err: errors.New(`ent: missing required field "UsernameChange.username_canonical"`)}
+	}
+	if v, ok := ucc.mutation.UsernameCanonical(); ok {
+		if err := usernamechange.UsernameCanonicalValidator(v); err != nil {
+			return &ValidationError{Name: "username_canonical", err: fmt.Errorf(`ent: validator failed for field "UsernameChange.username_canonical": %w`, err)}
+		}
+	}
+	if _, ok := ucc.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "UsernameChange.changed_at"`)}
+	}
+	if _, ok := ucc.mutation.ReservedUntil(); !ok {
+		return &ValidationError{Name: "reserved_until", err: errors.New(`ent: missing required field "UsernameChange.reserved_until"`)}
+	}
+	if len(ucc.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "UsernameChange.account"`)}
+	}
+	return nil
+}
+
+func (ucc *UsernameChangeCreate) sqlSave(ctx context.Context) (*UsernameChange, error) {
+	if err := ucc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ucc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ucc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	ucc.mutation.id = &_node.ID
+	ucc.mutation.done = true
+	return _node, nil
+}
+
+func (ucc *UsernameChangeCreate) createSpec() (*UsernameChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsernameChange{config: ucc.config}
+		_spec = sqlgraph.NewCreateSpec(usernamechange.Table, sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt))
+	)
+	if id, ok := ucc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ucc.mutation.Username(); ok {
+		_spec.SetField(usernamechange.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := ucc.mutation.UsernameCanonical(); ok {
+		_spec.SetField(usernamechange.FieldUsernameCanonical, field.TypeString, value)
+		_node.UsernameCanonical = value
+	}
+	if value, ok := ucc.mutation.ChangedAt(); ok {
+		_spec.SetField(usernamechange.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if value, ok := ucc.mutation.ReservedUntil(); ok {
+		_spec.SetField(usernamechange.FieldReservedUntil, field.TypeTime, value)
+		_node.ReservedUntil = value
+	}
+	if nodes := ucc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usernamechange.AccountTable,
+			Columns: []string{usernamechange.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UsernameChangeCreateBulk is the builder for creating many UsernameChange entities in bulk.
+type UsernameChangeCreateBulk struct {
+	config
+	err      error
+	builders []*UsernameChangeCreate
+}
+
+// Save creates the UsernameChange entities in the database.
+func (uccb *UsernameChangeCreateBulk) Save(ctx context.Context) ([]*UsernameChange, error) {
+	if uccb.err != nil {
+		return nil, uccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uccb.builders))
+	nodes := make([]*UsernameChange, len(uccb.builders))
+	mutators := make([]Mutator, len(uccb.builders))
+	for i := range uccb.builders {
+		func(i int, root context.Context) {
+			builder := uccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsernameChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uccb *UsernameChangeCreateBulk) SaveX(ctx context.Context) []*UsernameChange {
+	v, err := uccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uccb *UsernameChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := uccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uccb *UsernameChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := uccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
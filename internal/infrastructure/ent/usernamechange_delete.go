@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
+)
+
+// UsernameChangeDelete is the builder for deleting a UsernameChange entity.
+type UsernameChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *UsernameChangeMutation
+}
+
+// Where appends a list predicates to the UsernameChangeDelete builder.
+func (ucd *UsernameChangeDelete) Where(ps ...predicate.UsernameChange) *UsernameChangeDelete {
+	ucd.mutation.Where(ps...)
+	return ucd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ucd *UsernameChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ucd.sqlExec, ucd.mutation, ucd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucd *UsernameChangeDelete) ExecX(ctx context.Context) int {
+	n, err := ucd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ucd *UsernameChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usernamechange.Table, sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt))
+	if ps := ucd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ucd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ucd.mutation.done = true
+	return affected, err
+}
+
+// UsernameChangeDeleteOne is the builder for deleting a single UsernameChange entity.
+type UsernameChangeDeleteOne struct {
+	ucd *UsernameChangeDelete
+}
+
+// Where appends a list predicates to the UsernameChangeDelete builder.
+func (ucdo *UsernameChangeDeleteOne) Where(ps ...predicate.UsernameChange) *UsernameChangeDeleteOne {
+	ucdo.ucd.mutation.Where(ps...)
+	return ucdo
+}
+
+// Exec executes the deletion query.
+func (ucdo *UsernameChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := ucdo.ucd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usernamechange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucdo *UsernameChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := ucdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
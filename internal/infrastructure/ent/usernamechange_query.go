@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
+)
+
+// UsernameChangeQuery is the builder for querying UsernameChange entities.
+type UsernameChangeQuery struct {
+	config
+	ctx         *QueryContext
+	order       []usernamechange.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.UsernameChange
+	withAccount *AccountQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UsernameChangeQuery builder.
+func (ucq *UsernameChangeQuery) Where(ps ...predicate.UsernameChange) *UsernameChangeQuery {
+	ucq.predicates = append(ucq.predicates, ps...)
+	return ucq
+}
+
+// Limit the number of records to be returned by this query.
+func (ucq *UsernameChangeQuery) Limit(limit int) *UsernameChangeQuery {
+	ucq.ctx.Limit = &limit
+	return ucq
+}
+
+// Offset to start from.
+func (ucq *UsernameChangeQuery) Offset(offset int) *UsernameChangeQuery {
+	ucq.ctx.Offset = &offset
+	return ucq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ucq *UsernameChangeQuery) Unique(unique bool) *UsernameChangeQuery {
+	ucq.ctx.Unique = &unique
+	return ucq
+}
+
+// Order specifies how the records should be ordered.
+func (ucq *UsernameChangeQuery) Order(o ...usernamechange.OrderOption) *UsernameChangeQuery {
+	ucq.order = append(ucq.order, o...)
+	return ucq
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (ucq *UsernameChangeQuery) QueryAccount() *AccountQuery {
+	query := (&AccountClient{config: ucq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ucq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ucq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usernamechange.Table, usernamechange.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usernamechange.AccountTable, usernamechange.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ucq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first UsernameChange entity from the query.
+// Returns a *NotFoundError when no UsernameChange was found.
+func (ucq *UsernameChangeQuery) First(ctx context.Context) (*UsernameChange, error) {
+	nodes, err := ucq.Limit(1).All(setContextOp(ctx, ucq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{usernamechange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ucq *UsernameChangeQuery) FirstX(ctx context.Context) *UsernameChange {
+	node, err := ucq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UsernameChange ID from the query.
+// Returns a *NotFoundError when no UsernameChange ID was found.
+func (ucq *UsernameChangeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ucq.Limit(1).IDs(setContextOp(ctx, ucq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{usernamechange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ucq *UsernameChangeQuery) FirstIDX(ctx context.Context) int {
+	id, err := ucq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UsernameChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UsernameChange entity is found.
+// Returns a *NotFoundError when no UsernameChange entities are found.
+func (ucq *UsernameChangeQuery) Only(ctx context.Context) (*UsernameChange, error) {
+	nodes, err := ucq.Limit(2).All(setContextOp(ctx, ucq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{usernamechange.Label}
+	default:
+		return nil, &NotSingularError{usernamechange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ucq *UsernameChangeQuery) OnlyX(ctx context.Context) *UsernameChange {
+	node, err := ucq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UsernameChange ID in the query.
+// Returns a *NotSingularError when more than one UsernameChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ucq *UsernameChangeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ucq.Limit(2).IDs(setContextOp(ctx, ucq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{usernamechange.Label}
+	default:
+		err = &NotSingularError{usernamechange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ucq *UsernameChangeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ucq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UsernameChanges.
+func (ucq *UsernameChangeQuery) All(ctx context.Context) ([]*UsernameChange, error) {
+	ctx = setContextOp(ctx, ucq.ctx, ent.OpQueryAll)
+	if err := ucq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UsernameChange, *UsernameChangeQuery]()
+	return withInterceptors[[]*UsernameChange](ctx, ucq, qr, ucq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ucq *UsernameChangeQuery) AllX(ctx context.Context) []*UsernameChange {
+	nodes, err := ucq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UsernameChange IDs.
+func (ucq *UsernameChangeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ucq.ctx.Unique == nil && ucq.path != nil {
+		ucq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ucq.ctx, ent.OpQueryIDs)
+	if err = ucq.Select(usernamechange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ucq *UsernameChangeQuery) IDsX(ctx context.Context) []int {
+	ids, err := ucq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ucq *UsernameChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ucq.ctx, ent.OpQueryCount)
+	if err := ucq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ucq, querierCount[*UsernameChangeQuery](), ucq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ucq *UsernameChangeQuery) CountX(ctx context.Context) int {
+	count, err := ucq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ucq *UsernameChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ucq.ctx, ent.OpQueryExist)
+	switch _, err := ucq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ucq *UsernameChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := ucq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UsernameChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ucq *UsernameChangeQuery) Clone() *UsernameChangeQuery {
+	if ucq == nil {
+		return nil
+	}
+	return &UsernameChangeQuery{
+		config:      ucq.config,
+		ctx:         ucq.ctx.Clone(),
+		order:       append([]usernamechange.OrderOption{}, ucq.order...),
+		inters:      append([]Interceptor{}, ucq.inters...),
+		predicates:  append([]predicate.UsernameChange{}, ucq.predicates...),
+		withAccount: ucq.withAccount.Clone(),
+		// clone intermediate query.
+		sql:  ucq.sql.Clone(),
+		path: ucq.path,
+	}
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (ucq *UsernameChangeQuery) WithAccount(opts ...func(*AccountQuery)) *UsernameChangeQuery {
+	query := (&AccountClient{config: ucq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ucq.withAccount = query
+	return ucq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountID int `json:"account_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UsernameChange.Query().
+//		GroupBy(usernamechange.FieldAccountID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ucq *UsernameChangeQuery) GroupBy(field string, fields ...string) *UsernameChangeGroupBy {
+	ucq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UsernameChangeGroupBy{build: ucq}
+	grbuild.flds = &ucq.ctx.Fields
+	grbuild.label = usernamechange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountID int `json:"account_id,omitempty"`
+//	}
+//
+//	client.UsernameChange.Query().
+//		Select(usernamechange.FieldAccountID).
+//		Scan(ctx, &v)
+func (ucq *UsernameChangeQuery) Select(fields ...string) *UsernameChangeSelect {
+	ucq.ctx.Fields = append(ucq.ctx.Fields, fields...)
+	sbuild := &UsernameChangeSelect{UsernameChangeQuery: ucq}
+	sbuild.label = usernamechange.Label
+	sbuild.flds, sbuild.scan = &ucq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UsernameChangeSelect configured with the given aggregations.
+func (ucq *UsernameChangeQuery) Aggregate(fns ...AggregateFunc) *UsernameChangeSelect {
+	return ucq.Select().Aggregate(fns...)
+}
+
+func (ucq *UsernameChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ucq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ucq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ucq.ctx.Fields {
+		if !usernamechange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ucq.path != nil {
+		prev, err := ucq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ucq.sql = prev
+	}
+	return nil
+}
+
+func (ucq *UsernameChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UsernameChange, error) {
+	var (
+		nodes       = []*UsernameChange{}
+		_spec       = ucq.querySpec()
+		loadedTypes = [1]bool{
+			ucq.withAccount != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UsernameChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UsernameChange{config: ucq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ucq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ucq.withAccount; query != nil {
+		if err := ucq.loadAccount(ctx, query, nodes, nil,
+			func(n *UsernameChange, e *Account) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ucq *UsernameChangeQuery) loadAccount(ctx context.Context, query *AccountQuery, nodes []*UsernameChange, init func(*UsernameChange), assign func(*UsernameChange, *Account)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UsernameChange)
+	for i := range nodes {
+		fk := nodes[i].AccountID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ucq *UsernameChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ucq.querySpec()
+	_spec.Node.Columns = ucq.ctx.Fields
+	if len(ucq.ctx.Fields) > 0 {
+		_spec.Unique = ucq.ctx.Unique != nil && *ucq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ucq.driver, _spec)
+}
+
+func (ucq *UsernameChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(usernamechange.Table, usernamechange.Columns, sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt))
+	_spec.From = ucq.sql
+	if unique := ucq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ucq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ucq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usernamechange.FieldID)
+		for i := range fields {
+			if fields[i] != usernamechange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ucq.withAccount != nil {
+			_spec.Node.AddColumnOnce(usernamechange.FieldAccountID)
+		}
+	}
+	if ps := ucq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ucq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ucq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ucq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ucq *UsernameChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ucq.driver.Dialect())
+	t1 := builder.Table(usernamechange.Table)
+	columns := ucq.ctx.Fields
+	if len(columns) == 0 {
+		columns = usernamechange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ucq.sql != nil {
+		selector = ucq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ucq.ctx.Unique != nil && *ucq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ucq.predicates {
+		p(selector)
+	}
+	for _, p := range ucq.order {
+		p(selector)
+	}
+	if offset := ucq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ucq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UsernameChangeGroupBy is the group-by builder for UsernameChange entities.
+type UsernameChangeGroupBy struct {
+	selector
+	build *UsernameChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ucgb *UsernameChangeGroupBy) Aggregate(fns ...AggregateFunc) *UsernameChangeGroupBy {
+	ucgb.fns = append(ucgb.fns, fns...)
+	return ucgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ucgb *UsernameChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ucgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ucgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UsernameChangeQuery, *UsernameChangeGroupBy](ctx, ucgb.build, ucgb, ucgb.build.inters, v)
+}
+
+func (ucgb *UsernameChangeGroupBy) sqlScan(ctx context.Context, root *UsernameChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ucgb.fns))
+	for _, fn := range ucgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ucgb.flds)+len(ucgb.fns))
+		for _, f := range *ucgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ucgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ucgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UsernameChangeSelect is the builder for selecting fields of UsernameChange entities.
+type UsernameChangeSelect struct {
+	*UsernameChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ucs *UsernameChangeSelect) Aggregate(fns ...AggregateFunc) *UsernameChangeSelect {
+	ucs.fns = append(ucs.fns, fns...)
+	return ucs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ucs *UsernameChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ucs.ctx, ent.OpQuerySelect)
+	if err := ucs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UsernameChangeQuery, *UsernameChangeSelect](ctx, ucs.UsernameChangeQuery, ucs, ucs.inters, v)
+}
+
+func (ucs *UsernameChangeSelect) sqlScan(ctx context.Context, root *UsernameChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ucs.fns))
+	for _, fn := range ucs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ucs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ucs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
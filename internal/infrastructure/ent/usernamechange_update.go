@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
+)
+
+// UsernameChangeUpdate is the builder for updating UsernameChange entities.
+type UsernameChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsernameChangeMutation
+}
+
+// Where appends a list predicates to the UsernameChangeUpdate builder.
+func (ucu *UsernameChangeUpdate) Where(ps ...predicate.UsernameChange) *UsernameChangeUpdate {
+	ucu.mutation.Where(ps...)
+	return ucu
+}
+
+// Mutation returns the UsernameChangeMutation object of the builder.
+func (ucu *UsernameChangeUpdate) Mutation() *UsernameChangeMutation {
+	return ucu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ucu *UsernameChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ucu.sqlSave, ucu.mutation, ucu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucu *UsernameChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := ucu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ucu *UsernameChangeUpdate) Exec(ctx context.Context) error {
+	_, err := ucu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucu *UsernameChangeUpdate) ExecX(ctx context.Context) {
+	if err := ucu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucu *UsernameChangeUpdate) check() error {
+	if ucu.mutation.AccountCleared() && len(ucu.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UsernameChange.account"`)
+	}
+	return nil
+}
+
+func (ucu *UsernameChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ucu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usernamechange.Table, usernamechange.Columns, sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt))
+	if ps := ucu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ucu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usernamechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ucu.mutation.done = true
+	return n, nil
+}
+
+// UsernameChangeUpdateOne is the builder for updating a single UsernameChange entity.
+type UsernameChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsernameChangeMutation
+}
+
+// Mutation returns the UsernameChangeMutation object of the builder.
+func (ucuo *UsernameChangeUpdateOne) Mutation() *UsernameChangeMutation {
+	return ucuo.mutation
+}
+
+// Where appends a list predicates to the UsernameChangeUpdate builder.
+func (ucuo *UsernameChangeUpdateOne) Where(ps ...predicate.UsernameChange) *UsernameChangeUpdateOne {
+	ucuo.mutation.Where(ps...)
+	return ucuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ucuo *UsernameChangeUpdateOne) Select(field string, fields ...string) *UsernameChangeUpdateOne {
+	ucuo.fields = append([]string{field}, fields...)
+	return ucuo
+}
+
+// Save executes the query and returns the updated UsernameChange entity.
+func (ucuo *UsernameChangeUpdateOne) Save(ctx context.Context) (*UsernameChange, error) {
+	return withHooks(ctx, ucuo.sqlSave, ucuo.mutation, ucuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucuo *UsernameChangeUpdateOne) SaveX(ctx context.Context) *UsernameChange {
+	node, err := ucuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ucuo *UsernameChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := ucuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucuo *UsernameChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := ucuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucuo *UsernameChangeUpdateOne) check() error {
+	if ucuo.mutation.AccountCleared() && len(ucuo.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UsernameChange.account"`)
+	}
+	return nil
+}
+
+func (ucuo *UsernameChangeUpdateOne) sqlSave(ctx context.Context) (_node *UsernameChange, err error) {
+	if err := ucuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usernamechange.Table, usernamechange.Columns, sqlgraph.NewFieldSpec(usernamechange.FieldID, field.TypeInt))
+	id, ok := ucuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsernameChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ucuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usernamechange.FieldID)
+		for _, f := range fields {
+			if !usernamechange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usernamechange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ucuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &UsernameChange{config: ucuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ucuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usernamechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ucuo.mutation.done = true
+	return _node, nil
+}
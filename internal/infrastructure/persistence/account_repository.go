@@ -7,9 +7,10 @@ import (
 	"github.com/intezya/auth_service/internal/domain/repository"
 	"github.com/intezya/auth_service/internal/infrastructure/ent"
 	entAccount "github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	"github.com/intezya/auth_service/internal/infrastructure/ent/predicate"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"strings"
+	"time"
 )
 
 type accountRepository struct {
@@ -21,11 +22,13 @@ func NewAccountRepository(client *ent.Client) repository.AccountRepository {
 }
 
 func (r *accountRepository) Create(ctx context.Context, account *domain.Account) (*domain.Account, error) {
-	created, err := r.client.Account.
+	created, err := clientFromContext(ctx, r.client).Account.
 		Create().
 		SetUsername(account.Username()).
+		SetUsernameCanonical(string(domain.Username(account.Username()).Canonical())).
 		SetPassword(account.Password()).
 		SetNillableHardwareID(account.HardwareID()).
+		SetNillableHardwareIDDigest(account.HardwareIDDigest()).
 		Save(ctx)
 	if err != nil {
 		return nil, r.handleConstraintError(err)
@@ -35,7 +38,9 @@ func (r *accountRepository) Create(ctx context.Context, account *domain.Account)
 }
 
 func (r *accountRepository) FindByID(ctx context.Context, id domain.AccountID) (*domain.Account, error) {
-	found, err := r.client.Account.Get(ctx, int(id))
+	ctx = readOnly(ctx)
+
+	found, err := clientFromContext(ctx, r.client).Account.Get(ctx, int(id))
 	if err != nil {
 		return nil, r.handleNotFoundError(err)
 	}
@@ -43,14 +48,60 @@ func (r *accountRepository) FindByID(ctx context.Context, id domain.AccountID) (
 	return mapper.EntAccountToDomain(found), nil
 }
 
+func (r *accountRepository) FindAllByIDs(ctx context.Context, ids []domain.AccountID) ([]*domain.Account, error) {
+	ctx = readOnly(ctx)
+
+	values := make([]int, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, int(id))
+	}
+
+	found, err := clientFromContext(ctx, r.client).Account.
+		Query().
+		Where(entAccount.IDIn(values...)).
+		All(ctx)
+	if err != nil {
+		return nil, unexpectedError(err)
+	}
+
+	accounts := make([]*domain.Account, 0, len(found))
+	for _, account := range found {
+		accounts = append(accounts, mapper.EntAccountToDomain(account))
+	}
+
+	return accounts, nil
+}
+
 func (r *accountRepository) FindByLowerUsername(ctx context.Context, username domain.Username) (
 	*domain.Account,
 	error,
 ) {
-	found, err := r.client.Account.
+	ctx = readOnly(ctx)
+
+	found, err := clientFromContext(ctx, r.client).Account.
+		Query().
+		Where(
+			entAccount.UsernameCanonical(string(username.Canonical())),
+			entAccount.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, r.handleNotFoundError(err)
+	}
+
+	return mapper.EntAccountToDomain(found), nil
+}
+
+func (r *accountRepository) FindByEmail(ctx context.Context, email domain.Email) (*domain.Account, error) {
+	ctx = readOnly(ctx)
+
+	found, err := clientFromContext(ctx, r.client).Account.
 		Query().
-		Where(entAccount.UsernameEqualFold(string(username))).
-		First(ctx)
+		Where(
+			entAccount.EmailCanonical(string(email.Canonical())),
+			entAccount.DeletedAtIsNil(),
+		).
+		Only(ctx)
 	if err != nil {
 		return nil, r.handleNotFoundError(err)
 	}
@@ -58,33 +109,278 @@ func (r *accountRepository) FindByLowerUsername(ctx context.Context, username do
 	return mapper.EntAccountToDomain(found), nil
 }
 
-func (r *accountRepository) ExistsByLowerUsername(ctx context.Context, username domain.Username) bool {
-	exists, err := r.client.Account.
+func (r *accountRepository) FindDeletedByLowerUsername(ctx context.Context, username domain.Username) (
+	*domain.Account,
+	error,
+) {
+	found, err := clientFromContext(ctx, r.client).Account.
 		Query().
-		Where(entAccount.UsernameEqualFold(string(username))).
+		Where(
+			entAccount.UsernameCanonical(string(username.Canonical())),
+			entAccount.DeletedAtNotNil(),
+			entAccount.ErasedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, r.handleNotFoundError(err)
+	}
+
+	return mapper.EntAccountToDomain(found), nil
+}
+
+func (r *accountRepository) FindAllDeletedBefore(ctx context.Context, before time.Time, limit int) (
+	[]*domain.Account,
+	error,
+) {
+	found, err := clientFromContext(ctx, r.client).Account.
+		Query().
+		Where(
+			entAccount.DeletedAtLT(before),
+			entAccount.ErasedAtIsNil(),
+		).
+		Order(ent.Asc(entAccount.FieldDeletedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, unexpectedError(err)
+	}
+
+	accounts := make([]*domain.Account, 0, len(found))
+	for _, account := range found {
+		accounts = append(accounts, mapper.EntAccountToDomain(account))
+	}
+
+	return accounts, nil
+}
+
+func (r *accountRepository) Search(ctx context.Context, criteria repository.AccountSearchCriteria) (
+	[]*domain.Account,
+	error,
+) {
+	ctx = readOnly(ctx)
+
+	predicates := []predicate.Account{entAccount.DeletedAtIsNil()}
+
+	if criteria.UsernamePrefix != "" {
+		prefix := domain.Username(criteria.UsernamePrefix).Canonical()
+		predicates = append(predicates, entAccount.UsernameCanonicalHasPrefix(string(prefix)))
+	}
+
+	if criteria.AccessLevel != nil {
+		predicates = append(predicates, entAccount.AccessLevel(*criteria.AccessLevel))
+	}
+
+	if criteria.Banned != nil {
+		if *criteria.Banned {
+			predicates = append(predicates, entAccount.BannedUntilGT(criteria.Now))
+		} else {
+			predicates = append(
+				predicates,
+				entAccount.Or(entAccount.BannedUntilIsNil(), entAccount.BannedUntilLTE(criteria.Now)),
+			)
+		}
+	}
+
+	if criteria.CreatedFrom != nil {
+		predicates = append(predicates, entAccount.CreatedAtGTE(*criteria.CreatedFrom))
+	}
+
+	if criteria.CreatedTo != nil {
+		predicates = append(predicates, entAccount.CreatedAtLT(*criteria.CreatedTo))
+	}
+
+	if criteria.After != nil {
+		predicates = append(predicates, afterCursor(criteria.After, criteria.SortBy, criteria.Descending))
+	}
+
+	order := ent.Asc
+	if criteria.Descending {
+		order = ent.Desc
+	}
+
+	query := clientFromContext(ctx, r.client).Account.
+		Query().
+		Where(predicates...)
+
+	switch criteria.SortBy {
+	case repository.AccountSortByCreatedAt:
+		query = query.Order(order(entAccount.FieldCreatedAt), order(entAccount.FieldID))
+	case repository.AccountSortByUsername:
+		query = query.Order(order(entAccount.FieldUsernameCanonical), order(entAccount.FieldID))
+	default:
+		query = query.Order(order(entAccount.FieldID))
+	}
+
+	found, err := query.Limit(criteria.Limit).All(ctx)
+	if err != nil {
+		return nil, unexpectedError(err)
+	}
+
+	accounts := make([]*domain.Account, 0, len(found))
+	for _, account := range found {
+		accounts = append(accounts, mapper.EntAccountToDomain(account))
+	}
+
+	return accounts, nil
+}
+
+// afterCursor matches accounts following cursor in (sort field, id) order.
+func afterCursor(cursor *repository.AccountCursor, sortBy repository.AccountSortField, descending bool) predicate.Account {
+	idAfter := entAccount.IDGT(cursor.ID)
+	if descending {
+		idAfter = entAccount.IDLT(cursor.ID)
+	}
+
+	switch sortBy {
+	case repository.AccountSortByCreatedAt:
+		after := entAccount.CreatedAtGT(cursor.CreatedAt)
+		if descending {
+			after = entAccount.CreatedAtLT(cursor.CreatedAt)
+		}
+
+		return entAccount.Or(after, entAccount.And(entAccount.CreatedAt(cursor.CreatedAt), idAfter))
+	case repository.AccountSortByUsername:
+		username := string(cursor.UsernameCanonical)
+
+		after := entAccount.UsernameCanonicalGT(username)
+		if descending {
+			after = entAccount.UsernameCanonicalLT(username)
+		}
+
+		return entAccount.Or(after, entAccount.And(entAccount.UsernameCanonical(username), idAfter))
+	default:
+		return idAfter
+	}
+}
+
+func (r *accountRepository) ExistsByEmail(ctx context.Context, email domain.Email) (bool, error) {
+	ctx = readOnly(ctx)
+
+	exists, err := clientFromContext(ctx, r.client).Account.
+		Query().
+		Where(entAccount.EmailCanonical(string(email.Canonical()))).
 		Exist(ctx)
+	if err != nil {
+		return false, unexpectedError(err)
+	}
 
-	return err != nil && exists
+	return exists, nil
+}
+
+// ExistsByHardwareIDDigest reports error instead of "not exists", so uniqueness is not assumed on failure.
+func (r *accountRepository) ExistsByHardwareIDDigest(
+	ctx context.Context,
+	digest domain.HardwareIDDigest,
+) (bool, error) {
+	ctx = readOnly(ctx)
+
+	exists, err := clientFromContext(ctx, r.client).Account.
+		Query().
+		Where(entAccount.HardwareIDDigest(string(digest))).
+		Exist(ctx)
+	if err != nil {
+		return false, unexpectedError(err)
+	}
+
+	return exists, nil
 }
 
 func (r *accountRepository) Update(ctx context.Context, account *domain.Account) error {
-	// update can return not found, but in code repository.Update called only if account already found
-	err := r.client.Account.UpdateOne(
-		&ent.Account{
-			ID:          account.ID(),
-			Username:    account.Username(),
-			Password:    account.Password(),
-			HardwareID:  account.HardwareID(),
-			AccessLevel: domain.AccessLevel(account.AccessLevel()),
-			CreatedAt:   account.CreatedAt(),
-			BannedUntil: account.BannedUntil(),
-			BanReason:   account.BanReason(),
-		},
-	).Exec(ctx)
+	// conditional update: nothing is written if account was changed since it was loaded
+	update := clientFromContext(ctx, r.client).Account.
+		UpdateOneID(account.ID()).
+		Where(entAccount.Version(account.Version())).
+		AddVersion(1).
+		SetAccessLevel(domain.AccessLevel(account.AccessLevel()))
+
+	if account.IsErased() {
+		update.ClearUsername().ClearUsernameCanonical().ClearPassword()
+	} else {
+		update.
+			SetUsername(account.Username()).
+			SetUsernameCanonical(string(domain.Username(account.Username()).Canonical())).
+			SetPassword(account.Password())
+	}
+
+	if account.HardwareID() != nil {
+		update.SetHardwareID(*account.HardwareID())
+	} else {
+		update.ClearHardwareID()
+	}
+
+	if account.HardwareIDDigest() != nil {
+		update.SetHardwareIDDigest(*account.HardwareIDDigest())
+	} else {
+		update.ClearHardwareIDDigest()
+	}
+
+	if account.BannedUntil() != nil {
+		update.SetBannedUntil(*account.BannedUntil())
+	} else {
+		update.ClearBannedUntil()
+	}
+
+	if account.BanReason() != nil {
+		update.SetBanReason(*account.BanReason())
+	} else {
+		update.ClearBanReason()
+	}
+
+	if account.DeletedAt() != nil {
+		update.SetDeletedAt(*account.DeletedAt())
+	} else {
+		update.ClearDeletedAt()
+	}
+
+	if account.ErasedAt() != nil {
+		update.SetErasedAt(*account.ErasedAt())
+	} else {
+		update.ClearErasedAt()
+	}
+
+	if account.UsernameChangedAt() != nil {
+		update.SetUsernameChangedAt(*account.UsernameChangedAt())
+	} else {
+		update.ClearUsernameChangedAt()
+	}
+
+	if account.Email() != nil {
+		update.
+			SetEmail(*account.Email()).
+			SetEmailCanonical(string(domain.Email(*account.Email()).Canonical())).
+			SetNillableEmailVerifiedAt(account.EmailVerifiedAt())
+	} else {
+		update.ClearEmail().ClearEmailCanonical().ClearEmailVerifiedAt()
+	}
+
+	updated, err := update.Save(ctx)
+	if ent.IsNotFound(err) {
+		return repository.ErrConcurrentModification // changed or deleted
+	}
+
 	if err != nil {
 		return r.handleConstraintError(err)
 	}
 
+	account.SetVersion(updated.Version)
+
+	return nil
+}
+
+func (r *accountRepository) UpdateLastLoginAt(ctx context.Context, id domain.AccountID, at time.Time) error {
+	// out of order batches never move last login back
+	_, err := clientFromContext(ctx, r.client).Account.
+		Update().
+		Where(
+			entAccount.ID(int(id)),
+			entAccount.Or(entAccount.LastLoginAtIsNil(), entAccount.LastLoginAtLT(at)),
+		).
+		SetLastLoginAt(at).
+		Save(ctx)
+	if err != nil {
+		return unexpectedError(err)
+	}
+
 	return nil
 }
 
@@ -93,14 +389,15 @@ func (r *accountRepository) handleConstraintError(err error) error {
 		return nil
 	}
 
-	switch {
-	case strings.Contains(err.Error(), "username"):
-		return status.Errorf(codes.AlreadyExists, "user already exists")
-	case strings.Contains(err.Error(), "hardware_id"):
-		return status.Errorf(codes.AlreadyExists, "hardware_id conflict")
+	if mapped, ok := mapConstraintError(err); ok {
+		return mapped
+	}
+
+	if ent.IsNotFound(err) {
+		return status.Errorf(codes.NotFound, "account not found")
 	}
 
-	return status.Errorf(codes.Internal, "unexpected internal error: %v", err)
+	return unexpectedError(err)
 }
 
 func (r *accountRepository) handleNotFoundError(err error) error {
@@ -112,5 +409,5 @@ func (r *accountRepository) handleNotFoundError(err error) error {
 		return status.Errorf(codes.NotFound, "account not found")
 	}
 
-	return status.Errorf(codes.Internal, "unexpected internal error: %v", err)
+	return unexpectedError(err)
 }
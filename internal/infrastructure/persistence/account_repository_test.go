@@ -0,0 +1,197 @@
+package persistence
+
+import (
+	"context"
+	entsql "entgo.io/ent/dialect/sql"
+	"errors"
+	"fmt"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+	"github.com/intezya/auth_service/pkg/clock"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testBackends returns drivers and data source names the suite runs against. SQLite always runs in-process,
+// Postgres and MySQL run when TEST_POSTGRES_DSN / TEST_MYSQL_DSN are set (MySQL DSN needs
+// parseTime=true&multiStatements=true). Their databases must be disposable, schema is recreated by tests.
+func testBackends(t *testing.T) map[string]string {
+	t.Helper()
+
+	backends := map[string]string{
+		"sqlite3": fmt.Sprintf(
+			"file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_time_format=sqlite&_txlock=immediate",
+			filepath.Join(t.TempDir(), "auth.db"),
+		),
+	}
+
+	if dsn := os.Getenv("TEST_POSTGRES_DSN"); dsn != "" {
+		backends["postgres"] = dsn
+	}
+
+	if dsn := os.Getenv("TEST_MYSQL_DSN"); dsn != "" {
+		backends["mysql"] = dsn
+	}
+
+	return backends
+}
+
+// forEachBackend runs test against freshly migrated database of every backend.
+func forEachBackend(t *testing.T, test func(t *testing.T, provider *Provider)) {
+	t.Helper()
+
+	for driver, dsn := range testBackends(t) {
+		t.Run(
+			driver, func(t *testing.T) {
+				provider := newTestProvider(t, driver, dsn)
+				test(t, provider)
+			},
+		)
+	}
+}
+
+func newTestProvider(t *testing.T, driver string, dsn string) *Provider {
+	t.Helper()
+
+	db, err := entsql.Open(driver, dsn)
+	if err != nil {
+		t.Fatalf("open %s: %v", driver, err)
+	}
+
+	migrator, err := NewMigrator(db.DB(), driver)
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := migrator.Down(ctx, math.MaxInt); err != nil {
+		t.Fatalf("reset schema: %v", err)
+	}
+
+	if err := migrator.Up(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	client := ent.NewClient(ent.Driver(db))
+	t.Cleanup(func() { _ = client.Close() })
+
+	return NewProvider(client, EntConfig{})
+}
+
+func newTestAccount(username string, hardwareIDDigest string) *domain.Account {
+	return domain.NewAccount(
+		domain.Username(username),
+		"password-hash",
+		domain.HardwareID("hardware-id-"+hardwareIDDigest),
+		domain.HardwareIDDigest(hardwareIDDigest),
+		clock.NewMockClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+	)
+}
+
+func TestAccountRepository_CreateAndFindByLowerUsername(t *testing.T) {
+	forEachBackend(
+		t, func(t *testing.T, provider *Provider) {
+			ctx := context.Background()
+
+			created, err := provider.AccountRepository.Create(ctx, newTestAccount("Alice", "digest-1"))
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			if created.ID() == 0 {
+				t.Fatal("Create() returned account without id")
+			}
+
+			found, err := provider.AccountRepository.FindByLowerUsername(ctx, "ALICE")
+			if err != nil {
+				t.Fatalf("FindByLowerUsername() error = %v", err)
+			}
+
+			if found.ID() != created.ID() || found.Username() != "Alice" {
+				t.Fatalf("FindByLowerUsername() = %d %q, want %d %q", found.ID(), found.Username(), created.ID(), "Alice")
+			}
+
+			if _, err := provider.AccountRepository.FindByLowerUsername(ctx, "bob"); err == nil {
+				t.Fatal("FindByLowerUsername() found unknown username")
+			}
+		},
+	)
+}
+
+func TestAccountRepository_CreateConstraintErrors(t *testing.T) {
+	forEachBackend(
+		t, func(t *testing.T, provider *Provider) {
+			ctx := context.Background()
+
+			if _, err := provider.AccountRepository.Create(ctx, newTestAccount("alice", "digest-1")); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			tests := []struct {
+				name    string
+				account *domain.Account
+				want    error
+			}{
+				{name: "username differs in case", account: newTestAccount("ALICE", "digest-2"), want: repository.ErrAccountAlreadyExists},
+				{name: "hardware id", account: newTestAccount("bob", "digest-1"), want: repository.ErrHardwareIDConflict},
+			}
+
+			for _, tt := range tests {
+				t.Run(
+					tt.name, func(t *testing.T) {
+						_, err := provider.AccountRepository.Create(ctx, tt.account)
+						if !errors.Is(err, tt.want) {
+							t.Fatalf("Create() error = %v, want %v", err, tt.want)
+						}
+					},
+				)
+			}
+		},
+	)
+}
+
+func TestAccountRepository_UpdateVersionConflict(t *testing.T) {
+	forEachBackend(
+		t, func(t *testing.T, provider *Provider) {
+			ctx := context.Background()
+
+			created, err := provider.AccountRepository.Create(ctx, newTestAccount("alice", "digest-1"))
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			first, err := provider.AccountRepository.FindByID(ctx, domain.AccountID(created.ID()))
+			if err != nil {
+				t.Fatalf("FindByID() error = %v", err)
+			}
+
+			stale, err := provider.AccountRepository.FindByID(ctx, domain.AccountID(created.ID()))
+			if err != nil {
+				t.Fatalf("FindByID() error = %v", err)
+			}
+
+			reason := "cheating"
+			if err := first.Ban(time.Now().Add(time.Hour), &reason); err != nil {
+				t.Fatalf("Ban() error = %v", err)
+			}
+
+			if err := provider.AccountRepository.Update(ctx, first); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+
+			stale.Unban()
+
+			if err := provider.AccountRepository.Update(ctx, stale); !errors.Is(err, repository.ErrConcurrentModification) {
+				t.Fatalf("Update() of stale account error = %v, want %v", err, repository.ErrConcurrentModification)
+			}
+
+			if err := provider.AccountRepository.Update(ctx, first); err != nil {
+				t.Fatalf("Update() after successful update error = %v", err)
+			}
+		},
+	)
+}
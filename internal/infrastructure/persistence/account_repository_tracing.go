@@ -8,6 +8,7 @@ import (
 	"github.com/intezya/auth_service/internal/domain/repository"
 	"github.com/intezya/auth_service/internal/infrastructure/ent"
 	tracer "github.com/intezya/auth_service/pkg/tracer"
+	"time"
 )
 
 type accountRepositoryWithTracing struct {
@@ -35,6 +36,13 @@ func (t *accountRepositoryWithTracing) FindByID(ctx context.Context, id domain.A
 	return t.wrapped.FindByID(ctx, id)
 }
 
+func (t *accountRepositoryWithTracing) FindAllByIDs(ctx context.Context, ids []domain.AccountID) ([]*domain.Account, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountRepository.FindAllByIDs")
+	defer span.End()
+
+	return t.wrapped.FindAllByIDs(ctx, ids)
+}
+
 func (t *accountRepositoryWithTracing) FindByLowerUsername(ctx context.Context, username domain.Username) (*domain.Account, error) {
 	ctx, span := tracer.StartSpan(ctx, "AccountRepository.FindByLowerUsername")
 	defer span.End()
@@ -42,11 +50,46 @@ func (t *accountRepositoryWithTracing) FindByLowerUsername(ctx context.Context,
 	return t.wrapped.FindByLowerUsername(ctx, username)
 }
 
-func (t *accountRepositoryWithTracing) ExistsByLowerUsername(ctx context.Context, username domain.Username) bool {
-	ctx, span := tracer.StartSpan(ctx, "AccountRepository.ExistsByLowerUsername")
+func (t *accountRepositoryWithTracing) FindByEmail(ctx context.Context, email domain.Email) (*domain.Account, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountRepository.FindByEmail")
+	defer span.End()
+
+	return t.wrapped.FindByEmail(ctx, email)
+}
+
+func (t *accountRepositoryWithTracing) FindDeletedByLowerUsername(ctx context.Context, username domain.Username) (*domain.Account, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountRepository.FindDeletedByLowerUsername")
+	defer span.End()
+
+	return t.wrapped.FindDeletedByLowerUsername(ctx, username)
+}
+
+func (t *accountRepositoryWithTracing) FindAllDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*domain.Account, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountRepository.FindAllDeletedBefore")
+	defer span.End()
+
+	return t.wrapped.FindAllDeletedBefore(ctx, before, limit)
+}
+
+func (t *accountRepositoryWithTracing) Search(ctx context.Context, criteria repository.AccountSearchCriteria) ([]*domain.Account, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountRepository.Search")
+	defer span.End()
+
+	return t.wrapped.Search(ctx, criteria)
+}
+
+func (t *accountRepositoryWithTracing) ExistsByEmail(ctx context.Context, email domain.Email) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountRepository.ExistsByEmail")
+	defer span.End()
+
+	return t.wrapped.ExistsByEmail(ctx, email)
+}
+
+func (t *accountRepositoryWithTracing) ExistsByHardwareIDDigest(ctx context.Context, digest domain.HardwareIDDigest) (bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "AccountRepository.ExistsByHardwareIDDigest")
 	defer span.End()
 
-	return t.wrapped.ExistsByLowerUsername(ctx, username)
+	return t.wrapped.ExistsByHardwareIDDigest(ctx, digest)
 }
 
 func (t *accountRepositoryWithTracing) Update(ctx context.Context, account *domain.Account) error {
@@ -55,3 +98,10 @@ func (t *accountRepositoryWithTracing) Update(ctx context.Context, account *doma
 
 	return t.wrapped.Update(ctx, account)
 }
+
+func (t *accountRepositoryWithTracing) UpdateLastLoginAt(ctx context.Context, id domain.AccountID, at time.Time) error {
+	ctx, span := tracer.StartSpan(ctx, "AccountRepository.UpdateLastLoginAt")
+	defer span.End()
+
+	return t.wrapped.UpdateLastLoginAt(ctx, id, at)
+}
@@ -0,0 +1,61 @@
+package persistence
+
+import (
+	"context"
+	"github.com/intezya/auth_service/internal/adapters/mapper"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+	entAuditEntry "github.com/intezya/auth_service/internal/infrastructure/ent/auditentry"
+)
+
+type auditRepository struct {
+	client *ent.Client
+}
+
+func NewAuditRepository(client *ent.Client) repository.AuditRepository {
+	return &auditRepository{client: client}
+}
+
+func (r *auditRepository) Create(ctx context.Context, entry *domain.AuditEntry) (*domain.AuditEntry, error) {
+	created, err := clientFromContext(ctx, r.client).AuditEntry.
+		Create().
+		SetAccountID(entry.AccountID()).
+		SetAction(string(entry.Action())).
+		SetActor(string(entry.Actor())).
+		SetDetails(entry.Details()).
+		SetCreatedAt(entry.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		if mapped, ok := mapConstraintError(err); ok {
+			return nil, mapped
+		}
+
+		return nil, unexpectedError(err)
+	}
+
+	return mapper.EntAuditEntryToDomain(created), nil
+}
+
+func (r *auditRepository) FindAllByAccountID(ctx context.Context, accountID domain.AccountID) (
+	[]*domain.AuditEntry,
+	error,
+) {
+	ctx = readOnly(ctx)
+
+	found, err := clientFromContext(ctx, r.client).AuditEntry.
+		Query().
+		Where(entAuditEntry.AccountID(int(accountID))).
+		Order(ent.Asc(entAuditEntry.FieldCreatedAt), ent.Asc(entAuditEntry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, unexpectedError(err)
+	}
+
+	entries := make([]*domain.AuditEntry, 0, len(found))
+	for _, entry := range found {
+		entries = append(entries, mapper.EntAuditEntryToDomain(entry))
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,71 @@
+package persistence
+
+import (
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// constraintErrors maps constraint names of every dialect to repository errors.
+// Names must follow migrations, see internal/infrastructure/persistence/migrations.
+var constraintErrors = map[string]error{
+	// postgres
+	"accounts_username_canonical_key": repository.ErrAccountAlreadyExists,
+	"accounts_hardware_id_digest_key": repository.ErrHardwareIDConflict,
+	"devices_hardware_id_digest_key":  repository.ErrHardwareIDConflict,
+	"accounts_email_canonical_key":    repository.ErrEmailAlreadyUsed,
+	// mysql index and sqlite3 column names, see constraintName
+	"username_canonical": repository.ErrAccountAlreadyExists,
+	"hardware_id_digest": repository.ErrHardwareIDConflict,
+	"email_canonical":    repository.ErrEmailAlreadyUsed,
+}
+
+// constraintName strips "table." prefix reported by sqlite3 and MySQL 8.0.19+,
+// older MySQL servers report bare index name.
+func constraintName(name string) string {
+	if _, unqualified, ok := strings.Cut(name, "."); ok {
+		return unqualified
+	}
+
+	return name
+}
+
+// mapConstraintError converts ent constraint error to typed repository error.
+// It returns false if err is not a constraint error.
+func mapConstraintError(err error) (error, bool) {
+	if !ent.IsConstraintError(err) {
+		return nil, false
+	}
+
+	violation, ok := parseConstraintViolation(err)
+	if !ok {
+		// ent validators and edge checks (e.g. unique edge already set)
+		return repository.ErrConstraintViolation, true
+	}
+
+	if mapped, ok := constraintErrors[constraintName(violation.name)]; ok {
+		return mapped, true
+	}
+
+	switch violation.kind {
+	case uniqueConstraint:
+		return repository.ErrDuplicateEntity, true
+	case foreignKeyConstraint:
+		return repository.ErrReferenceNotFound, true
+	case checkConstraint, notNullConstraint:
+		return repository.ErrConstraintViolation, true
+	}
+
+	return repository.ErrConstraintViolation, true
+}
+
+// unexpectedError converts database error which is not a part of repository contract.
+func unexpectedError(err error) error {
+	if isSerializationFailure(err) {
+		return repository.ErrSerializationFailure
+	}
+
+	return status.Errorf(codes.Internal, "unexpected internal error: %v", err)
+}
@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"github.com/go-sql-driver/mysql"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"testing"
+)
+
+func TestParseMySQLViolation_IndexNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    error
+	}{
+		{
+			name:    "qualified (8.0.19+)",
+			message: "Duplicate entry 'alice' for key 'accounts.username_canonical'",
+			want:    repository.ErrAccountAlreadyExists,
+		},
+		{
+			name:    "bare (before 8.0.19)",
+			message: "Duplicate entry 'alice' for key 'username_canonical'",
+			want:    repository.ErrAccountAlreadyExists,
+		},
+		{
+			name:    "bare hardware id",
+			message: "Duplicate entry 'digest' for key 'hardware_id_digest'",
+			want:    repository.ErrHardwareIDConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				violation, ok := parseMySQLViolation(&mysql.MySQLError{Number: 1062, Message: tt.message})
+				if !ok || violation.kind != uniqueConstraint {
+					t.Fatalf("parseMySQLViolation() = %+v, %t, want unique violation", violation, ok)
+				}
+
+				if got := constraintErrors[constraintName(violation.name)]; got != tt.want {
+					t.Fatalf("constraint %q maps to %v, want %v", violation.name, got, tt.want)
+				}
+			},
+		)
+	}
+}
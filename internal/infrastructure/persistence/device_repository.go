@@ -0,0 +1,176 @@
+package persistence
+
+import (
+	"context"
+	"github.com/intezya/auth_service/internal/adapters/mapper"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+	entDevice "github.com/intezya/auth_service/internal/infrastructure/ent/device"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type deviceRepository struct {
+	client *ent.Client
+}
+
+func NewDeviceRepository(client *ent.Client) repository.DeviceRepository {
+	return &deviceRepository{client: client}
+}
+
+func (r *deviceRepository) Create(ctx context.Context, device *domain.Device) (*domain.Device, error) {
+	created, err := clientFromContext(ctx, r.client).Device.
+		Create().
+		SetAccountID(device.AccountID()).
+		SetHardwareID(device.HardwareID()).
+		SetHardwareIDDigest(device.HardwareIDDigest()).
+		SetStatus(device.Status()).
+		SetCreatedAt(device.CreatedAt()).
+		SetNillableApprovedAt(device.ApprovedAt()).
+		SetNillableLastSeenAt(device.LastSeenAt()).
+		Save(ctx)
+	if err != nil {
+		return nil, r.handleConstraintError(err)
+	}
+
+	return mapper.EntDeviceToDomain(created), nil
+}
+
+func (r *deviceRepository) FindByID(ctx context.Context, id domain.DeviceID) (*domain.Device, error) {
+	ctx = readOnly(ctx)
+
+	found, err := clientFromContext(ctx, r.client).Device.Get(ctx, int(id))
+	if err != nil {
+		return nil, r.handleNotFoundError(err)
+	}
+
+	return mapper.EntDeviceToDomain(found), nil
+}
+
+func (r *deviceRepository) FindByHardwareIDDigest(ctx context.Context, digest domain.HardwareIDDigest) (
+	*domain.Device,
+	error,
+) {
+	ctx = readOnly(ctx)
+
+	found, err := clientFromContext(ctx, r.client).Device.
+		Query().
+		Where(entDevice.HardwareIDDigest(string(digest))).
+		Only(ctx)
+	if err != nil {
+		return nil, r.handleNotFoundError(err)
+	}
+
+	return mapper.EntDeviceToDomain(found), nil
+}
+
+func (r *deviceRepository) FindAllByAccountID(ctx context.Context, accountID domain.AccountID) (
+	[]*domain.Device,
+	error,
+) {
+	ctx = readOnly(ctx)
+
+	found, err := clientFromContext(ctx, r.client).Device.
+		Query().
+		Where(entDevice.AccountID(int(accountID))).
+		Order(ent.Asc(entDevice.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, unexpectedError(err)
+	}
+
+	devices := make([]*domain.Device, 0, len(found))
+	for _, device := range found {
+		devices = append(devices, mapper.EntDeviceToDomain(device))
+	}
+
+	return devices, nil
+}
+
+func (r *deviceRepository) CountByAccountID(ctx context.Context, accountID domain.AccountID) (int, error) {
+	ctx = readOnly(ctx)
+
+	count, err := clientFromContext(ctx, r.client).Device.
+		Query().
+		Where(entDevice.AccountID(int(accountID))).
+		Count(ctx)
+	if err != nil {
+		return 0, unexpectedError(err)
+	}
+
+	return count, nil
+}
+
+func (r *deviceRepository) CountTrustedByAccountID(ctx context.Context, accountID domain.AccountID) (int, error) {
+	ctx = readOnly(ctx)
+
+	count, err := clientFromContext(ctx, r.client).Device.
+		Query().
+		Where(entDevice.AccountID(int(accountID)), entDevice.Status(domain.DeviceStatusTrusted)).
+		Count(ctx)
+	if err != nil {
+		return 0, unexpectedError(err)
+	}
+
+	return count, nil
+}
+
+func (r *deviceRepository) Update(ctx context.Context, device *domain.Device) error {
+	err := clientFromContext(ctx, r.client).Device.
+		UpdateOneID(device.ID()).
+		SetStatus(device.Status()).
+		SetNillableApprovedAt(device.ApprovedAt()).
+		SetNillableLastSeenAt(device.LastSeenAt()).
+		Exec(ctx)
+	if err != nil {
+		return r.handleNotFoundError(err)
+	}
+
+	return nil
+}
+
+func (r *deviceRepository) Delete(ctx context.Context, id domain.DeviceID) error {
+	err := clientFromContext(ctx, r.client).Device.DeleteOneID(int(id)).Exec(ctx)
+	if err != nil {
+		return r.handleNotFoundError(err)
+	}
+
+	return nil
+}
+
+func (r *deviceRepository) DeleteAllByAccountID(ctx context.Context, accountID domain.AccountID) error {
+	_, err := clientFromContext(ctx, r.client).Device.
+		Delete().
+		Where(entDevice.AccountID(int(accountID))).
+		Exec(ctx)
+	if err != nil {
+		return unexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *deviceRepository) handleConstraintError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if mapped, ok := mapConstraintError(err); ok {
+		return mapped
+	}
+
+	return unexpectedError(err)
+}
+
+func (r *deviceRepository) handleNotFoundError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if ent.IsNotFound(err) {
+		return status.Errorf(codes.NotFound, "device not found")
+	}
+
+	return unexpectedError(err)
+}
@@ -0,0 +1,204 @@
+package persistence
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+var errUnsupportedDriver = errors.New("unsupported database driver")
+
+func init() {
+	// modernc.org/sqlite registers itself as "sqlite", ent dialect expects "sqlite3"
+	sql.Register(dialect.SQLite, &sqlite.Driver{})
+}
+
+// dataSourceName builds DSN for configured driver. For sqlite3 DBName is a database file path.
+func dataSourceName(config EntConfig) (string, error) {
+	switch config.Driver {
+	case dialect.Postgres:
+		return fmt.Sprintf(
+			"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+			config.Host, config.Port, config.User, config.Password, config.DBName, config.SSL,
+		), nil
+	case dialect.MySQL:
+		mysqlConfig := mysql.NewConfig()
+		mysqlConfig.User = config.User
+		mysqlConfig.Passwd = config.Password
+		mysqlConfig.Net = "tcp"
+		mysqlConfig.Addr = net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+		mysqlConfig.DBName = config.DBName
+		mysqlConfig.ParseTime = true
+		mysqlConfig.MultiStatements = true // migrations are executed file by file
+
+		if config.SSL != "disable" {
+			mysqlConfig.TLSConfig = "true"
+		}
+
+		return mysqlConfig.FormatDSN(), nil
+	case dialect.SQLite:
+		return fmt.Sprintf(
+			"file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_time_format=sqlite&_txlock=immediate",
+			config.DBName,
+		), nil
+	default:
+		return "", fmt.Errorf("%w: %s", errUnsupportedDriver, config.Driver)
+	}
+}
+
+type constraintKind int
+
+const (
+	uniqueConstraint constraintKind = iota + 1
+	foreignKeyConstraint
+	checkConstraint
+	notNullConstraint
+)
+
+// constraintViolation describes driver error of violated constraint.
+// Name is a constraint (index) name for postgres and mysql, "table.column" for sqlite3 unique and not null violations.
+type constraintViolation struct {
+	kind constraintKind
+	name string
+}
+
+// parseConstraintViolation extracts violated constraint from driver error by its code.
+func parseConstraintViolation(err error) (constraintViolation, bool) {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return parsePostgresViolation(pqErr)
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return parseMySQLViolation(mysqlErr)
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		return parseSQLiteViolation(sqliteErr)
+	}
+
+	return constraintViolation{}, false
+}
+
+func parsePostgresViolation(err *pq.Error) (constraintViolation, bool) {
+	switch err.Code {
+	case "23505": // unique_violation
+		return constraintViolation{kind: uniqueConstraint, name: err.Constraint}, true
+	case "23503": // foreign_key_violation
+		return constraintViolation{kind: foreignKeyConstraint, name: err.Constraint}, true
+	case "23514": // check_violation
+		return constraintViolation{kind: checkConstraint, name: err.Constraint}, true
+	case "23502": // not_null_violation
+		return constraintViolation{kind: notNullConstraint, name: err.Table + "." + err.Column}, true
+	}
+
+	return constraintViolation{}, false
+}
+
+// mysql reports constraint name only in the error message.
+func parseMySQLViolation(err *mysql.MySQLError) (constraintViolation, bool) {
+	switch err.Number {
+	case 1062: //nolint:mnd // ER_DUP_ENTRY: Duplicate entry 'value' for key 'accounts.username'
+		return constraintViolation{kind: uniqueConstraint, name: quotedAfter(err.Message, " for key ", "'")}, true
+	case 1451, 1452: //nolint:mnd // ER_ROW_IS_REFERENCED_2, ER_NO_REFERENCED_ROW_2: ... CONSTRAINT `name` FOREIGN KEY ...
+		return constraintViolation{kind: foreignKeyConstraint, name: quotedAfter(err.Message, "CONSTRAINT ", "`")}, true
+	case 3819: //nolint:mnd // ER_CHECK_CONSTRAINT_VIOLATED: Check constraint 'name' is violated.
+		return constraintViolation{kind: checkConstraint, name: quotedAfter(err.Message, "constraint ", "'")}, true
+	case 1048: //nolint:mnd // ER_BAD_NULL_ERROR: Column 'name' cannot be null
+		return constraintViolation{kind: notNullConstraint, name: quotedAfter(err.Message, "Column ", "'")}, true
+	}
+
+	return constraintViolation{}, false
+}
+
+// sqlite reports "table.column" for unique and not null violations, check constraint name
+// and nothing for foreign keys.
+func parseSQLiteViolation(err *sqlite.Error) (constraintViolation, bool) {
+	// constraint failed: UNIQUE constraint failed: accounts.username (2067)
+	message := err.Error()
+	name := message[strings.LastIndex(message, "failed: ")+len("failed: "):]
+	name, _, _ = strings.Cut(name, " ")
+
+	switch err.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		return constraintViolation{kind: uniqueConstraint, name: name}, true
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		return constraintViolation{kind: foreignKeyConstraint}, true
+	case sqlite3.SQLITE_CONSTRAINT_CHECK:
+		return constraintViolation{kind: checkConstraint, name: name}, true
+	case sqlite3.SQLITE_CONSTRAINT_NOTNULL:
+		return constraintViolation{kind: notNullConstraint, name: name}, true
+	}
+
+	return constraintViolation{}, false
+}
+
+// isSerializationFailure reports whether transaction was aborted because of concurrent transaction
+// and can be retried.
+func isSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == "40001" || pqErr.Code == "40P01" // serialization_failure, deadlock_detected
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == 1213 || mysqlErr.Number == 1205 //nolint:mnd // ER_LOCK_DEADLOCK, ER_LOCK_WAIT_TIMEOUT
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code()&0xff == sqlite3.SQLITE_BUSY //nolint:mnd // primary result code
+	}
+
+	return false
+}
+
+func quotedAfter(message string, prefix string, quote string) string {
+	_, rest, found := strings.Cut(message, prefix)
+	if !found {
+		return ""
+	}
+
+	parts := strings.SplitN(rest, quote, 3) //nolint:mnd // "", name, rest
+	if len(parts) < 3 {                     //nolint:mnd
+		return ""
+	}
+
+	return parts[1]
+}
+
+// rebind replaces "?" placeholders with positional ones for postgres.
+func rebind(driver string, query string) string {
+	if driver != dialect.Postgres {
+		return query
+	}
+
+	var builder strings.Builder
+
+	position := 0
+
+	for _, char := range query {
+		if char == '?' {
+			position++
+			builder.WriteString("$" + strconv.Itoa(position))
+
+			continue
+		}
+
+		builder.WriteRune(char)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"context"
+	"github.com/intezya/auth_service/internal/adapters/mapper"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+	entEmailVerification "github.com/intezya/auth_service/internal/infrastructure/ent/emailverification"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type emailVerificationRepository struct {
+	client *ent.Client
+}
+
+func NewEmailVerificationRepository(client *ent.Client) repository.EmailVerificationRepository {
+	return &emailVerificationRepository{client: client}
+}
+
+func (r *emailVerificationRepository) Create(ctx context.Context, verification *domain.EmailVerification) (
+	*domain.EmailVerification,
+	error,
+) {
+	created, err := clientFromContext(ctx, r.client).EmailVerification.
+		Create().
+		SetAccountID(verification.AccountID()).
+		SetEmail(verification.Email()).
+		SetCodeDigest(verification.CodeDigest()).
+		SetAttempts(verification.Attempts()).
+		SetExpiresAt(verification.ExpiresAt()).
+		SetCreatedAt(verification.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		if mapped, ok := mapConstraintError(err); ok {
+			return nil, mapped
+		}
+
+		return nil, unexpectedError(err)
+	}
+
+	return mapper.EntEmailVerificationToDomain(created), nil
+}
+
+func (r *emailVerificationRepository) FindLatestByAccountID(ctx context.Context, accountID domain.AccountID) (
+	*domain.EmailVerification,
+	error,
+) {
+	found, err := clientFromContext(ctx, r.client).EmailVerification.
+		Query().
+		Where(entEmailVerification.AccountID(int(accountID))).
+		Order(ent.Desc(entEmailVerification.FieldCreatedAt), ent.Desc(entEmailVerification.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "email verification not found")
+		}
+
+		return nil, unexpectedError(err)
+	}
+
+	return mapper.EntEmailVerificationToDomain(found), nil
+}
+
+func (r *emailVerificationRepository) Update(ctx context.Context, verification *domain.EmailVerification) error {
+	err := clientFromContext(ctx, r.client).EmailVerification.
+		UpdateOneID(verification.ID()).
+		SetAttempts(verification.Attempts()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return status.Errorf(codes.NotFound, "email verification not found")
+		}
+
+		return unexpectedError(err)
+	}
+
+	return nil
+}
+
+func (r *emailVerificationRepository) DeleteAllByAccountID(ctx context.Context, accountID domain.AccountID) error {
+	_, err := clientFromContext(ctx, r.client).EmailVerification.
+		Delete().
+		Where(entEmailVerification.AccountID(int(accountID))).
+		Exec(ctx)
+	if err != nil {
+		return unexpectedError(err)
+	}
+
+	return nil
+}
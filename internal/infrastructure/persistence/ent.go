@@ -2,20 +2,22 @@ package persistence
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
 	"github.com/intezya/auth_service/internal/infrastructure/ent"
-	"github.com/intezya/auth_service/internal/infrastructure/ent/migrate"
-
+	"github.com/intezya/auth_service/pkg/defaults"
+	"math/rand/v2"
 	"time"
-)
-
-import _ "github.com/lib/pq" //nolint
 
-const driverName = "postgres" //nolint
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
 
 const (
-	defaultEntReconnectMaxRetries = 5
-	defaultEntReconnectDelay      = 2 * time.Second
+	defaultConnectMaxRetries     = 5
+	defaultConnectInitialBackoff = 500 * time.Millisecond
+	defaultConnectMaxBackoff     = 30 * time.Second
 )
 
 type Logger interface {
@@ -26,73 +28,165 @@ type Logger interface {
 }
 
 type EntConfig struct {
-	source     string
-	maxRetries int
-	retryDelay time.Duration
-	Debug      bool `env:"ORM_DEBUG" env-default:"false"`
+	Debug bool `env:"ORM_DEBUG" env-default:"false"`
+	// AutoMigrate applies pending migrations on startup (intended for local development).
+	AutoMigrate bool `env:"DATABASE_AUTO_MIGRATE" env-default:"false"`
 
+	// Driver is one of "postgres", "mysql", "sqlite3".
+	Driver   string `env:"DATABASE_DRIVER" env-default:"postgres"`
 	Host     string `env:"DATABASE_HOST" env-default:"localhost"`
 	Port     int    `env:"DATABASE_PORT" env-default:"5432"`
 	User     string `env:"DATABASE_USERNAME" env-default:"postgres"`
 	Password string `env:"DATABASE_PASSWORD" env-default:"postgres"`
 	DBName   string `env:"DATABASE_NAME" env-default:"postgres"`
 	SSL      string `env:"DATABASE_SSL" env-default:"disable"`
+
+	// Connection pool of primary and every replica, zero values follow database/sql semantics.
+	MaxOpenConns    int           `env:"DATABASE_MAX_OPEN_CONNS" env-default:"25"`
+	MaxIdleConns    int           `env:"DATABASE_MAX_IDLE_CONNS" env-default:"10"`
+	ConnMaxLifetime time.Duration `env:"DATABASE_CONN_MAX_LIFETIME" env-default:"30m"`
+	ConnMaxIdleTime time.Duration `env:"DATABASE_CONN_MAX_IDLE_TIME" env-default:"5m"`
+
+	// Startup connection attempts are retried with exponential backoff and full jitter.
+	ConnectMaxRetries     int           `env:"DATABASE_CONNECT_MAX_RETRIES" env-default:"5"`
+	ConnectInitialBackoff time.Duration `env:"DATABASE_CONNECT_INITIAL_BACKOFF" env-default:"500ms"`
+	ConnectMaxBackoff     time.Duration `env:"DATABASE_CONNECT_MAX_BACKOFF" env-default:"30s"`
+
+	// TxMaxRetries limits attempts of transaction aborted by serialization failure or deadlock.
+	TxMaxRetries int `env:"DATABASE_TX_MAX_RETRIES" env-default:"3"`
+
+	// ReplicaDSNs are read replica data source names in driver format.
+	ReplicaDSNs          []string      `env:"DATABASE_REPLICA_DSNS" env-separator:";"`
+	ReplicaCheckInterval time.Duration `env:"DATABASE_REPLICA_CHECK_INTERVAL" env-default:"5s"`
+	// ReplicaMaxLag routes reads back to primary when replica falls behind (0 disables the check).
+	ReplicaMaxLag time.Duration `env:"DATABASE_REPLICA_MAX_LAG" env-default:"2s"`
 }
 
+// SetupEnt connects to database and refuses to start when its schema version differs from embedded migrations.
 func SetupEnt(config EntConfig, logger Logger) *ent.Client {
-	maxRetries := gt0(config.maxRetries, defaultEntReconnectMaxRetries)
-	retryDelay := gt0(config.retryDelay, defaultEntReconnectDelay)
-
-	source := fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		config.Host, config.Port, config.User, config.Password, config.DBName, config.SSL,
-	)
+	maxRetries := defaults.Positive(config.ConnectMaxRetries, defaultConnectMaxRetries)
+	initialBackoff := defaults.Positive(config.ConnectInitialBackoff, defaultConnectInitialBackoff)
+	maxBackoff := defaults.Positive(config.ConnectMaxBackoff, defaultConnectMaxBackoff)
 
-	entClient, err := ent.Open(driverName, source)
+	db, err := OpenDB(config)
 	if err != nil {
 		logger.Fatal(err) // invalid driver
 	}
 
-	if config.Debug {
-		entClient = entClient.Debug()
+	migrator, err := NewMigrator(db, config.Driver)
+	if err != nil {
+		logger.Fatal(err) // malformed embedded migrations
 	}
 
+	var status *MigrationStatus
+
 	// Retry connecting to the database if it fails
 	for attempt := 1; attempt <= maxRetries; attempt++ {
-		err = entClient.Schema.Create(
-			context.Background(),
-			migrate.WithDropIndex(true),
-			migrate.WithDropColumn(true),
-		)
+		status, err = checkSchema(context.Background(), migrator, config.AutoMigrate)
 		if err == nil {
-			logger.Infof("Database migrations runned success on attempt %d", attempt)
+			logger.Infof("Database schema checked on attempt %d", attempt)
 
 			break
 		}
 
 		logger.Warnf(
-			"Attempt %d of %d: Failed to run migrations for database: %v",
+			"Attempt %d of %d: Failed to check database schema: %v",
 			attempt,
 			maxRetries,
 			err,
 		)
 
 		if attempt < maxRetries {
-			time.Sleep(retryDelay)
+			time.Sleep(backoff(attempt, initialBackoff, maxBackoff))
 		}
 	}
 
 	if err != nil {
-		logger.Fatalf("failed to create schema (all attempts are over)")
+		logger.Fatalf("failed to check database schema (all attempts are over)")
+	}
+
+	if !status.IsUpToDate() {
+		logger.Fatalf(
+			"database schema version %d (dirty: %t) doesn't match binary schema version %d, run migrations",
+			status.Version,
+			status.Dirty,
+			status.Latest,
+		)
+	}
+
+	registerDBStats(db, "primary", logger)
+
+	var driver dialect.Driver = entsql.OpenDB(config.Driver, db)
+
+	if len(config.ReplicaDSNs) > 0 {
+		replicas, err := openReplicas(config)
+		if err != nil {
+			logger.Fatal(err)
+		}
+
+		for _, replica := range replicas {
+			registerDBStats(replica.driver.DB(), replica.name, logger)
+		}
+
+		driver = newRoutingDriver(entsql.OpenDB(config.Driver, db), replicas, config, logger)
+	}
+
+	entClient := ent.NewClient(ent.Driver(driver))
+
+	if config.Debug {
+		entClient = entClient.Debug()
 	}
 
 	return entClient
 }
 
-func gt0[T int | time.Duration](value T, fallback T) T {
-	if value <= 0 {
-		return fallback
+// OpenDB opens database handle without touching the schema.
+func OpenDB(config EntConfig) (*sql.DB, error) {
+	source, err := dataSourceName(config)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open(config.Driver, source)
+	if err != nil {
+		return nil, err
+	}
+
+	configurePool(db, config)
+
+	return db, nil
+}
+
+func configurePool(db *sql.DB, config EntConfig) {
+	db.SetMaxOpenConns(config.MaxOpenConns)
+	db.SetMaxIdleConns(config.MaxIdleConns)
+	db.SetConnMaxLifetime(config.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+}
+
+// registerDBStats exports sql.DBStats of db as go_sql_* gauges labeled with db_name.
+func registerDBStats(db *sql.DB, name string, logger Logger) {
+	if err := prometheus.Register(collectors.NewDBStatsCollector(db, name)); err != nil {
+		logger.Warnf("Failed to register %s database metrics: %v", name, err)
+	}
+}
+
+// backoff returns random delay in [0, min(maxBackoff, initialBackoff * 2^(attempt-1))).
+func backoff(attempt int, initialBackoff time.Duration, maxBackoff time.Duration) time.Duration {
+	delay := maxBackoff
+	if shift := attempt - 1; shift < 32 && initialBackoff<<shift < maxBackoff { //nolint:mnd // avoid overflow
+		delay = initialBackoff << shift
+	}
+
+	return rand.N(delay) //nolint:gosec // jitter doesn't need crypto random
+}
+
+func checkSchema(ctx context.Context, migrator *Migrator, autoMigrate bool) (*MigrationStatus, error) {
+	if autoMigrate {
+		if err := migrator.Up(ctx); err != nil {
+			return nil, err
+		}
 	}
 
-	return value
+	return migrator.Status(ctx)
 }
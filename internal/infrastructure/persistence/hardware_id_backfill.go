@@ -0,0 +1,81 @@
+package persistence
+
+import (
+	"context"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+	entAccount "github.com/intezya/auth_service/internal/infrastructure/ent/account"
+)
+
+const hardwareIDBackfillBatchSize = 500
+
+// BackfillHardwareIDDigests fills hardware_id_digest for accounts stored before the blind index existed.
+// Rows are processed in id order, so the job can be interrupted and started again at any time.
+func BackfillHardwareIDDigests(
+	ctx context.Context,
+	client *ent.Client,
+	passwordEncoder service.PasswordEncoder,
+	logger Logger,
+) {
+	lastID := 0
+	updated := 0
+
+	for {
+		batch, err := client.Account.
+			Query().
+			Where(
+				entAccount.HardwareIDNotNil(),
+				entAccount.HardwareIDDigestIsNil(),
+				entAccount.IDGT(lastID),
+			).
+			Order(ent.Asc(entAccount.FieldID)).
+			Limit(hardwareIDBackfillBatchSize).
+			All(ctx)
+		if err != nil {
+			logger.Warnf("Hardware id digest backfill stopped: %v", err)
+
+			return
+		}
+
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, account := range batch {
+			lastID = account.ID
+
+			hardwareID, err := passwordEncoder.DecodeHardwareID(ctx, *account.HardwareID)
+			if err != nil {
+				logger.Warnf("Hardware id digest backfill: account %d has undecodable hardware id: %v", account.ID, err)
+
+				continue
+			}
+
+			err = client.Account.
+				UpdateOneID(account.ID).
+				Where(entAccount.Version(account.Version)).
+				SetHardwareIDDigest(passwordEncoder.DigestHardwareID(ctx, hardwareID)).
+				AddVersion(1).
+				Exec(ctx)
+			if ent.IsNotFound(err) {
+				continue // changed concurrently, digest is written by that update
+			}
+
+			if ent.IsConstraintError(err) {
+				logger.Warnf("Hardware id digest backfill: account %d shares hardware id with another account", account.ID)
+
+				continue
+			}
+
+			if err != nil {
+				logger.Warnf("Hardware id digest backfill stopped: %v", err)
+
+				return
+			}
+
+			updated++
+		}
+	}
+
+	logger.Infof("Hardware id digest backfill completed: %d accounts updated", updated)
+}
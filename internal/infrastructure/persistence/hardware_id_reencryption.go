@@ -0,0 +1,156 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+	entAccount "github.com/intezya/auth_service/internal/infrastructure/ent/account"
+	entDevice "github.com/intezya/auth_service/internal/infrastructure/ent/device"
+	"github.com/intezya/auth_service/pkg/defaults"
+)
+
+const defaultHardwareIDReencryptionBatchSize = 500
+
+type HardwareIDReencryptionOptions struct {
+	BatchSize int
+	// AccountsFromID and DevicesFromID resume interrupted run after the last logged ids.
+	AccountsFromID int
+	DevicesFromID  int
+}
+
+// ReencryptHardwareIDs re-encrypts stored hardware ids of accounts and devices with the primary key.
+// Values already encrypted with the primary key are skipped, so the job is safe to run again.
+func ReencryptHardwareIDs(
+	ctx context.Context,
+	client *ent.Client,
+	passwordEncoder service.PasswordEncoder,
+	options HardwareIDReencryptionOptions,
+	logger Logger,
+) error {
+	batchSize := defaults.Positive(options.BatchSize, defaultHardwareIDReencryptionBatchSize)
+
+	accounts, err := reencryptAccounts(ctx, client, passwordEncoder, batchSize, options.AccountsFromID, logger)
+	if err != nil {
+		return fmt.Errorf("accounts re-encryption: %w", err)
+	}
+
+	devices, err := reencryptDevices(ctx, client, passwordEncoder, batchSize, options.DevicesFromID, logger)
+	if err != nil {
+		return fmt.Errorf("devices re-encryption: %w", err)
+	}
+
+	logger.Infof("Hardware id re-encryption completed: %d accounts, %d devices updated", accounts, devices)
+
+	return nil
+}
+
+func reencryptAccounts(
+	ctx context.Context,
+	client *ent.Client,
+	passwordEncoder service.PasswordEncoder,
+	batchSize int,
+	lastID int,
+	logger Logger,
+) (int, error) {
+	updated := 0
+
+	for {
+		batch, err := client.Account.
+			Query().
+			Where(entAccount.HardwareIDNotNil(), entAccount.IDGT(lastID)).
+			Order(ent.Asc(entAccount.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return updated, err
+		}
+
+		if len(batch) == 0 {
+			return updated, nil
+		}
+
+		for _, account := range batch {
+			reencrypted, changed, err := passwordEncoder.ReencryptHardwareID(ctx, *account.HardwareID)
+			if err != nil {
+				logger.Warnf("Hardware id re-encryption: account %d has undecodable hardware id: %v", account.ID, err)
+
+				continue
+			}
+
+			if !changed {
+				continue
+			}
+
+			err = client.Account.
+				UpdateOneID(account.ID).
+				Where(entAccount.Version(account.Version)).
+				SetHardwareID(reencrypted).
+				AddVersion(1).
+				Exec(ctx)
+			if ent.IsNotFound(err) {
+				// account was changed concurrently, it is re-encrypted by the next run
+				continue
+			}
+
+			if err != nil {
+				return updated, err
+			}
+
+			updated++
+		}
+
+		lastID = batch[len(batch)-1].ID
+		logger.Infof("Hardware id re-encryption: accounts processed up to id %d", lastID)
+	}
+}
+
+func reencryptDevices(
+	ctx context.Context,
+	client *ent.Client,
+	passwordEncoder service.PasswordEncoder,
+	batchSize int,
+	lastID int,
+	logger Logger,
+) (int, error) {
+	updated := 0
+
+	for {
+		batch, err := client.Device.
+			Query().
+			Where(entDevice.IDGT(lastID)).
+			Order(ent.Asc(entDevice.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return updated, err
+		}
+
+		if len(batch) == 0 {
+			return updated, nil
+		}
+
+		for _, device := range batch {
+			reencrypted, changed, err := passwordEncoder.ReencryptHardwareID(ctx, device.HardwareID)
+			if err != nil {
+				logger.Warnf("Hardware id re-encryption: device %d has undecodable hardware id: %v", device.ID, err)
+
+				continue
+			}
+
+			if !changed {
+				continue
+			}
+
+			err = client.Device.UpdateOneID(device.ID).SetHardwareID(reencrypted).Exec(ctx)
+			if err != nil {
+				return updated, err
+			}
+
+			updated++
+		}
+
+		lastID = batch[len(batch)-1].ID
+		logger.Infof("Hardware id re-encryption: devices processed up to id %d", lastID)
+	}
+}
@@ -0,0 +1,254 @@
+package persistence
+
+import (
+	"context"
+	"github.com/intezya/auth_service/internal/adapters/mapper"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+	entLoginAttempt "github.com/intezya/auth_service/internal/infrastructure/ent/loginattempt"
+	entUnknownLoginAttempt "github.com/intezya/auth_service/internal/infrastructure/ent/unknownloginattempt"
+	"time"
+)
+
+type loginAttemptRepository struct {
+	client *ent.Client
+}
+
+func NewLoginAttemptRepository(client *ent.Client) repository.LoginAttemptRepository {
+	return &loginAttemptRepository{client: client}
+}
+
+// CreateBulk stores attempts of unknown logins apart from login history, they have no account to belong to.
+func (r *loginAttemptRepository) CreateBulk(ctx context.Context, attempts []*domain.LoginAttempt) error {
+	client := clientFromContext(ctx, r.client)
+
+	builders := make([]*ent.LoginAttemptCreate, 0, len(attempts))
+	unknownBuilders := make([]*ent.UnknownLoginAttemptCreate, 0)
+
+	for _, attempt := range attempts {
+		if attempt.IsUnknownAccount() {
+			unknownBuilders = append(
+				unknownBuilders,
+				client.UnknownLoginAttempt.
+					Create().
+					SetIP(*attempt.IP()).
+					SetLoginDigest(*attempt.LoginDigest()).
+					SetCreatedAt(attempt.CreatedAt()),
+			)
+
+			continue
+		}
+
+		location := attempt.Location()
+
+		builder := client.LoginAttempt.
+			Create().
+			SetAccountID(attempt.AccountID()).
+			SetNillableIP(attempt.IP()).
+			SetHardwareIDMatch(string(attempt.HardwareIDMatch())).
+			SetSuccess(attempt.IsSuccessful()).
+			SetNillableFailureReason((*string)(attempt.FailureReason())).
+			SetNillableAsn(location.ASN).
+			SetNillableCountry(location.Country).
+			SetNillableLatitude(location.Latitude).
+			SetNillableLongitude(location.Longitude).
+			SetCreatedAt(attempt.CreatedAt())
+
+		if risk := attempt.Risk(); risk != nil {
+			builder.
+				SetRiskScore(risk.Score).
+				SetRiskDecision(string(risk.Decision)).
+				SetRiskSignals(mapper.RiskSignalsFromDomain(risk.Signals))
+		}
+
+		builders = append(builders, builder)
+	}
+
+	if len(builders) > 0 {
+		err := client.LoginAttempt.CreateBulk(builders...).Exec(ctx)
+		if err != nil {
+			if mapped, ok := mapConstraintError(err); ok {
+				return mapped
+			}
+
+			return unexpectedError(err)
+		}
+	}
+
+	if len(unknownBuilders) > 0 {
+		if err := client.UnknownLoginAttempt.CreateBulk(unknownBuilders...).Exec(ctx); err != nil {
+			return unexpectedError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *loginAttemptRepository) FindAllByAccountID(
+	ctx context.Context,
+	accountID domain.AccountID,
+	after *repository.LoginAttemptCursor,
+	limit int,
+) ([]*domain.LoginAttempt, error) {
+	ctx = readOnly(ctx)
+
+	query := clientFromContext(ctx, r.client).LoginAttempt.
+		Query().
+		Where(entLoginAttempt.AccountID(int(accountID)))
+
+	if after != nil {
+		query = query.Where(
+			entLoginAttempt.Or(
+				entLoginAttempt.CreatedAtLT(after.CreatedAt),
+				entLoginAttempt.And(entLoginAttempt.CreatedAt(after.CreatedAt), entLoginAttempt.IDLT(after.ID)),
+			),
+		)
+	}
+
+	found, err := query.
+		Order(ent.Desc(entLoginAttempt.FieldCreatedAt), ent.Desc(entLoginAttempt.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, unexpectedError(err)
+	}
+
+	attempts := make([]*domain.LoginAttempt, 0, len(found))
+	for _, attempt := range found {
+		attempts = append(attempts, mapper.EntLoginAttemptToDomain(attempt))
+	}
+
+	return attempts, nil
+}
+
+func (r *loginAttemptRepository) FindRecentSuccessfulByAccountID(
+	ctx context.Context,
+	accountID domain.AccountID,
+	limit int,
+) ([]*domain.LoginAttempt, error) {
+	ctx = readOnly(ctx)
+
+	found, err := clientFromContext(ctx, r.client).LoginAttempt.
+		Query().
+		Where(entLoginAttempt.AccountID(int(accountID)), entLoginAttempt.Success(true)).
+		Order(ent.Desc(entLoginAttempt.FieldCreatedAt), ent.Desc(entLoginAttempt.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, unexpectedError(err)
+	}
+
+	attempts := make([]*domain.LoginAttempt, 0, len(found))
+	for _, attempt := range found {
+		attempts = append(attempts, mapper.EntLoginAttemptToDomain(attempt))
+	}
+
+	return attempts, nil
+}
+
+func (r *loginAttemptRepository) CountFailedAccountsByIPSince(ctx context.Context, ip string, since time.Time) (
+	int,
+	error,
+) {
+	ctx = readOnly(ctx)
+	client := clientFromContext(ctx, r.client)
+
+	accountIDs, err := client.LoginAttempt.
+		Query().
+		Where(
+			entLoginAttempt.IP(ip),
+			// failures caused by risk decisions or device checks don't mean the ip guesses passwords
+			entLoginAttempt.FailureReason(string(domain.LoginFailureInvalidPassword)),
+			entLoginAttempt.CreatedAtGTE(since),
+		).
+		Unique(true).
+		Select(entLoginAttempt.FieldAccountID).
+		Ints(ctx)
+	if err != nil {
+		return 0, unexpectedError(err)
+	}
+
+	unknownLogins, err := client.UnknownLoginAttempt.
+		Query().
+		Where(entUnknownLoginAttempt.IP(ip), entUnknownLoginAttempt.CreatedAtGTE(since)).
+		Unique(true).
+		Select(entUnknownLoginAttempt.FieldLoginDigest).
+		Strings(ctx)
+	if err != nil {
+		return 0, unexpectedError(err)
+	}
+
+	return len(accountIDs) + len(unknownLogins), nil
+}
+
+// DeleteAllCreatedBefore deletes attempts of accounts first, attempts of unknown logins fill the rest of the batch.
+func (r *loginAttemptRepository) DeleteAllCreatedBefore(ctx context.Context, before time.Time, limit int) (
+	int,
+	error,
+) {
+	client := clientFromContext(ctx, r.client)
+
+	// DELETE with LIMIT is not portable, ids of the batch are selected first
+	ids, err := client.LoginAttempt.
+		Query().
+		Where(entLoginAttempt.CreatedAtLT(before)).
+		Order(ent.Asc(entLoginAttempt.FieldCreatedAt)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return 0, unexpectedError(err)
+	}
+
+	deleted := 0
+
+	if len(ids) > 0 {
+		deleted, err = client.LoginAttempt.
+			Delete().
+			Where(entLoginAttempt.IDIn(ids...)).
+			Exec(ctx)
+		if err != nil {
+			return 0, unexpectedError(err)
+		}
+	}
+
+	if len(ids) == limit {
+		return deleted, nil
+	}
+
+	unknownIDs, err := client.UnknownLoginAttempt.
+		Query().
+		Where(entUnknownLoginAttempt.CreatedAtLT(before)).
+		Order(ent.Asc(entUnknownLoginAttempt.FieldCreatedAt)).
+		Limit(limit - len(ids)).
+		IDs(ctx)
+	if err != nil {
+		return deleted, unexpectedError(err)
+	}
+
+	if len(unknownIDs) == 0 {
+		return deleted, nil
+	}
+
+	unknownDeleted, err := client.UnknownLoginAttempt.
+		Delete().
+		Where(entUnknownLoginAttempt.IDIn(unknownIDs...)).
+		Exec(ctx)
+	if err != nil {
+		return deleted, unexpectedError(err)
+	}
+
+	return deleted + unknownDeleted, nil
+}
+
+func (r *loginAttemptRepository) DeleteAllByAccountID(ctx context.Context, accountID domain.AccountID) error {
+	_, err := clientFromContext(ctx, r.client).LoginAttempt.
+		Delete().
+		Where(entLoginAttempt.AccountID(int(accountID))).
+		Exec(ctx)
+	if err != nil {
+		return unexpectedError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package persistence
+
+import (
+	"context"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/pkg/clock"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestLoginAttemptRepository_CountFailedAccountsByIPSince(t *testing.T) {
+	forEachBackend(
+		t, func(t *testing.T, provider *Provider) {
+			ctx := context.Background()
+			now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+			ip := "192.0.2.1"
+
+			reasons := []domain.LoginFailureReason{
+				domain.LoginFailureInvalidPassword,
+				domain.LoginFailureInvalidPassword,
+				domain.LoginFailureRiskDenied,
+				domain.LoginFailureDeviceApprovalPending,
+			}
+
+			attempts := make([]*domain.LoginAttempt, 0, len(reasons)+2)
+
+			for i, reason := range reasons {
+				account, err := provider.AccountRepository.Create(
+					ctx, newTestAccount("user"+strconv.Itoa(i), "digest-"+strconv.Itoa(i)),
+				)
+				if err != nil {
+					t.Fatalf("Create() error = %v", err)
+				}
+
+				attempts = append(
+					attempts, domain.NewLoginAttempt(
+						domain.AccountID(account.ID()), &ip, domain.HardwareIDMatchNotChecked, &reason, nil,
+						clock.NewMockClock(now),
+					),
+				)
+
+				if i == 0 {
+					// the same account counts once, attempts before the window don't count
+					attempts = append(
+						attempts,
+						domain.NewLoginAttempt(
+							domain.AccountID(account.ID()), &ip, domain.HardwareIDMatchNotChecked, &reason, nil,
+							clock.NewMockClock(now),
+						),
+						domain.NewLoginAttempt(
+							domain.AccountID(account.ID()), &ip, domain.HardwareIDMatchNotChecked, &reason, nil,
+							clock.NewMockClock(now.Add(-time.Hour)),
+						),
+					)
+				}
+			}
+
+			if err := provider.LoginAttemptRepository.CreateBulk(ctx, attempts); err != nil {
+				t.Fatalf("CreateBulk() error = %v", err)
+			}
+
+			count, err := provider.LoginAttemptRepository.CountFailedAccountsByIPSince(ctx, ip, now.Add(-time.Minute))
+			if err != nil {
+				t.Fatalf("CountFailedAccountsByIPSince() error = %v", err)
+			}
+
+			if count != 2 { //nolint:mnd // accounts with invalid password
+				t.Fatalf("CountFailedAccountsByIPSince() = %d, want 2", count)
+			}
+		},
+	)
+}
+
+func TestLoginAttemptRepository_CountFailedAccountsByIPSinceCountsUnknownLogins(t *testing.T) {
+	forEachBackend(
+		t, func(t *testing.T, provider *Provider) {
+			ctx := context.Background()
+			now := clock.NewMockClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+			ip := "192.0.2.1"
+			reason := domain.LoginFailureInvalidPassword
+
+			account, err := provider.AccountRepository.Create(ctx, newTestAccount("alice", "digest-alice"))
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			attempts := []*domain.LoginAttempt{
+				domain.NewLoginAttempt(
+					domain.AccountID(account.ID()), &ip, domain.HardwareIDMatchNotChecked, &reason, nil, now,
+				),
+				// logins differing in case are the same login
+				domain.NewUnknownLoginAttempt("ghost", ip, now),
+				domain.NewUnknownLoginAttempt("Ghost", ip, now),
+				domain.NewUnknownLoginAttempt("nobody", ip, now),
+				domain.NewUnknownLoginAttempt("other-ip", "192.0.2.2", now),
+			}
+
+			if err := provider.LoginAttemptRepository.CreateBulk(ctx, attempts); err != nil {
+				t.Fatalf("CreateBulk() error = %v", err)
+			}
+
+			count, err := provider.LoginAttemptRepository.CountFailedAccountsByIPSince(
+				ctx, ip, now.Now().Add(-time.Minute),
+			)
+			if err != nil {
+				t.Fatalf("CountFailedAccountsByIPSince() error = %v", err)
+			}
+
+			if count != 3 { //nolint:mnd // alice, ghost and nobody
+				t.Fatalf("CountFailedAccountsByIPSince() = %d, want 3", count)
+			}
+
+			history, err := provider.LoginAttemptRepository.FindAllByAccountID(
+				ctx, domain.AccountID(account.ID()), nil, 10, //nolint:mnd
+			)
+			if err != nil {
+				t.Fatalf("FindAllByAccountID() error = %v", err)
+			}
+
+			if len(history) != 1 {
+				t.Fatalf("FindAllByAccountID() returned %d attempts, want 1", len(history))
+			}
+		},
+	)
+}
+
+func TestLoginAttemptRepository_DeleteAllCreatedBeforePrunesUnknownLogins(t *testing.T) {
+	forEachBackend(
+		t, func(t *testing.T, provider *Provider) {
+			ctx := context.Background()
+			start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+			old := clock.NewMockClock(start)
+			recent := clock.NewMockClock(start.Add(time.Hour))
+			ip := "192.0.2.1"
+			reason := domain.LoginFailureInvalidPassword
+
+			account, err := provider.AccountRepository.Create(ctx, newTestAccount("alice", "digest-alice"))
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			attempts := []*domain.LoginAttempt{
+				domain.NewLoginAttempt(
+					domain.AccountID(account.ID()), &ip, domain.HardwareIDMatchNotChecked, &reason, nil, old,
+				),
+				domain.NewUnknownLoginAttempt("ghost", ip, old),
+				domain.NewUnknownLoginAttempt("nobody", ip, old),
+				domain.NewUnknownLoginAttempt("recent", ip, recent),
+			}
+
+			if err := provider.LoginAttemptRepository.CreateBulk(ctx, attempts); err != nil {
+				t.Fatalf("CreateBulk() error = %v", err)
+			}
+
+			before := start.Add(time.Minute)
+
+			for _, want := range []int{2, 1, 0} {
+				deleted, err := provider.LoginAttemptRepository.DeleteAllCreatedBefore(ctx, before, 2) //nolint:mnd
+				if err != nil {
+					t.Fatalf("DeleteAllCreatedBefore() error = %v", err)
+				}
+
+				if deleted != want {
+					t.Fatalf("DeleteAllCreatedBefore() = %d, want %d", deleted, want)
+				}
+			}
+
+			count, err := provider.LoginAttemptRepository.CountFailedAccountsByIPSince(ctx, ip, start)
+			if err != nil {
+				t.Fatalf("CountFailedAccountsByIPSince() error = %v", err)
+			}
+
+			if count != 1 {
+				t.Fatalf("CountFailedAccountsByIPSince() = %d, want 1 (recent unknown login)", count)
+			}
+		},
+	)
+}
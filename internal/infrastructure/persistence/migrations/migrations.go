@@ -0,0 +1,8 @@
+// Package migrations embeds versioned SQL migrations generated from dbschema (see dbschema/migrate).
+// Every supported dialect has its own directory. Files follow golang-migrate format: <version>_<name>.up.sql / <version>_<name>.down.sql.
+package migrations
+
+import "embed"
+
+//go:embed postgres/*.sql mysql/*.sql sqlite3/*.sql
+var FS embed.FS
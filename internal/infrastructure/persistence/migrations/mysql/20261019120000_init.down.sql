@@ -0,0 +1,4 @@
+-- reverse: create "devices" table
+DROP TABLE `devices`;
+-- reverse: create "accounts" table
+DROP TABLE `accounts`;
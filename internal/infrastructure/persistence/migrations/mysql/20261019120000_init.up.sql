@@ -0,0 +1,4 @@
+-- create "accounts" table
+CREATE TABLE `accounts` (`id` bigint NOT NULL AUTO_INCREMENT, `username` varchar(255) NOT NULL, `password` varchar(255) NOT NULL, `hardware_id` varchar(255) NULL, `hardware_id_digest` varchar(255) NULL, `access_level` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, `banned_until` timestamp NULL, `ban_reason` varchar(255) NULL, PRIMARY KEY (`id`), UNIQUE INDEX `hardware_id_digest` (`hardware_id_digest`), UNIQUE INDEX `username` (`username`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "devices" table
+CREATE TABLE `devices` (`id` bigint NOT NULL AUTO_INCREMENT, `hardware_id` varchar(255) NOT NULL, `hardware_id_digest` varchar(255) NOT NULL, `status` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, `approved_at` timestamp NULL, `last_seen_at` timestamp NULL, `account_id` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `devices_accounts_devices` (`account_id`), UNIQUE INDEX `hardware_id_digest` (`hardware_id_digest`), CONSTRAINT `devices_accounts_devices` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- reverse: modify "accounts" table
+ALTER TABLE `accounts` ADD UNIQUE INDEX `username` (`username`), DROP COLUMN `username_canonical`;
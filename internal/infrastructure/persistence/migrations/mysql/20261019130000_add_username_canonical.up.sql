@@ -0,0 +1,2 @@
+-- modify "accounts" table, username_canonical is filled by Go migration of the next version
+ALTER TABLE `accounts` ADD COLUMN `username_canonical` varchar(255) NULL, DROP INDEX `username`;
@@ -0,0 +1,2 @@
+-- reverse: modify "accounts" table
+ALTER TABLE `accounts` DROP INDEX `username_canonical`, MODIFY COLUMN `username_canonical` varchar(255) NULL;
@@ -0,0 +1,2 @@
+-- modify "accounts" table
+ALTER TABLE `accounts` MODIFY COLUMN `username_canonical` varchar(255) NOT NULL, ADD UNIQUE INDEX `username_canonical` (`username_canonical`);
@@ -0,0 +1,2 @@
+-- modify "accounts" table
+ALTER TABLE `accounts` ADD COLUMN `version` bigint NOT NULL DEFAULT 0;
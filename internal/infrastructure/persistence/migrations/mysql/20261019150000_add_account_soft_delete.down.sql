@@ -0,0 +1,4 @@
+-- erased accounts get placeholder username and empty password to satisfy NOT NULL
+UPDATE `accounts` SET `username` = CONCAT('erased-', `id`), `username_canonical` = CONCAT('erased-', `id`), `password` = '' WHERE `erased_at` IS NOT NULL;
+-- reverse: modify "accounts" table
+ALTER TABLE `accounts` DROP INDEX `account_deleted_at`, DROP COLUMN `erased_at`, DROP COLUMN `deleted_at`, MODIFY COLUMN `password` varchar(255) NOT NULL, MODIFY COLUMN `username_canonical` varchar(255) NOT NULL, MODIFY COLUMN `username` varchar(255) NOT NULL;
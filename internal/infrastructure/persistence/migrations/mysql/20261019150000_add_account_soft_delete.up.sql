@@ -0,0 +1,2 @@
+-- modify "accounts" table
+ALTER TABLE `accounts` MODIFY COLUMN `username` varchar(255) NULL, MODIFY COLUMN `username_canonical` varchar(255) NULL, MODIFY COLUMN `password` varchar(255) NULL, ADD COLUMN `deleted_at` timestamp NULL, ADD COLUMN `erased_at` timestamp NULL, ADD INDEX `account_deleted_at` (`deleted_at`);
@@ -0,0 +1,2 @@
+-- create "audit_entries" table
+CREATE TABLE `audit_entries` (`id` bigint NOT NULL AUTO_INCREMENT, `action` varchar(255) NOT NULL, `actor` varchar(255) NOT NULL, `details` json NULL, `created_at` timestamp NOT NULL, `account_id` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `auditentry_account_id_created_at` (`account_id`, `created_at`), CONSTRAINT `audit_entries_accounts_audit_entries` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- reverse: modify "accounts" table
+ALTER TABLE `accounts` DROP INDEX `account_username_canonical`, DROP INDEX `account_banned_until`, DROP INDEX `account_access_level_id`, DROP INDEX `account_created_at_id`;
@@ -0,0 +1,2 @@
+-- modify "accounts" table
+ALTER TABLE `accounts` ADD INDEX `account_created_at_id` (`created_at`, `id`), ADD INDEX `account_access_level_id` (`access_level`, `id`), ADD INDEX `account_banned_until` (`banned_until`), ADD INDEX `account_username_canonical` (`username_canonical`);
@@ -0,0 +1,4 @@
+-- modify "accounts" table
+ALTER TABLE `accounts` ADD COLUMN `username_changed_at` timestamp NULL;
+-- create "username_changes" table
+CREATE TABLE `username_changes` (`id` bigint NOT NULL AUTO_INCREMENT, `username` varchar(255) NOT NULL, `username_canonical` varchar(255) NOT NULL, `changed_at` timestamp NOT NULL, `reserved_until` timestamp NOT NULL, `account_id` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `usernamechange_username_canonical_changed_at` (`username_canonical`, `changed_at`), INDEX `usernamechange_account_id_changed_at` (`account_id`, `changed_at`), CONSTRAINT `username_changes_accounts_username_changes` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
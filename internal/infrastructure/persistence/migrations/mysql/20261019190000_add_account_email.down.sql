@@ -0,0 +1,4 @@
+-- reverse: create "email_verifications" table
+DROP TABLE `email_verifications`;
+-- reverse: modify "accounts" table
+ALTER TABLE `accounts` DROP INDEX `email_canonical`, DROP COLUMN `email_verified_at`, DROP COLUMN `email_canonical`, DROP COLUMN `email`;
@@ -0,0 +1,4 @@
+-- modify "accounts" table
+ALTER TABLE `accounts` ADD COLUMN `email` varchar(255) NULL, ADD COLUMN `email_canonical` varchar(255) NULL, ADD COLUMN `email_verified_at` timestamp NULL, ADD UNIQUE INDEX `email_canonical` (`email_canonical`);
+-- create "email_verifications" table
+CREATE TABLE `email_verifications` (`id` bigint NOT NULL AUTO_INCREMENT, `email` varchar(255) NOT NULL, `code_digest` varchar(255) NOT NULL, `attempts` bigint NOT NULL DEFAULT 0, `expires_at` timestamp NOT NULL, `created_at` timestamp NOT NULL, `account_id` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `emailverification_account_id_created_at` (`account_id`, `created_at`), CONSTRAINT `email_verifications_accounts_email_verifications` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,4 @@
+-- modify "accounts" table
+ALTER TABLE `accounts` ADD COLUMN `last_login_at` timestamp NULL;
+-- create "login_attempts" table
+CREATE TABLE `login_attempts` (`id` bigint NOT NULL AUTO_INCREMENT, `ip` varchar(255) NULL, `hardware_id_match` varchar(255) NOT NULL, `success` bool NOT NULL, `failure_reason` varchar(255) NULL, `created_at` timestamp NOT NULL, `account_id` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `loginattempt_account_id_created_at_id` (`account_id`, `created_at`, `id`), INDEX `loginattempt_created_at` (`created_at`), CONSTRAINT `login_attempts_accounts_login_attempts` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- reverse: modify "login_attempts" table
+ALTER TABLE `login_attempts` DROP INDEX `loginattempt_ip_created_at`, DROP COLUMN `risk_signals`, DROP COLUMN `risk_decision`, DROP COLUMN `risk_score`, DROP COLUMN `longitude`, DROP COLUMN `latitude`, DROP COLUMN `country`, DROP COLUMN `asn`;
@@ -0,0 +1,2 @@
+-- modify "login_attempts" table
+ALTER TABLE `login_attempts` ADD COLUMN `asn` bigint unsigned NULL, ADD COLUMN `country` varchar(255) NULL, ADD COLUMN `latitude` double NULL, ADD COLUMN `longitude` double NULL, ADD COLUMN `risk_score` bigint NULL, ADD COLUMN `risk_decision` varchar(255) NULL, ADD COLUMN `risk_signals` json NULL, ADD INDEX `loginattempt_ip_created_at` (`ip`, `created_at`);
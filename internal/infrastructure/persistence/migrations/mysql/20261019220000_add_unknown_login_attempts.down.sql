@@ -0,0 +1,2 @@
+-- reverse: create "unknown_login_attempts" table
+DROP TABLE `unknown_login_attempts`;
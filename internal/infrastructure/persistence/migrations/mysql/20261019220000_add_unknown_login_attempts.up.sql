@@ -0,0 +1,2 @@
+-- create "unknown_login_attempts" table
+CREATE TABLE `unknown_login_attempts` (`id` bigint NOT NULL AUTO_INCREMENT, `ip` varchar(255) NOT NULL, `login_digest` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `unknownloginattempt_created_at` (`created_at`), INDEX `unknownloginattempt_ip_created_at` (`ip`, `created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
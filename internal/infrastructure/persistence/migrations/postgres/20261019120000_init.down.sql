@@ -0,0 +1,10 @@
+-- reverse: create index "devices_hardware_id_digest_key" to table: "devices"
+DROP INDEX "devices_hardware_id_digest_key";
+-- reverse: create "devices" table
+DROP TABLE "devices";
+-- reverse: create index "accounts_username_key" to table: "accounts"
+DROP INDEX "accounts_username_key";
+-- reverse: create index "accounts_hardware_id_digest_key" to table: "accounts"
+DROP INDEX "accounts_hardware_id_digest_key";
+-- reverse: create "accounts" table
+DROP TABLE "accounts";
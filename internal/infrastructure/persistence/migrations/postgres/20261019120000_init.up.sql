@@ -0,0 +1,10 @@
+-- create "accounts" table
+CREATE TABLE "accounts" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "username" character varying NOT NULL, "password" character varying NOT NULL, "hardware_id" character varying NULL, "hardware_id_digest" character varying NULL, "access_level" character varying NOT NULL, "created_at" timestamptz NOT NULL, "banned_until" timestamptz NULL, "ban_reason" character varying NULL, PRIMARY KEY ("id"));
+-- create index "accounts_hardware_id_digest_key" to table: "accounts"
+CREATE UNIQUE INDEX "accounts_hardware_id_digest_key" ON "accounts" ("hardware_id_digest");
+-- create index "accounts_username_key" to table: "accounts"
+CREATE UNIQUE INDEX "accounts_username_key" ON "accounts" ("username");
+-- create "devices" table
+CREATE TABLE "devices" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "hardware_id" character varying NOT NULL, "hardware_id_digest" character varying NOT NULL, "status" character varying NOT NULL, "created_at" timestamptz NOT NULL, "approved_at" timestamptz NULL, "last_seen_at" timestamptz NULL, "account_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "devices_accounts_devices" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "devices_hardware_id_digest_key" to table: "devices"
+CREATE UNIQUE INDEX "devices_hardware_id_digest_key" ON "devices" ("hardware_id_digest");
@@ -0,0 +1,4 @@
+-- reverse: drop index "accounts_username_key" from table: "accounts"
+CREATE UNIQUE INDEX "accounts_username_key" ON "accounts" ("username");
+-- reverse: modify "accounts" table
+ALTER TABLE "accounts" DROP COLUMN "username_canonical";
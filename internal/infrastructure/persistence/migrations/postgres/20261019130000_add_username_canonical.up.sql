@@ -0,0 +1,4 @@
+-- modify "accounts" table, username_canonical is filled by Go migration of the next version
+ALTER TABLE "accounts" ADD COLUMN "username_canonical" character varying NULL;
+-- drop index "accounts_username_key" from table: "accounts"
+DROP INDEX "accounts_username_key";
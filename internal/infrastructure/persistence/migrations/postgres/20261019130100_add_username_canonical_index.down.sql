@@ -0,0 +1,4 @@
+-- reverse: create index "accounts_username_canonical_key" to table: "accounts"
+DROP INDEX "accounts_username_canonical_key";
+-- reverse: modify "accounts" table
+ALTER TABLE "accounts" ALTER COLUMN "username_canonical" DROP NOT NULL;
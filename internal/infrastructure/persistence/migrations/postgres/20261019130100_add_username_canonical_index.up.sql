@@ -0,0 +1,4 @@
+-- modify "accounts" table
+ALTER TABLE "accounts" ALTER COLUMN "username_canonical" SET NOT NULL;
+-- create index "accounts_username_canonical_key" to table: "accounts"
+CREATE UNIQUE INDEX "accounts_username_canonical_key" ON "accounts" ("username_canonical");
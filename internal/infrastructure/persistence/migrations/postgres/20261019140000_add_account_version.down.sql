@@ -0,0 +1,2 @@
+-- reverse: modify "accounts" table
+ALTER TABLE "accounts" DROP COLUMN "version";
@@ -0,0 +1,6 @@
+-- reverse: create index "account_deleted_at" to table: "accounts"
+DROP INDEX "account_deleted_at";
+-- erased accounts get placeholder username and empty password to satisfy NOT NULL
+UPDATE "accounts" SET "username" = 'erased-' || "id", "username_canonical" = 'erased-' || "id", "password" = '' WHERE "erased_at" IS NOT NULL;
+-- reverse: modify "accounts" table
+ALTER TABLE "accounts" DROP COLUMN "erased_at", DROP COLUMN "deleted_at", ALTER COLUMN "password" SET NOT NULL, ALTER COLUMN "username_canonical" SET NOT NULL, ALTER COLUMN "username" SET NOT NULL;
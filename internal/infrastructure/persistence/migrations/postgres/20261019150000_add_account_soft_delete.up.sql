@@ -0,0 +1,4 @@
+-- modify "accounts" table
+ALTER TABLE "accounts" ALTER COLUMN "username" DROP NOT NULL, ALTER COLUMN "username_canonical" DROP NOT NULL, ALTER COLUMN "password" DROP NOT NULL, ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "erased_at" timestamptz NULL;
+-- create index "account_deleted_at" to table: "accounts"
+CREATE INDEX "account_deleted_at" ON "accounts" ("deleted_at");
@@ -0,0 +1,2 @@
+-- reverse: create "audit_entries" table
+DROP TABLE "audit_entries";
@@ -0,0 +1,4 @@
+-- create "audit_entries" table
+CREATE TABLE "audit_entries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "action" character varying NOT NULL, "actor" character varying NOT NULL, "details" jsonb NULL, "created_at" timestamptz NOT NULL, "account_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "audit_entries_accounts_audit_entries" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "auditentry_account_id_created_at" to table: "audit_entries"
+CREATE INDEX "auditentry_account_id_created_at" ON "audit_entries" ("account_id", "created_at");
@@ -0,0 +1,8 @@
+-- reverse: create index "account_username_canonical" to table: "accounts"
+DROP INDEX "account_username_canonical";
+-- reverse: create index "account_banned_until" to table: "accounts"
+DROP INDEX "account_banned_until";
+-- reverse: create index "account_access_level_id" to table: "accounts"
+DROP INDEX "account_access_level_id";
+-- reverse: create index "account_created_at_id" to table: "accounts"
+DROP INDEX "account_created_at_id";
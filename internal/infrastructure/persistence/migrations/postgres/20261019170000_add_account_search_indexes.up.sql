@@ -0,0 +1,8 @@
+-- create index "account_created_at_id" to table: "accounts"
+CREATE INDEX "account_created_at_id" ON "accounts" ("created_at", "id");
+-- create index "account_access_level_id" to table: "accounts"
+CREATE INDEX "account_access_level_id" ON "accounts" ("access_level", "id");
+-- create index "account_banned_until" to table: "accounts"
+CREATE INDEX "account_banned_until" ON "accounts" ("banned_until");
+-- create index "account_username_canonical" to table: "accounts"
+CREATE INDEX "account_username_canonical" ON "accounts" ("username_canonical" varchar_pattern_ops);
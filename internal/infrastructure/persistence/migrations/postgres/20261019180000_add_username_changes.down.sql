@@ -0,0 +1,4 @@
+-- reverse: create "username_changes" table
+DROP TABLE "username_changes";
+-- reverse: modify "accounts" table
+ALTER TABLE "accounts" DROP COLUMN "username_changed_at";
@@ -0,0 +1,8 @@
+-- modify "accounts" table
+ALTER TABLE "accounts" ADD COLUMN "username_changed_at" timestamptz NULL;
+-- create "username_changes" table
+CREATE TABLE "username_changes" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "username" character varying NOT NULL, "username_canonical" character varying NOT NULL, "changed_at" timestamptz NOT NULL, "reserved_until" timestamptz NOT NULL, "account_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "username_changes_accounts_username_changes" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "usernamechange_username_canonical_changed_at" to table: "username_changes"
+CREATE INDEX "usernamechange_username_canonical_changed_at" ON "username_changes" ("username_canonical", "changed_at");
+-- create index "usernamechange_account_id_changed_at" to table: "username_changes"
+CREATE INDEX "usernamechange_account_id_changed_at" ON "username_changes" ("account_id", "changed_at");
@@ -0,0 +1,6 @@
+-- reverse: create "email_verifications" table
+DROP TABLE "email_verifications";
+-- reverse: create index "accounts_email_canonical_key" to table: "accounts"
+DROP INDEX "accounts_email_canonical_key";
+-- reverse: modify "accounts" table
+ALTER TABLE "accounts" DROP COLUMN "email_verified_at", DROP COLUMN "email_canonical", DROP COLUMN "email";
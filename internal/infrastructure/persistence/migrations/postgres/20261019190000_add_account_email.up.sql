@@ -0,0 +1,8 @@
+-- modify "accounts" table
+ALTER TABLE "accounts" ADD COLUMN "email" character varying NULL, ADD COLUMN "email_canonical" character varying NULL, ADD COLUMN "email_verified_at" timestamptz NULL;
+-- create index "accounts_email_canonical_key" to table: "accounts"
+CREATE UNIQUE INDEX "accounts_email_canonical_key" ON "accounts" ("email_canonical");
+-- create "email_verifications" table
+CREATE TABLE "email_verifications" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "email" character varying NOT NULL, "code_digest" character varying NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "expires_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "account_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "email_verifications_accounts_email_verifications" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "emailverification_account_id_created_at" to table: "email_verifications"
+CREATE INDEX "emailverification_account_id_created_at" ON "email_verifications" ("account_id", "created_at");
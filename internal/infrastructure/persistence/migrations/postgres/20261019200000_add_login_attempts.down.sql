@@ -0,0 +1,4 @@
+-- reverse: create "login_attempts" table
+DROP TABLE "login_attempts";
+-- reverse: modify "accounts" table
+ALTER TABLE "accounts" DROP COLUMN "last_login_at";
@@ -0,0 +1,8 @@
+-- modify "accounts" table
+ALTER TABLE "accounts" ADD COLUMN "last_login_at" timestamptz NULL;
+-- create "login_attempts" table
+CREATE TABLE "login_attempts" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "ip" character varying NULL, "hardware_id_match" character varying NOT NULL, "success" boolean NOT NULL, "failure_reason" character varying NULL, "created_at" timestamptz NOT NULL, "account_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "login_attempts_accounts_login_attempts" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "loginattempt_account_id_created_at_id" to table: "login_attempts"
+CREATE INDEX "loginattempt_account_id_created_at_id" ON "login_attempts" ("account_id", "created_at", "id");
+-- create index "loginattempt_created_at" to table: "login_attempts"
+CREATE INDEX "loginattempt_created_at" ON "login_attempts" ("created_at");
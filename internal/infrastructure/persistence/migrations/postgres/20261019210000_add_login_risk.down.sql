@@ -0,0 +1,4 @@
+-- reverse: create index "loginattempt_ip_created_at" to table: "login_attempts"
+DROP INDEX "loginattempt_ip_created_at";
+-- reverse: modify "login_attempts" table
+ALTER TABLE "login_attempts" DROP COLUMN "risk_signals", DROP COLUMN "risk_decision", DROP COLUMN "risk_score", DROP COLUMN "longitude", DROP COLUMN "latitude", DROP COLUMN "country", DROP COLUMN "asn";
@@ -0,0 +1,4 @@
+-- modify "login_attempts" table
+ALTER TABLE "login_attempts" ADD COLUMN "asn" bigint NULL, ADD COLUMN "country" character varying NULL, ADD COLUMN "latitude" double precision NULL, ADD COLUMN "longitude" double precision NULL, ADD COLUMN "risk_score" bigint NULL, ADD COLUMN "risk_decision" character varying NULL, ADD COLUMN "risk_signals" jsonb NULL;
+-- create index "loginattempt_ip_created_at" to table: "login_attempts"
+CREATE INDEX "loginattempt_ip_created_at" ON "login_attempts" ("ip", "created_at");
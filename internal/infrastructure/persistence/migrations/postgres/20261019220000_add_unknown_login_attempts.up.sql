@@ -0,0 +1,6 @@
+-- create "unknown_login_attempts" table
+CREATE TABLE "unknown_login_attempts" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "ip" character varying NOT NULL, "login_digest" character varying NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "unknownloginattempt_created_at" to table: "unknown_login_attempts"
+CREATE INDEX "unknownloginattempt_created_at" ON "unknown_login_attempts" ("created_at");
+-- create index "unknownloginattempt_ip_created_at" to table: "unknown_login_attempts"
+CREATE INDEX "unknownloginattempt_ip_created_at" ON "unknown_login_attempts" ("ip", "created_at");
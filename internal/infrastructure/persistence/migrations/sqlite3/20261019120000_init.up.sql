@@ -0,0 +1,10 @@
+-- create "accounts" table
+CREATE TABLE `accounts` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `username` text NOT NULL, `password` text NOT NULL, `hardware_id` text NULL, `hardware_id_digest` text NULL, `access_level` text NOT NULL, `created_at` datetime NOT NULL, `banned_until` datetime NULL, `ban_reason` text NULL);
+-- create index "accounts_hardware_id_digest_key" to table: "accounts"
+CREATE UNIQUE INDEX `accounts_hardware_id_digest_key` ON `accounts` (`hardware_id_digest`);
+-- create index "accounts_username_key" to table: "accounts"
+CREATE UNIQUE INDEX `accounts_username_key` ON `accounts` (`username`);
+-- create "devices" table
+CREATE TABLE `devices` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `hardware_id` text NOT NULL, `hardware_id_digest` text NOT NULL, `status` text NOT NULL, `created_at` datetime NOT NULL, `approved_at` datetime NULL, `last_seen_at` datetime NULL, `account_id` integer NOT NULL, CONSTRAINT `devices_accounts_devices` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "devices_hardware_id_digest_key" to table: "devices"
+CREATE UNIQUE INDEX `devices_hardware_id_digest_key` ON `devices` (`hardware_id_digest`);
@@ -0,0 +1,5 @@
+-- modify "accounts" table, sqlite requires default for NOT NULL column added to existing table,
+-- username_canonical is filled by Go migration of the next version
+ALTER TABLE `accounts` ADD COLUMN `username_canonical` text NOT NULL DEFAULT '';
+-- drop index "accounts_username_key" from table: "accounts"
+DROP INDEX `accounts_username_key`;
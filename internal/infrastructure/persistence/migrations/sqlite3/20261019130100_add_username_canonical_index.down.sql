@@ -0,0 +1,2 @@
+-- reverse: create index "accounts_username_canonical_key" to table: "accounts"
+DROP INDEX `accounts_username_canonical_key`;
@@ -0,0 +1,2 @@
+-- create index "accounts_username_canonical_key" to table: "accounts"
+CREATE UNIQUE INDEX `accounts_username_canonical_key` ON `accounts` (`username_canonical`);
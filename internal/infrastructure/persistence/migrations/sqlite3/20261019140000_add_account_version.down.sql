@@ -0,0 +1,2 @@
+-- reverse: add column "version" to table: "accounts"
+ALTER TABLE `accounts` DROP COLUMN `version`;
@@ -0,0 +1,2 @@
+-- add column "version" to table: "accounts"
+ALTER TABLE `accounts` ADD COLUMN `version` integer NOT NULL DEFAULT (0);
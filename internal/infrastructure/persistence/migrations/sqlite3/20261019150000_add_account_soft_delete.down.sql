@@ -0,0 +1,14 @@
+-- erased accounts get placeholder username and empty password to satisfy NOT NULL
+UPDATE `accounts` SET `username` = 'erased-' || `id`, `username_canonical` = 'erased-' || `id`, `password` = '' WHERE `erased_at` IS NOT NULL;
+-- reverse: rebuild "accounts" table
+CREATE TABLE `new_accounts` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `username` text NOT NULL, `password` text NOT NULL, `hardware_id` text NULL, `hardware_id_digest` text NULL, `access_level` text NOT NULL, `created_at` datetime NOT NULL, `banned_until` datetime NULL, `ban_reason` text NULL, `username_canonical` text NOT NULL DEFAULT '', `version` integer NOT NULL DEFAULT (0));
+INSERT INTO `new_accounts` (`id`, `username`, `password`, `hardware_id`, `hardware_id_digest`, `access_level`, `created_at`, `banned_until`, `ban_reason`, `username_canonical`, `version`) SELECT `id`, `username`, `password`, `hardware_id`, `hardware_id_digest`, `access_level`, `created_at`, `banned_until`, `ban_reason`, `username_canonical`, `version` FROM `accounts`;
+CREATE TABLE `new_devices` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `hardware_id` text NOT NULL, `hardware_id_digest` text NOT NULL, `status` text NOT NULL, `created_at` datetime NOT NULL, `approved_at` datetime NULL, `last_seen_at` datetime NULL, `account_id` integer NOT NULL, CONSTRAINT `devices_accounts_devices` FOREIGN KEY (`account_id`) REFERENCES `new_accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+INSERT INTO `new_devices` (`id`, `hardware_id`, `hardware_id_digest`, `status`, `created_at`, `approved_at`, `last_seen_at`, `account_id`) SELECT `id`, `hardware_id`, `hardware_id_digest`, `status`, `created_at`, `approved_at`, `last_seen_at`, `account_id` FROM `devices`;
+DROP TABLE `devices`;
+DROP TABLE `accounts`;
+ALTER TABLE `new_accounts` RENAME TO `accounts`;
+ALTER TABLE `new_devices` RENAME TO `devices`;
+CREATE UNIQUE INDEX `accounts_hardware_id_digest_key` ON `accounts` (`hardware_id_digest`);
+CREATE UNIQUE INDEX `accounts_username_canonical_key` ON `accounts` (`username_canonical`);
+CREATE UNIQUE INDEX `devices_hardware_id_digest_key` ON `devices` (`hardware_id_digest`);
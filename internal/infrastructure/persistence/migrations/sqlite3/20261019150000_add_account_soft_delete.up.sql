@@ -0,0 +1,24 @@
+-- sqlite can't drop NOT NULL, so "accounts" is rebuilt. "devices" is rebuilt first to reference the new table,
+-- otherwise dropping old "accounts" would cascade delete devices.
+-- create "new_accounts" table
+CREATE TABLE `new_accounts` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `username` text NULL, `password` text NULL, `hardware_id` text NULL, `hardware_id_digest` text NULL, `access_level` text NOT NULL, `created_at` datetime NOT NULL, `banned_until` datetime NULL, `ban_reason` text NULL, `username_canonical` text NULL, `version` integer NOT NULL DEFAULT (0), `deleted_at` datetime NULL, `erased_at` datetime NULL);
+-- copy rows from old table "accounts" to new temporary table "new_accounts"
+INSERT INTO `new_accounts` (`id`, `username`, `password`, `hardware_id`, `hardware_id_digest`, `access_level`, `created_at`, `banned_until`, `ban_reason`, `username_canonical`, `version`) SELECT `id`, `username`, `password`, `hardware_id`, `hardware_id_digest`, `access_level`, `created_at`, `banned_until`, `ban_reason`, `username_canonical`, `version` FROM `accounts`;
+-- create "new_devices" table
+CREATE TABLE `new_devices` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `hardware_id` text NOT NULL, `hardware_id_digest` text NOT NULL, `status` text NOT NULL, `created_at` datetime NOT NULL, `approved_at` datetime NULL, `last_seen_at` datetime NULL, `account_id` integer NOT NULL, CONSTRAINT `devices_accounts_devices` FOREIGN KEY (`account_id`) REFERENCES `new_accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- copy rows from old table "devices" to new temporary table "new_devices"
+INSERT INTO `new_devices` (`id`, `hardware_id`, `hardware_id_digest`, `status`, `created_at`, `approved_at`, `last_seen_at`, `account_id`) SELECT `id`, `hardware_id`, `hardware_id_digest`, `status`, `created_at`, `approved_at`, `last_seen_at`, `account_id` FROM `devices`;
+-- drop old tables
+DROP TABLE `devices`;
+DROP TABLE `accounts`;
+-- rename temporary tables, reference of "new_devices" is renamed as well
+ALTER TABLE `new_accounts` RENAME TO `accounts`;
+ALTER TABLE `new_devices` RENAME TO `devices`;
+-- create index "accounts_hardware_id_digest_key" to table: "accounts"
+CREATE UNIQUE INDEX `accounts_hardware_id_digest_key` ON `accounts` (`hardware_id_digest`);
+-- create index "accounts_username_canonical_key" to table: "accounts"
+CREATE UNIQUE INDEX `accounts_username_canonical_key` ON `accounts` (`username_canonical`);
+-- create index "account_deleted_at" to table: "accounts"
+CREATE INDEX `account_deleted_at` ON `accounts` (`deleted_at`);
+-- create index "devices_hardware_id_digest_key" to table: "devices"
+CREATE UNIQUE INDEX `devices_hardware_id_digest_key` ON `devices` (`hardware_id_digest`);
@@ -0,0 +1,4 @@
+-- create "audit_entries" table
+CREATE TABLE `audit_entries` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `action` text NOT NULL, `actor` text NOT NULL, `details` json NULL, `created_at` datetime NOT NULL, `account_id` integer NOT NULL, CONSTRAINT `audit_entries_accounts_audit_entries` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "auditentry_account_id_created_at" to table: "audit_entries"
+CREATE INDEX `auditentry_account_id_created_at` ON `audit_entries` (`account_id`, `created_at`);
@@ -0,0 +1,4 @@
+-- reverse: create "username_changes" table
+DROP TABLE `username_changes`;
+-- reverse: add column "username_changed_at" to table: "accounts"
+ALTER TABLE `accounts` DROP COLUMN `username_changed_at`;
@@ -0,0 +1,8 @@
+-- add column "username_changed_at" to table: "accounts"
+ALTER TABLE `accounts` ADD COLUMN `username_changed_at` datetime NULL;
+-- create "username_changes" table
+CREATE TABLE `username_changes` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `username` text NOT NULL, `username_canonical` text NOT NULL, `changed_at` datetime NOT NULL, `reserved_until` datetime NOT NULL, `account_id` integer NOT NULL, CONSTRAINT `username_changes_accounts_username_changes` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "usernamechange_username_canonical_changed_at" to table: "username_changes"
+CREATE INDEX `usernamechange_username_canonical_changed_at` ON `username_changes` (`username_canonical`, `changed_at`);
+-- create index "usernamechange_account_id_changed_at" to table: "username_changes"
+CREATE INDEX `usernamechange_account_id_changed_at` ON `username_changes` (`account_id`, `changed_at`);
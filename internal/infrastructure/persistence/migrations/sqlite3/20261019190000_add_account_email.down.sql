@@ -0,0 +1,10 @@
+-- reverse: create "email_verifications" table
+DROP TABLE `email_verifications`;
+-- reverse: create index "accounts_email_canonical_key" to table: "accounts"
+DROP INDEX `accounts_email_canonical_key`;
+-- reverse: add column "email_verified_at" to table: "accounts"
+ALTER TABLE `accounts` DROP COLUMN `email_verified_at`;
+-- reverse: add column "email_canonical" to table: "accounts"
+ALTER TABLE `accounts` DROP COLUMN `email_canonical`;
+-- reverse: add column "email" to table: "accounts"
+ALTER TABLE `accounts` DROP COLUMN `email`;
@@ -0,0 +1,12 @@
+-- add column "email" to table: "accounts"
+ALTER TABLE `accounts` ADD COLUMN `email` text NULL;
+-- add column "email_canonical" to table: "accounts"
+ALTER TABLE `accounts` ADD COLUMN `email_canonical` text NULL;
+-- add column "email_verified_at" to table: "accounts"
+ALTER TABLE `accounts` ADD COLUMN `email_verified_at` datetime NULL;
+-- create index "accounts_email_canonical_key" to table: "accounts"
+CREATE UNIQUE INDEX `accounts_email_canonical_key` ON `accounts` (`email_canonical`);
+-- create "email_verifications" table
+CREATE TABLE `email_verifications` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `email` text NOT NULL, `code_digest` text NOT NULL, `attempts` integer NOT NULL DEFAULT (0), `expires_at` datetime NOT NULL, `created_at` datetime NOT NULL, `account_id` integer NOT NULL, CONSTRAINT `email_verifications_accounts_email_verifications` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "emailverification_account_id_created_at" to table: "email_verifications"
+CREATE INDEX `emailverification_account_id_created_at` ON `email_verifications` (`account_id`, `created_at`);
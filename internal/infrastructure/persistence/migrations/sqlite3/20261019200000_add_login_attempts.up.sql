@@ -0,0 +1,8 @@
+-- add column "last_login_at" to table: "accounts"
+ALTER TABLE `accounts` ADD COLUMN `last_login_at` datetime NULL;
+-- create "login_attempts" table
+CREATE TABLE `login_attempts` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `ip` text NULL, `hardware_id_match` text NOT NULL, `success` bool NOT NULL, `failure_reason` text NULL, `created_at` datetime NOT NULL, `account_id` integer NOT NULL, CONSTRAINT `login_attempts_accounts_login_attempts` FOREIGN KEY (`account_id`) REFERENCES `accounts` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "loginattempt_account_id_created_at_id" to table: "login_attempts"
+CREATE INDEX `loginattempt_account_id_created_at_id` ON `login_attempts` (`account_id`, `created_at`, `id`);
+-- create index "loginattempt_created_at" to table: "login_attempts"
+CREATE INDEX `loginattempt_created_at` ON `login_attempts` (`created_at`);
@@ -0,0 +1,16 @@
+-- reverse: create index "loginattempt_ip_created_at" to table: "login_attempts"
+DROP INDEX `loginattempt_ip_created_at`;
+-- reverse: add column "risk_signals" to table: "login_attempts"
+ALTER TABLE `login_attempts` DROP COLUMN `risk_signals`;
+-- reverse: add column "risk_decision" to table: "login_attempts"
+ALTER TABLE `login_attempts` DROP COLUMN `risk_decision`;
+-- reverse: add column "risk_score" to table: "login_attempts"
+ALTER TABLE `login_attempts` DROP COLUMN `risk_score`;
+-- reverse: add column "longitude" to table: "login_attempts"
+ALTER TABLE `login_attempts` DROP COLUMN `longitude`;
+-- reverse: add column "latitude" to table: "login_attempts"
+ALTER TABLE `login_attempts` DROP COLUMN `latitude`;
+-- reverse: add column "country" to table: "login_attempts"
+ALTER TABLE `login_attempts` DROP COLUMN `country`;
+-- reverse: add column "asn" to table: "login_attempts"
+ALTER TABLE `login_attempts` DROP COLUMN `asn`;
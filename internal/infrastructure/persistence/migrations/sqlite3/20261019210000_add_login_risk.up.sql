@@ -0,0 +1,16 @@
+-- add column "asn" to table: "login_attempts"
+ALTER TABLE `login_attempts` ADD COLUMN `asn` integer NULL;
+-- add column "country" to table: "login_attempts"
+ALTER TABLE `login_attempts` ADD COLUMN `country` text NULL;
+-- add column "latitude" to table: "login_attempts"
+ALTER TABLE `login_attempts` ADD COLUMN `latitude` real NULL;
+-- add column "longitude" to table: "login_attempts"
+ALTER TABLE `login_attempts` ADD COLUMN `longitude` real NULL;
+-- add column "risk_score" to table: "login_attempts"
+ALTER TABLE `login_attempts` ADD COLUMN `risk_score` integer NULL;
+-- add column "risk_decision" to table: "login_attempts"
+ALTER TABLE `login_attempts` ADD COLUMN `risk_decision` text NULL;
+-- add column "risk_signals" to table: "login_attempts"
+ALTER TABLE `login_attempts` ADD COLUMN `risk_signals` json NULL;
+-- create index "loginattempt_ip_created_at" to table: "login_attempts"
+CREATE INDEX `loginattempt_ip_created_at` ON `login_attempts` (`ip`, `created_at`);
@@ -0,0 +1,6 @@
+-- create "unknown_login_attempts" table
+CREATE TABLE `unknown_login_attempts` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `ip` text NOT NULL, `login_digest` text NOT NULL, `created_at` datetime NOT NULL);
+-- create index "unknownloginattempt_created_at" to table: "unknown_login_attempts"
+CREATE INDEX `unknownloginattempt_created_at` ON `unknown_login_attempts` (`created_at`);
+-- create index "unknownloginattempt_ip_created_at" to table: "unknown_login_attempts"
+CREATE INDEX `unknownloginattempt_ip_created_at` ON `unknown_login_attempts` (`ip`, `created_at`);
@@ -0,0 +1,396 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/intezya/auth_service/internal/infrastructure/persistence/migrations"
+	"io"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const migrationsTable = "schema_migrations"
+
+var (
+	errDirtyDatabase    = errors.New("database is dirty, fix schema manually and run force")
+	errUnknownMigration = errors.New("unknown migration version")
+)
+
+type migration struct {
+	version uint64
+	name    string
+	up      string
+	down    string
+	// goUp runs before up statements, see goMigrations
+	goUp goMigration
+}
+
+// goMigration changes data which SQL of every dialect can't express (e.g. values computed by domain).
+// It runs in its own transaction, so it must not change schema.
+type goMigration func(ctx context.Context, tx *sql.Tx, driver string) error
+
+// goMigrations are run before SQL up migrations of the same version.
+var goMigrations = map[uint64]goMigration{
+	20261019130100: backfillUsernameCanonical,
+}
+
+// MigrationStatus describes state of database schema compared to migrations embedded into binary.
+type MigrationStatus struct {
+	Version uint64 // 0 = no migrations applied
+	Dirty   bool
+	Latest  uint64
+	Pending []string
+}
+
+func (s *MigrationStatus) IsUpToDate() bool {
+	return !s.Dirty && s.Version == s.Latest
+}
+
+// Migrator applies versioned migrations. Version is stored in golang-migrate compatible table.
+type Migrator struct {
+	db         *sql.DB
+	driver     string
+	migrations []migration
+}
+
+// NewMigrator loads embedded migrations of driver dialect.
+func NewMigrator(db *sql.DB, driver string) (*Migrator, error) {
+	loaded, err := loadMigrations(migrations.FS, driver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, driver: driver, migrations: loaded}, nil
+}
+
+func loadMigrations(fsys fs.FS, dir string) ([]migration, error) {
+	names, err := fs.Glob(fsys, dir+"/*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	loaded := make([]migration, 0, len(names))
+
+	for _, path := range names {
+		base := strings.TrimSuffix(path[len(dir)+1:], ".up.sql")
+
+		versionPart, name, _ := strings.Cut(base, "_")
+
+		version, err := strconv.ParseUint(versionPart, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %q: %w", path, err)
+		}
+
+		up, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return nil, err
+		}
+
+		down, err := fs.ReadFile(fsys, dir+"/"+base+".down.sql")
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		loaded = append(
+			loaded,
+			migration{version: version, name: name, up: string(up), down: string(down), goUp: goMigrations[version]},
+		)
+	}
+
+	sort.Slice(loaded, func(i, j int) bool { return loaded[i].version < loaded[j].version })
+
+	return loaded, nil
+}
+
+func (m *Migrator) Status(ctx context.Context) (*MigrationStatus, error) {
+	version, dirty, err := m.version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &MigrationStatus{Version: version, Dirty: dirty}
+
+	for _, migration := range m.migrations {
+		status.Latest = migration.version
+
+		if migration.version > version {
+			status.Pending = append(status.Pending, migration.fileName())
+		}
+	}
+
+	return status, nil
+}
+
+// Up applies all pending migrations. Each migration runs in its own transaction,
+// database stays dirty if migration fails.
+func (m *Migrator) Up(ctx context.Context) error {
+	pending, err := m.pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range pending {
+		if migration.goUp != nil {
+			// data is changed before version is marked dirty: failed transaction leaves database clean
+			if err := m.runGoMigration(ctx, migration.goUp); err != nil {
+				return fmt.Errorf("migration %s: %w", migration.fileName(), err)
+			}
+		}
+
+		if err := m.apply(ctx, migration.up, migration.version); err != nil {
+			return fmt.Errorf("migration %s: %w", migration.fileName(), err)
+		}
+	}
+
+	return nil
+}
+
+// Down reverts last steps applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	applied, err := m.applied(ctx, steps)
+	if err != nil {
+		return err
+	}
+
+	for i, migration := range applied {
+		previous := uint64(0)
+		if i+1 < len(applied) {
+			previous = applied[i+1].version
+		} else if index := m.indexOf(migration.version); index > 0 {
+			previous = m.migrations[index-1].version
+		}
+
+		if err := m.apply(ctx, migration.down, previous); err != nil {
+			return fmt.Errorf("migration %s: %w", migration.fileName(), err)
+		}
+	}
+
+	return nil
+}
+
+// Force sets clean schema version without running migrations
+// (e.g. for database created by auto-migration or fixed manually after failed migration).
+func (m *Migrator) Force(ctx context.Context, version uint64) error {
+	if version != 0 && m.indexOf(version) < 0 {
+		return fmt.Errorf("%w: %d", errUnknownMigration, version)
+	}
+
+	return m.writeVersion(ctx, version, false)
+}
+
+// DryRun writes SQL of migrations which Up (steps = 0) or Down (steps > 0) would execute.
+func (m *Migrator) DryRun(ctx context.Context, w io.Writer, down bool, steps int) error {
+	var (
+		planned []migration
+		err     error
+	)
+
+	if down {
+		planned, err = m.applied(ctx, steps)
+	} else {
+		planned, err = m.pending(ctx)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range planned {
+		statements := migration.up
+		if down {
+			statements = migration.down
+		}
+
+		if _, err := fmt.Fprintf(w, "-- %s\n", migration.fileName()); err != nil {
+			return err
+		}
+
+		if !down && migration.goUp != nil {
+			if _, err := fmt.Fprintln(w, "-- Go migration runs before the statements"); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintln(w, statements); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Migrator) pending(ctx context.Context) ([]migration, error) {
+	version, dirty, err := m.version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if dirty {
+		return nil, errDirtyDatabase
+	}
+
+	var pending []migration
+
+	for _, migration := range m.migrations {
+		if migration.version > version {
+			pending = append(pending, migration)
+		}
+	}
+
+	return pending, nil
+}
+
+// applied returns last steps applied migrations, newest first.
+func (m *Migrator) applied(ctx context.Context, steps int) ([]migration, error) {
+	version, dirty, err := m.version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if dirty {
+		return nil, errDirtyDatabase
+	}
+
+	if version == 0 {
+		return nil, nil
+	}
+
+	index := m.indexOf(version)
+	if index < 0 {
+		return nil, fmt.Errorf("%w: %d", errUnknownMigration, version)
+	}
+
+	var applied []migration
+
+	for i := index; i >= 0 && len(applied) < steps; i-- {
+		applied = append(applied, m.migrations[i])
+	}
+
+	return applied, nil
+}
+
+// apply marks target version dirty before running statements and clears the mark with them,
+// so migration interrupted halfway (MySQL DDL commits implicitly) leaves database dirty until Force.
+func (m *Migrator) apply(ctx context.Context, statements string, version uint64) error {
+	if err := m.writeVersion(ctx, version, true); err != nil {
+		return err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(statements) != "" {
+		if _, err := tx.ExecContext(ctx, statements); err != nil {
+			_ = tx.Rollback()
+
+			return err
+		}
+	}
+
+	if err := m.setVersion(ctx, tx, version, false); err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) runGoMigration(ctx context.Context, goUp goMigration) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := goUp(ctx, tx, m.driver); err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) writeVersion(ctx context.Context, version uint64, dirty bool) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := m.setVersion(ctx, tx, version, dirty); err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) version(ctx context.Context) (uint64, bool, error) {
+	if err := m.ensureVersionTable(ctx); err != nil {
+		return 0, false, err
+	}
+
+	var (
+		version int64
+		dirty   bool
+	)
+
+	err := m.db.QueryRowContext(ctx, "SELECT version, dirty FROM "+migrationsTable+" LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return uint64(version), dirty, nil
+}
+
+func (m *Migrator) setVersion(ctx context.Context, tx *sql.Tx, version uint64, dirty bool) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM "+migrationsTable); err != nil {
+		return err
+	}
+
+	if version == 0 && !dirty {
+		return nil
+	}
+
+	_, err := tx.ExecContext(
+		ctx,
+		rebind(m.driver, "INSERT INTO "+migrationsTable+" (version, dirty) VALUES (?, ?)"),
+		int64(version), dirty,
+	)
+
+	return err
+}
+
+func (m *Migrator) ensureVersionTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(
+		ctx,
+		"CREATE TABLE IF NOT EXISTS "+migrationsTable+" (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)",
+	)
+
+	return err
+}
+
+func (m *Migrator) indexOf(version uint64) int {
+	for i, migration := range m.migrations {
+		if migration.version == version {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (m migration) fileName() string {
+	return fmt.Sprintf("%d_%s", m.version, m.name)
+}
@@ -0,0 +1,130 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+// migrateTo resets schema and applies migrations up to version including it.
+func migrateTo(t *testing.T, driver string, dsn string, version uint64) (*sql.DB, *Migrator) {
+	t.Helper()
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		t.Fatalf("open %s: %v", driver, err)
+	}
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	migrator, err := NewMigrator(db, driver)
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := migrator.Down(ctx, math.MaxInt); err != nil {
+		t.Fatalf("reset schema: %v", err)
+	}
+
+	partial := &Migrator{db: db, driver: driver, migrations: migrator.migrations[:migrator.indexOf(version)+1]}
+	if err := partial.Up(ctx); err != nil {
+		t.Fatalf("migrate to %d: %v", version, err)
+	}
+
+	return db, migrator
+}
+
+func insertLegacyAccounts(t *testing.T, db *sql.DB, driver string, usernames ...string) {
+	t.Helper()
+
+	for _, username := range usernames {
+		_, err := db.Exec(
+			rebind(
+				driver,
+				"INSERT INTO accounts (username, password, access_level, created_at) VALUES (?, 'hash', 'user', CURRENT_TIMESTAMP)",
+			),
+			username,
+		)
+		if err != nil {
+			t.Fatalf("insert %q: %v", username, err)
+		}
+	}
+}
+
+func TestMigrator_UsernameCanonicalBackfill(t *testing.T) {
+	for driver, dsn := range testBackends(t) {
+		t.Run(
+			driver, func(t *testing.T) {
+				db, migrator := migrateTo(t, driver, dsn, 20261019130000)
+				insertLegacyAccounts(t, db, driver, "Straße", "ＡＬＩＣＥ")
+
+				if err := migrator.Up(context.Background()); err != nil {
+					t.Fatalf("Up() error = %v", err)
+				}
+
+				var canonical []string
+
+				rows, err := db.Query("SELECT username_canonical FROM accounts ORDER BY id")
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer rows.Close()
+
+				for rows.Next() {
+					var value string
+					if err := rows.Scan(&value); err != nil {
+						t.Fatal(err)
+					}
+
+					canonical = append(canonical, value)
+				}
+
+				if strings.Join(canonical, ",") != "strasse,alice" {
+					t.Fatalf("username_canonical = %v, want [strasse alice]", canonical)
+				}
+			},
+		)
+	}
+}
+
+func TestMigrator_UsernameCanonicalCollision(t *testing.T) {
+	for driver, dsn := range testBackends(t) {
+		t.Run(
+			driver, func(t *testing.T) {
+				db, migrator := migrateTo(t, driver, dsn, 20261019130000)
+				insertLegacyAccounts(t, db, driver, "Straße", "bob", "STRASSE")
+
+				err := migrator.Up(context.Background())
+				if !errors.Is(err, errUsernameCollision) {
+					t.Fatalf("Up() error = %v, want %v", err, errUsernameCollision)
+				}
+
+				if !strings.Contains(err.Error(), `"strasse": ids [1 3]`) {
+					t.Fatalf("Up() error = %q, want it to list colliding ids", err)
+				}
+
+				status, err := migrator.Status(context.Background())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				// nothing was applied, so the database can be migrated after renaming accounts
+				if status.Dirty || status.Version != 20261019130000 {
+					t.Fatalf("Status() = %+v, want clean version 20261019130000", status)
+				}
+
+				if _, err := db.Exec(rebind(driver, "UPDATE accounts SET username = ? WHERE id = ?"), "strasse2", 3); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := migrator.Up(context.Background()); err != nil {
+					t.Fatalf("Up() after rename error = %v", err)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,17 @@
+package persistence
+
+import (
+	"context"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+)
+
+// Ping checks that primary database accepts transactions, ent client doesn't expose its database handle
+// so a transaction is opened and rolled back.
+func Ping(ctx context.Context, client *ent.Client) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Rollback()
+}
@@ -6,9 +6,23 @@ import (
 )
 
 type Provider struct {
-	AccountRepository repository.AccountRepository
+	AccountRepository           repository.AccountRepository
+	DeviceRepository            repository.DeviceRepository
+	AuditRepository             repository.AuditRepository
+	UsernameChangeRepository    repository.UsernameChangeRepository
+	EmailVerificationRepository repository.EmailVerificationRepository
+	LoginAttemptRepository      repository.LoginAttemptRepository
+	TxManager                   repository.TxManager
 }
 
-func NewProvider(client *ent.Client) *Provider {
-	return &Provider{AccountRepository: NewAccountRepository(client)}
+func NewProvider(client *ent.Client, config EntConfig) *Provider {
+	return &Provider{
+		AccountRepository:           NewAccountRepository(client),
+		DeviceRepository:            NewDeviceRepository(client),
+		AuditRepository:             NewAuditRepository(client),
+		UsernameChangeRepository:    NewUsernameChangeRepository(client),
+		EmailVerificationRepository: NewEmailVerificationRepository(client),
+		LoginAttemptRepository:      NewLoginAttemptRepository(client),
+		TxManager:                   NewTxManager(client, config.TxMaxRetries),
+	}
 }
@@ -0,0 +1,293 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-sql-driver/mysql"
+	"github.com/intezya/auth_service/pkg/defaults"
+)
+
+const (
+	defaultReplicaCheckInterval = 5 * time.Second
+	replicaCheckTimeout         = 2 * time.Second
+)
+
+var errReplicationStopped = errors.New("replication is not running")
+
+type readOnlyKey struct{}
+
+type sessionKey struct{}
+
+// session tracks writes done while handling one request, see WithSession.
+type session struct {
+	wrote atomic.Bool
+}
+
+// WithSession starts request scope: once anything is written within ctx,
+// following reads are served by primary, so the request always reads its own writes.
+func WithSession(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sessionKey{}, &session{})
+}
+
+// readOnly marks repository query as allowed to be served by replica.
+func readOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+func markWritten(ctx context.Context) {
+	if s, ok := ctx.Value(sessionKey{}).(*session); ok {
+		s.wrote.Store(true)
+	}
+}
+
+func usesReplica(ctx context.Context) bool {
+	if readOnly, _ := ctx.Value(readOnlyKey{}).(bool); !readOnly {
+		return false
+	}
+
+	s, ok := ctx.Value(sessionKey{}).(*session)
+
+	return !ok || !s.wrote.Load()
+}
+
+type replica struct {
+	name    string
+	driver  *entsql.Driver
+	healthy atomic.Bool
+}
+
+// routingDriver sends read-only queries to healthy replicas in round-robin order, everything else goes to primary.
+// Replica is considered unhealthy if it doesn't respond or its replication lag exceeds maxLag.
+type routingDriver struct {
+	*entsql.Driver // primary
+
+	replicas []*replica
+	next     atomic.Uint64
+	maxLag   time.Duration
+	logger   Logger
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newRoutingDriver(primary *entsql.Driver, replicas []*replica, config EntConfig, logger Logger) *routingDriver {
+	driver := &routingDriver{
+		Driver:   primary,
+		replicas: replicas,
+		maxLag:   config.ReplicaMaxLag,
+		logger:   logger,
+		done:     make(chan struct{}),
+	}
+
+	// replicas stay unhealthy (reads go to primary) until the first check passes
+	go driver.checkReplicas(defaults.Positive(config.ReplicaCheckInterval, defaultReplicaCheckInterval))
+
+	return driver
+}
+
+// openReplicas opens handles of read replicas, connection itself is established lazily.
+func openReplicas(config EntConfig) ([]*replica, error) {
+	replicas := make([]*replica, 0, len(config.ReplicaDSNs))
+
+	for i, dsn := range config.ReplicaDSNs {
+		db, err := sql.Open(config.Driver, dsn)
+		if err != nil {
+			for _, opened := range replicas {
+				_ = opened.driver.Close()
+			}
+
+			return nil, fmt.Errorf("failed to open replica %d: %w", i, err)
+		}
+
+		configurePool(db, config)
+
+		replicas = append(replicas, &replica{
+			name:   "replica-" + strconv.Itoa(i),
+			driver: entsql.OpenDB(config.Driver, db),
+		})
+	}
+
+	return replicas, nil
+}
+
+func (d *routingDriver) Query(ctx context.Context, query string, args, v any) error {
+	if usesReplica(ctx) {
+		if replica := d.pickReplica(); replica != nil {
+			return replica.driver.Query(ctx, query, args, v)
+		}
+
+		return d.Driver.Query(ctx, query, args, v)
+	}
+
+	// postgres inserts and updates are queries with RETURNING clause
+	markWritten(ctx)
+
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *routingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	markWritten(ctx)
+
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *routingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	markWritten(ctx)
+
+	return d.Driver.Tx(ctx)
+}
+
+func (d *routingDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	markWritten(ctx)
+
+	return d.Driver.BeginTx(ctx, opts)
+}
+
+func (d *routingDriver) Close() error {
+	d.closeOnce.Do(func() { close(d.done) })
+
+	errs := make([]error, 0, len(d.replicas)+1)
+	for _, replica := range d.replicas {
+		errs = append(errs, replica.driver.Close())
+	}
+
+	errs = append(errs, d.Driver.Close())
+
+	return errors.Join(errs...)
+}
+
+func (d *routingDriver) pickReplica() *replica {
+	count := uint64(len(d.replicas))
+
+	for range count {
+		replica := d.replicas[(d.next.Add(1)-1)%count]
+		if replica.healthy.Load() {
+			return replica
+		}
+	}
+
+	return nil
+}
+
+func (d *routingDriver) checkReplicas(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, replica := range d.replicas {
+			d.checkReplica(replica)
+		}
+
+		select {
+		case <-d.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *routingDriver) checkReplica(replica *replica) {
+	ctx, cancel := context.WithTimeout(context.Background(), replicaCheckTimeout)
+	defer cancel()
+
+	lag, err := replicationLag(ctx, replica.driver)
+
+	switch {
+	case err != nil:
+		if replica.healthy.Swap(false) {
+			d.logger.Warnf("Read %s is unavailable, reads are routed to primary: %v", replica.name, err)
+		}
+	case d.maxLag > 0 && lag > d.maxLag:
+		if replica.healthy.Swap(false) {
+			d.logger.Warnf("Read %s lags behind primary by %s, reads are routed to primary", replica.name, lag)
+		}
+	default:
+		if !replica.healthy.Swap(true) {
+			d.logger.Infof("Read %s is healthy (replication lag %s)", replica.name, lag)
+		}
+	}
+}
+
+// replicationLag returns how far replica is behind primary.
+func replicationLag(ctx context.Context, driver *entsql.Driver) (time.Duration, error) {
+	db := driver.DB()
+
+	switch driver.Dialect() {
+	case dialect.Postgres:
+		var seconds float64
+
+		// replica that replayed everything it received is not lagging even if primary is idle
+		err := db.QueryRowContext(ctx, `SELECT CASE
+			WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+		END`).Scan(&seconds)
+
+		return time.Duration(seconds * float64(time.Second)), err
+	case dialect.MySQL:
+		return mysqlReplicationLag(ctx, db)
+	default:
+		// sqlite3 has no replication, only check that database is reachable
+		return 0, db.PingContext(ctx)
+	}
+}
+
+func mysqlReplicationLag(ctx context.Context, db *sql.DB) (time.Duration, error) {
+	rows, err := db.QueryContext(ctx, "SHOW REPLICA STATUS")
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1064 { //nolint:mnd // ER_PARSE_ERROR
+		// MySQL before 8.0.22 knows only the old statement
+		rows, err = db.QueryContext(ctx, "SHOW SLAVE STATUS")
+	}
+
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return 0, rows.Err() // not a replica
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+
+	values := make([]sql.NullString, len(columns))
+	pointers := make([]any, len(columns))
+
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	if err := rows.Scan(pointers...); err != nil {
+		return 0, err
+	}
+
+	for i, column := range columns {
+		// named Seconds_Behind_Master by SHOW SLAVE STATUS
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+
+		if !values[i].Valid {
+			return 0, errReplicationStopped
+		}
+
+		seconds, err := strconv.Atoi(values[i].String)
+
+		return time.Duration(seconds) * time.Second, err
+	}
+
+	return 0, errReplicationStopped
+}
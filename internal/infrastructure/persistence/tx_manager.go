@@ -0,0 +1,102 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+	"github.com/intezya/auth_service/pkg/defaults"
+)
+
+const (
+	defaultTxMaxRetries   = 3
+	txRetryInitialBackoff = 10 * time.Millisecond
+	txRetryMaxBackoff     = 200 * time.Millisecond
+)
+
+type txKey struct{}
+
+type txManager struct {
+	client     *ent.Client
+	maxRetries int
+}
+
+func NewTxManager(client *ent.Client, maxRetries int) repository.TxManager {
+	return &txManager{client: client, maxRetries: defaults.Positive(maxRetries, defaultTxMaxRetries)}
+}
+
+// WithinTx runs fn in serializable transaction and retries it on serialization failures, deadlocks
+// and optimistic lock conflicts until ctx is done.
+func (m *txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*ent.Tx); ok {
+		return fn(ctx)
+	}
+
+	var err error
+
+	for attempt := 1; attempt <= m.maxRetries; attempt++ {
+		err = m.runTx(ctx, fn)
+		if !isRetryable(err) {
+			return err
+		}
+
+		if attempt < m.maxRetries {
+			if err := sleepContext(ctx, backoff(attempt, txRetryInitialBackoff, txRetryMaxBackoff)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// sleepContext waits for duration, it returns ctx error if ctx is done earlier.
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (m *txManager) runTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := m.client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return unexpectedError(err)
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("rollback: %w", rollbackErr))
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return unexpectedError(err)
+	}
+
+	return nil
+}
+
+func isRetryable(err error) bool {
+	return errors.Is(err, repository.ErrSerializationFailure) || errors.Is(err, repository.ErrConcurrentModification)
+}
+
+// clientFromContext returns client of transaction started by txManager or the default one.
+func clientFromContext(ctx context.Context, client *ent.Client) *ent.Client {
+	if tx, ok := ctx.Value(txKey{}).(*ent.Tx); ok {
+		return tx.Client()
+	}
+
+	return client
+}
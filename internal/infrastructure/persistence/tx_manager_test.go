@@ -0,0 +1,61 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"testing"
+)
+
+func TestTxManager_WithinTxRetriesConflicts(t *testing.T) {
+	forEachBackend(
+		t, func(t *testing.T, provider *Provider) {
+			calls := 0
+
+			err := provider.TxManager.WithinTx(
+				context.Background(), func(context.Context) error {
+					calls++
+					if calls < 2 { //nolint:mnd
+						return repository.ErrConcurrentModification
+					}
+
+					return nil
+				},
+			)
+			if err != nil {
+				t.Fatalf("WithinTx() error = %v", err)
+			}
+
+			if calls != 2 { //nolint:mnd
+				t.Fatalf("fn called %d times, want 2", calls)
+			}
+		},
+	)
+}
+
+func TestTxManager_WithinTxStopsRetryingWhenContextIsDone(t *testing.T) {
+	forEachBackend(
+		t, func(t *testing.T, provider *Provider) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			calls := 0
+
+			err := provider.TxManager.WithinTx(
+				ctx, func(context.Context) error {
+					calls++
+					cancel()
+
+					return repository.ErrConcurrentModification
+				},
+			)
+			if !errors.Is(err, context.Canceled) {
+				t.Fatalf("WithinTx() error = %v, want %v", err, context.Canceled)
+			}
+
+			if calls != 1 {
+				t.Fatalf("fn called %d times, want 1", calls)
+			}
+		},
+	)
+}
@@ -0,0 +1,85 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"sort"
+	"strings"
+)
+
+var errUsernameCollision = errors.New(
+	"usernames collide after canonicalization, rename accounts and run migrations again",
+)
+
+// backfillUsernameCanonical fills username_canonical with domain.Username.Canonical (NFKC and case folding),
+// which SQL functions of the dialects don't match. Collisions are reported instead of being left
+// to unique index creation, so operator knows which accounts to rename.
+func backfillUsernameCanonical(ctx context.Context, tx *sql.Tx, driver string) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, username FROM accounts ORDER BY id")
+	if err != nil {
+		return err
+	}
+
+	var (
+		ids       []int
+		canonical = make(map[int]domain.CanonicalUsername)
+		owners    = make(map[domain.CanonicalUsername][]int)
+	)
+
+	for rows.Next() {
+		var (
+			id       int
+			username string
+		)
+
+		if err := rows.Scan(&id, &username); err != nil {
+			_ = rows.Close()
+
+			return err
+		}
+
+		ids = append(ids, id)
+		canonical[id] = domain.Username(username).Canonical()
+		owners[canonical[id]] = append(owners[canonical[id]], id)
+	}
+
+	if err := errors.Join(rows.Err(), rows.Close()); err != nil {
+		return err
+	}
+
+	if collisions := usernameCollisions(owners); len(collisions) > 0 {
+		return fmt.Errorf("%w: %s", errUsernameCollision, strings.Join(collisions, "; "))
+	}
+
+	update, err := tx.PrepareContext(ctx, rebind(driver, "UPDATE accounts SET username_canonical = ? WHERE id = ?"))
+	if err != nil {
+		return err
+	}
+	defer update.Close()
+
+	for _, id := range ids {
+		if _, err := update.ExecContext(ctx, string(canonical[id]), id); err != nil {
+			return fmt.Errorf("account %d: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// usernameCollisions describes canonical usernames shared by several accounts as `"name": ids [1 2]`.
+func usernameCollisions(owners map[domain.CanonicalUsername][]int) []string {
+	var collisions []string
+
+	for username, ids := range owners {
+		if len(ids) > 1 {
+			collisions = append(collisions, fmt.Sprintf("%q: ids %v", username, ids))
+		}
+	}
+
+	sort.Strings(collisions)
+
+	return collisions
+}
@@ -0,0 +1,100 @@
+package persistence
+
+import (
+	"context"
+	"github.com/intezya/auth_service/internal/adapters/mapper"
+	domain "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/repository"
+	"github.com/intezya/auth_service/internal/infrastructure/ent"
+	entUsernameChange "github.com/intezya/auth_service/internal/infrastructure/ent/usernamechange"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type usernameChangeRepository struct {
+	client *ent.Client
+}
+
+func NewUsernameChangeRepository(client *ent.Client) repository.UsernameChangeRepository {
+	return &usernameChangeRepository{client: client}
+}
+
+func (r *usernameChangeRepository) Create(ctx context.Context, change *domain.UsernameChange) (
+	*domain.UsernameChange,
+	error,
+) {
+	created, err := clientFromContext(ctx, r.client).UsernameChange.
+		Create().
+		SetAccountID(change.AccountID()).
+		SetUsername(change.Username()).
+		SetUsernameCanonical(string(domain.Username(change.Username()).Canonical())).
+		SetChangedAt(change.ChangedAt()).
+		SetReservedUntil(change.ReservedUntil()).
+		Save(ctx)
+	if err != nil {
+		if mapped, ok := mapConstraintError(err); ok {
+			return nil, mapped
+		}
+
+		return nil, unexpectedError(err)
+	}
+
+	return mapper.EntUsernameChangeToDomain(created), nil
+}
+
+func (r *usernameChangeRepository) FindLatestByUsername(ctx context.Context, username domain.Username) (
+	*domain.UsernameChange,
+	error,
+) {
+	ctx = readOnly(ctx)
+
+	found, err := clientFromContext(ctx, r.client).UsernameChange.
+		Query().
+		Where(entUsernameChange.UsernameCanonical(string(username.Canonical()))).
+		Order(ent.Desc(entUsernameChange.FieldChangedAt), ent.Desc(entUsernameChange.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "username change not found")
+		}
+
+		return nil, unexpectedError(err)
+	}
+
+	return mapper.EntUsernameChangeToDomain(found), nil
+}
+
+func (r *usernameChangeRepository) FindAllByAccountID(ctx context.Context, accountID domain.AccountID) (
+	[]*domain.UsernameChange,
+	error,
+) {
+	ctx = readOnly(ctx)
+
+	found, err := clientFromContext(ctx, r.client).UsernameChange.
+		Query().
+		Where(entUsernameChange.AccountID(int(accountID))).
+		Order(ent.Asc(entUsernameChange.FieldChangedAt), ent.Asc(entUsernameChange.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, unexpectedError(err)
+	}
+
+	changes := make([]*domain.UsernameChange, 0, len(found))
+	for _, change := range found {
+		changes = append(changes, mapper.EntUsernameChangeToDomain(change))
+	}
+
+	return changes, nil
+}
+
+func (r *usernameChangeRepository) DeleteAllByAccountID(ctx context.Context, accountID domain.AccountID) error {
+	_, err := clientFromContext(ctx, r.client).UsernameChange.
+		Delete().
+		Where(entUsernameChange.AccountID(int(accountID))).
+		Exec(ctx)
+	if err != nil {
+		return unexpectedError(err)
+	}
+
+	return nil
+}
@@ -2,8 +2,27 @@ package crypto
 
 import "errors"
 
-var errInvalidEncodeFormat = errors.New("invalid encode format")
+var (
+	errInvalidEncodeFormat = errors.New("invalid encode format")
+	errUnknownKeyID        = errors.New("unknown hardware id encryption key id")
+	errInvalidKeyID        = errors.New("invalid hardware id encryption key id")
+	errNoEncryptionKey     = errors.New("no hardware id encryption key configured")
+	errUnknownPrimaryKey   = errors.New("primary hardware id encryption key is not in keyring")
+)
 
+// Config keys are plaintext for "plain" key provider, otherwise data keys wrapped by KeyProvider.
 type Config struct {
-	HardwareIDEncryptionKey string `env:"HARDWARE_ID_ENCRYPTION_KEY" env-required:"true"`
+	KeyProvider KeyProviderConfig
+
+	// HardwareIDEncryptionKey decrypts unversioned "nonce:ciphertext" values written before key rotation existed.
+	// It is also used for encryption while keyring is empty.
+	HardwareIDEncryptionKey string `env:"HARDWARE_ID_ENCRYPTION_KEY"`
+	// HardwareIDEncryptionKeys is keyring of versioned keys: "id:key,id:key". Key ids must not contain ':'.
+	HardwareIDEncryptionKeys map[string]string `env:"HARDWARE_ID_ENCRYPTION_KEYS"`
+	// HardwareIDEncryptionKeyID is id of keyring key used for new ciphertexts.
+	HardwareIDEncryptionKeyID string `env:"HARDWARE_ID_ENCRYPTION_KEY_ID"`
+	HardwareIDDigestKey       string `env:"HARDWARE_ID_DIGEST_KEY" env-required:"true"`
+
+	// ReencryptOnStart runs re-encryption of stored hardware ids with primary key in background on startup.
+	ReencryptOnStart bool `env:"HARDWARE_ID_REENCRYPT_ON_START" env-default:"false"`
 }
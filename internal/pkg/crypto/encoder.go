@@ -2,9 +2,11 @@ package crypto
 
 import (
 	"context"
-	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"github.com/intezya/auth_service/internal/domain/service"
 	"github.com/intezya/pkglib/crypto"
@@ -13,17 +15,32 @@ import (
 )
 
 type passwordEncoder struct {
-	block cipher.Block
+	keyring   *keyring
+	digestKey []byte
 }
 
-func NewPasswordEncoder(config Config) service.PasswordEncoder {
-	block, err := aes.NewCipher([]byte(config.HardwareIDEncryptionKey)[:])
+func NewPasswordEncoder(config Config, keyProvider KeyProvider) service.PasswordEncoder {
+	timeout := config.KeyProvider.Timeout
+	if timeout <= 0 {
+		timeout = defaultKeyProviderTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	config, err := unwrapKeys(ctx, config, keyProvider)
+	if err != nil {
+		panic(err) // key provider unavailable or wrapped keys are malformed
+	}
+
+	keyring, err := newKeyring(config)
 	if err != nil {
-		panic(err) // not 16/24/32 bytes
+		panic(err) // not 16/24/32 bytes or misconfigured keyring
 	}
 
 	return &passwordEncoder{
-		block: block,
+		keyring:   keyring,
+		digestKey: []byte(config.HardwareIDDigestKey),
 	}
 }
 
@@ -45,25 +62,29 @@ func (p *passwordEncoder) VerifyPassword(ctx context.Context, password, hash str
 	return ok
 }
 
+// EncodeHardwareID encrypts hardwareID with primary key as "keyID:nonce:ciphertext".
 func (p *passwordEncoder) EncodeHardwareID(ctx context.Context, hardwareID string) string {
 	salt := generate.RandomBytes(12) //nolint:mnd
 
-	aesgcm, err := cipher.NewGCM(p.block)
-	if err != nil {
-		panic(err)
-	}
+	keyID, aesgcm := p.keyring.primary()
 
 	ciphertext := aesgcm.Seal(nil, salt, []byte(hardwareID), nil)
 
-	return fmt.Sprintf(
+	encoded := fmt.Sprintf(
 		"%s:%s",
 		base64.StdEncoding.EncodeToString(salt),
 		base64.StdEncoding.EncodeToString(ciphertext),
 	)
+
+	if keyID == legacyKeyID {
+		return encoded
+	}
+
+	return keyID + ":" + encoded
 }
 
 func (p *passwordEncoder) VerifyHardwareID(ctx context.Context, hardwareID, hash string) bool {
-	decoded, err := p.decodeHardwareID(ctx, hash)
+	decoded, err := p.DecodeHardwareID(ctx, hash)
 	if err != nil {
 		return false // malformed
 	}
@@ -71,23 +92,73 @@ func (p *passwordEncoder) VerifyHardwareID(ctx context.Context, hardwareID, hash
 	return decoded == hardwareID
 }
 
-func (p *passwordEncoder) decodeHardwareID(ctx context.Context, hardwareID string) (string, error) {
+// DigestHardwareID returns deterministic HMAC-SHA256 of hardwareID (blind index for lookups and uniqueness).
+func (p *passwordEncoder) DigestHardwareID(ctx context.Context, hardwareID string) string {
+	mac := hmac.New(sha256.New, p.digestKey)
+	mac.Write([]byte(hardwareID))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (p *passwordEncoder) DecodeHardwareID(ctx context.Context, hardwareID string) (string, error) {
 	parts := strings.Split(hardwareID, ":")
-	if len(parts) != 2 { //nolint:mnd
+
+	switch len(parts) {
+	case 2: //nolint:mnd // legacy format without key id, try every known key
+		var err error
+		for _, aesgcm := range p.keyring.fallback() {
+			var plaintext string
+			plaintext, err = open(aesgcm, parts[0], parts[1])
+			if err == nil {
+				return plaintext, nil
+			}
+		}
+
+		return "", err
+	case 3: //nolint:mnd
+		aesgcm, ok := p.keyring.get(parts[0])
+		if !ok {
+			return "", errUnknownKeyID
+		}
+
+		return open(aesgcm, parts[1], parts[2])
+	default:
 		return "", errInvalidEncodeFormat
 	}
+}
+
+// ReencryptHardwareID re-encrypts value with primary key. Reports false if it is already encrypted with it.
+func (p *passwordEncoder) ReencryptHardwareID(ctx context.Context, hardwareID string) (string, bool, error) {
+	if p.isEncodedWithPrimaryKey(hardwareID) {
+		return hardwareID, false, nil
+	}
 
-	nonce, err := base64.StdEncoding.DecodeString(parts[0])
+	decoded, err := p.DecodeHardwareID(ctx, hardwareID)
 	if err != nil {
-		return "", err
+		return "", false, err
 	}
 
-	ciphertext, err := base64.StdEncoding.DecodeString(parts[1])
+	return p.EncodeHardwareID(ctx, decoded), true, nil
+}
+
+func (p *passwordEncoder) isEncodedWithPrimaryKey(hardwareID string) bool {
+	primaryID, _ := p.keyring.primary()
+
+	parts := strings.Split(hardwareID, ":")
+	if len(parts) == 2 { //nolint:mnd
+		return primaryID == legacyKeyID
+	}
+
+	return len(parts) == 3 && parts[0] == primaryID //nolint:mnd
+}
+
+func open(aesgcm cipher.AEAD, encodedNonce, encodedCiphertext string) (string, error) {
+	nonce, err := base64.StdEncoding.DecodeString(encodedNonce)
 	if err != nil {
 		return "", err
 	}
 
-	aesgcm, err := cipher.NewGCM(p.block)
+	ciphertext, err := base64.StdEncoding.DecodeString(encodedCiphertext)
 	if err != nil {
 		return "", err
 	}
@@ -12,8 +12,8 @@ type passwordEncoderWithTracing struct {
 	wrapped service.PasswordEncoder
 }
 
-func NewPasswordEncoderWithTracing(config Config) service.PasswordEncoder {
-	wrapped := NewPasswordEncoder(config)
+func NewPasswordEncoderWithTracing(config Config, keyProvider KeyProvider) service.PasswordEncoder {
+	wrapped := NewPasswordEncoder(config, keyProvider)
 	return &passwordEncoderWithTracing{
 		wrapped: wrapped,
 	}
@@ -46,3 +46,24 @@ func (t *passwordEncoderWithTracing) VerifyHardwareID(ctx context.Context, hardw
 
 	return t.wrapped.VerifyHardwareID(ctx, hardwareID, hash)
 }
+
+func (t *passwordEncoderWithTracing) DigestHardwareID(ctx context.Context, hardwareID string) string {
+	ctx, span := tracer.StartSpan(ctx, "PasswordEncoder.DigestHardwareID")
+	defer span.End()
+
+	return t.wrapped.DigestHardwareID(ctx, hardwareID)
+}
+
+func (t *passwordEncoderWithTracing) DecodeHardwareID(ctx context.Context, hardwareID string) (string, error) {
+	ctx, span := tracer.StartSpan(ctx, "PasswordEncoder.DecodeHardwareID")
+	defer span.End()
+
+	return t.wrapped.DecodeHardwareID(ctx, hardwareID)
+}
+
+func (t *passwordEncoderWithTracing) ReencryptHardwareID(ctx context.Context, hardwareID string) (string, bool, error) {
+	ctx, span := tracer.StartSpan(ctx, "PasswordEncoder.ReencryptHardwareID")
+	defer span.End()
+
+	return t.wrapped.ReencryptHardwareID(ctx, hardwareID)
+}
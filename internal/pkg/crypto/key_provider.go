@@ -0,0 +1,109 @@
+package crypto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	KeyProviderPlain  = "plain"
+	KeyProviderFile   = "file"
+	KeyProviderVault  = "vault"
+	KeyProviderPKCS11 = "pkcs11"
+)
+
+const defaultKeyProviderTimeout = 10 * time.Second
+
+var errUnknownKeyProvider = errors.New("unknown hardware id key provider")
+
+// KeyProvider unwraps data keys encrypted with key encryption key (envelope encryption),
+// so hardware id keys are never stored in plaintext in environment.
+type KeyProvider interface {
+	UnwrapKey(ctx context.Context, wrappedKey string) ([]byte, error)
+}
+
+type KeyProviderConfig struct {
+	// Type is one of "plain" (keys in env are not wrapped), "file", "vault", "pkcs11".
+	Type    string        `env:"HARDWARE_ID_KEY_PROVIDER" env-default:"plain"`
+	Timeout time.Duration `env:"HARDWARE_ID_KEY_PROVIDER_TIMEOUT" env-default:"10s"`
+
+	KEKFile string `env:"HARDWARE_ID_KEK_FILE"`
+
+	VaultAddress      string `env:"VAULT_ADDR" env-default:"http://127.0.0.1:8200"`
+	VaultToken        string `env:"VAULT_TOKEN"`
+	VaultTransitMount string `env:"VAULT_TRANSIT_MOUNT" env-default:"transit"`
+	VaultTransitKey   string `env:"VAULT_TRANSIT_KEY"`
+
+	PKCS11KeyLabel string `env:"PKCS11_KEY_LABEL"`
+}
+
+func NewKeyProvider(config KeyProviderConfig) (KeyProvider, error) {
+	switch config.Type {
+	case KeyProviderPlain, "":
+		return plainKeyProvider{}, nil
+	case KeyProviderFile:
+		return NewFileKeyProvider(config.KEKFile)
+	case KeyProviderVault:
+		return NewVaultTransitKeyProvider(
+			config.VaultAddress,
+			config.VaultToken,
+			config.VaultTransitMount,
+			config.VaultTransitKey,
+			&http.Client{Timeout: config.Timeout},
+		), nil
+	case KeyProviderPKCS11:
+		return NewPKCS11KeyProvider(unavailablePKCS11Session{}, config.PKCS11KeyLabel), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownKeyProvider, config.Type)
+	}
+}
+
+// plainKeyProvider keeps backward compatibility with raw keys in environment.
+type plainKeyProvider struct{}
+
+func (plainKeyProvider) UnwrapKey(ctx context.Context, wrappedKey string) ([]byte, error) {
+	return []byte(wrappedKey), nil
+}
+
+// unwrapKeys returns copy of config with all hardware id keys unwrapped by keyProvider.
+func unwrapKeys(ctx context.Context, config Config, keyProvider KeyProvider) (Config, error) {
+	unwrap := func(wrapped string) (string, error) {
+		if wrapped == "" {
+			return "", nil
+		}
+
+		key, err := keyProvider.UnwrapKey(ctx, wrapped)
+		if err != nil {
+			return "", err
+		}
+
+		return string(key), nil
+	}
+
+	var err error
+
+	unwrapped := config
+
+	unwrapped.HardwareIDEncryptionKey, err = unwrap(config.HardwareIDEncryptionKey)
+	if err != nil {
+		return Config{}, fmt.Errorf("unwrap legacy encryption key: %w", err)
+	}
+
+	unwrapped.HardwareIDDigestKey, err = unwrap(config.HardwareIDDigestKey)
+	if err != nil {
+		return Config{}, fmt.Errorf("unwrap digest key: %w", err)
+	}
+
+	unwrapped.HardwareIDEncryptionKeys = make(map[string]string, len(config.HardwareIDEncryptionKeys))
+	for id, wrapped := range config.HardwareIDEncryptionKeys {
+		unwrapped.HardwareIDEncryptionKeys[id], err = unwrap(wrapped)
+		if err != nil {
+			return Config{}, fmt.Errorf("unwrap encryption key %q: %w", id, err)
+		}
+	}
+
+	return unwrapped, nil
+}
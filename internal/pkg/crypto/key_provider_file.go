@@ -0,0 +1,61 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+)
+
+var errWrappedKeyTooShort = errors.New("wrapped key is too short")
+
+// fileKeyProvider unwraps data keys with local AES key encryption key.
+// Wrapped key format: base64(nonce || AES-GCM ciphertext).
+type fileKeyProvider struct {
+	aesgcm cipher.AEAD
+}
+
+// NewFileKeyProvider reads KEK from path. File holds base64 of 16/24/32 byte key,
+// raw keys are not accepted since they may be valid base64 of another key.
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	kek, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(content)))
+	if err != nil {
+		return nil, fmt.Errorf("key encryption key file must contain base64: %w", err)
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	aesgcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileKeyProvider{aesgcm: aesgcm}, nil
+}
+
+func (f *fileKeyProvider) UnwrapKey(ctx context.Context, wrappedKey string) ([]byte, error) {
+	wrapped, err := base64.StdEncoding.DecodeString(wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrapped) < f.aesgcm.NonceSize() {
+		return nil, errWrappedKeyTooShort
+	}
+
+	nonce, ciphertext := wrapped[:f.aesgcm.NonceSize()], wrapped[f.aesgcm.NonceSize():]
+
+	return f.aesgcm.Open(nil, nonce, ciphertext, nil)
+}
@@ -0,0 +1,56 @@
+package crypto
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeKEK(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "kek")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestFileKeyProvider_UnwrapKey(t *testing.T) {
+	kek := make([]byte, 32)
+	_, _ = rand.Read(kek)
+
+	provider, err := NewFileKeyProvider(writeKEK(t, base64.StdEncoding.EncodeToString(kek)+"\n"))
+	if err != nil {
+		t.Fatalf("NewFileKeyProvider() error = %v", err)
+	}
+
+	block, _ := aes.NewCipher(kek)
+	aesgcm, _ := cipher.NewGCM(block)
+	nonce := make([]byte, aesgcm.NonceSize())
+	_, _ = rand.Read(nonce)
+
+	key := []byte("0123456789abcdef")
+	wrapped := base64.StdEncoding.EncodeToString(aesgcm.Seal(nonce, nonce, key, nil))
+
+	unwrapped, err := provider.UnwrapKey(context.Background(), wrapped)
+	if err != nil {
+		t.Fatalf("UnwrapKey() error = %v", err)
+	}
+
+	if string(unwrapped) != string(key) {
+		t.Fatalf("UnwrapKey() = %q, want %q", unwrapped, key)
+	}
+}
+
+func TestNewFileKeyProvider_RejectsRawKey(t *testing.T) {
+	if _, err := NewFileKeyProvider(writeKEK(t, "raw key with spaces!")); err == nil {
+		t.Fatal("NewFileKeyProvider() accepted non-base64 key")
+	}
+}
@@ -0,0 +1,44 @@
+package crypto
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+)
+
+var errPKCS11Unavailable = errors.New("pkcs11 module is not linked into this build")
+
+// PKCS11Session is the subset of PKCS#11 session operations needed to unwrap data keys
+// (C_DecryptInit + C_Decrypt with key found by CKA_LABEL).
+type PKCS11Session interface {
+	Decrypt(ctx context.Context, keyLabel string, ciphertext []byte) ([]byte, error)
+}
+
+// pkcs11KeyProvider unwraps data keys with key stored in HSM. Wrapped key format: base64 of HSM ciphertext.
+type pkcs11KeyProvider struct {
+	session  PKCS11Session
+	keyLabel string
+}
+
+func NewPKCS11KeyProvider(session PKCS11Session, keyLabel string) KeyProvider {
+	return &pkcs11KeyProvider{
+		session:  session,
+		keyLabel: keyLabel,
+	}
+}
+
+func (p *pkcs11KeyProvider) UnwrapKey(ctx context.Context, wrappedKey string) ([]byte, error) {
+	wrapped, err := base64.StdEncoding.DecodeString(wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.session.Decrypt(ctx, p.keyLabel, wrapped)
+}
+
+// unavailablePKCS11Session is used until a real PKCS#11 binding is wired in.
+type unavailablePKCS11Session struct{}
+
+func (unavailablePKCS11Session) Decrypt(ctx context.Context, keyLabel string, ciphertext []byte) ([]byte, error) {
+	return nil, errPKCS11Unavailable
+}
@@ -0,0 +1,90 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var errVaultDecrypt = errors.New("vault transit decrypt failed")
+
+// vaultTransitKeyProvider unwraps data keys with HashiCorp Vault transit secrets engine.
+// Wrapped key is Vault ciphertext as returned by transit encrypt ("vault:v1:...").
+type vaultTransitKeyProvider struct {
+	address    string
+	token      string
+	mount      string
+	key        string
+	httpClient *http.Client
+}
+
+func NewVaultTransitKeyProvider(
+	address string,
+	token string,
+	mount string,
+	key string,
+	httpClient *http.Client,
+) KeyProvider {
+	return &vaultTransitKeyProvider{
+		address:    strings.TrimRight(address, "/"),
+		token:      token,
+		mount:      strings.Trim(mount, "/"),
+		key:        key,
+		httpClient: httpClient,
+	}
+}
+
+type vaultDecryptRequest struct {
+	Ciphertext string `json:"ciphertext"`
+}
+
+type vaultDecryptResponse struct {
+	Data struct {
+		Plaintext string `json:"plaintext"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func (v *vaultTransitKeyProvider) UnwrapKey(ctx context.Context, wrappedKey string) ([]byte, error) {
+	body, err := json.Marshal(vaultDecryptRequest{Ciphertext: wrappedKey})
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/decrypt/%s", v.address, v.mount, v.key)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("X-Vault-Token", v.token)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := v.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var decoded vaultDecryptResponse
+	if err := json.NewDecoder(response.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("%w: status %d: %v", errVaultDecrypt, response.StatusCode, err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"%w: status %d: %s",
+			errVaultDecrypt,
+			response.StatusCode,
+			strings.Join(decoded.Errors, "; "),
+		)
+	}
+
+	return base64.StdEncoding.DecodeString(decoded.Data.Plaintext)
+}
@@ -0,0 +1,179 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	testVaultToken = "test-token"
+	testVaultKey   = "hardware-id"
+)
+
+// fakeTransit implements encrypt and decrypt endpoints of Vault transit engine mounted at "transit".
+// Ciphertext is "vault:v1:" followed by reversed base64 plaintext.
+func fakeTransit(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	writeJSON := func(w http.ResponseWriter, code int, body any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+
+	return httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("X-Vault-Token") != testVaultToken {
+					writeJSON(w, http.StatusForbidden, map[string]any{"errors": []string{"permission denied"}})
+
+					return
+				}
+
+				var body map[string]string
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []string{err.Error()}})
+
+					return
+				}
+
+				switch r.URL.Path {
+				case "/v1/transit/encrypt/" + testVaultKey:
+					writeJSON(
+						w, http.StatusOK,
+						map[string]any{"data": map[string]string{"ciphertext": "vault:v1:" + reverse(body["plaintext"])}},
+					)
+				case "/v1/transit/decrypt/" + testVaultKey:
+					ciphertext, ok := strings.CutPrefix(body["ciphertext"], "vault:v1:")
+					if !ok {
+						writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []string{"invalid ciphertext"}})
+
+						return
+					}
+
+					writeJSON(w, http.StatusOK, map[string]any{"data": map[string]string{"plaintext": reverse(ciphertext)}})
+				default:
+					writeJSON(w, http.StatusNotFound, map[string]any{"errors": []string{}})
+				}
+			},
+		),
+	)
+}
+
+func reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+
+	return string(runes)
+}
+
+// wrapKey wraps key with transit encrypt endpoint as operators do when provisioning the keyring.
+func wrapKey(t *testing.T, server *httptest.Server, key []byte) string {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]string{"plaintext": base64.StdEncoding.EncodeToString(key)})
+
+	request, _ := http.NewRequest(
+		http.MethodPost, server.URL+"/v1/transit/encrypt/"+testVaultKey, bytes.NewReader(body),
+	)
+	request.Header.Set("X-Vault-Token", testVaultToken)
+
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	defer response.Body.Close()
+
+	var decoded struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&decoded); err != nil {
+		t.Fatalf("encrypt response: %v", err)
+	}
+
+	return decoded.Data.Ciphertext
+}
+
+func TestVaultTransitKeyProvider_WrapUnwrap(t *testing.T) {
+	server := fakeTransit(t)
+	defer server.Close()
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	wrapped := wrapKey(t, server, key)
+
+	provider := NewVaultTransitKeyProvider(server.URL+"/", testVaultToken, "/transit/", testVaultKey, server.Client())
+
+	unwrapped, err := provider.UnwrapKey(context.Background(), wrapped)
+	if err != nil {
+		t.Fatalf("UnwrapKey() error = %v", err)
+	}
+
+	if !bytes.Equal(unwrapped, key) {
+		t.Fatalf("UnwrapKey() = %q, want %q", unwrapped, key)
+	}
+}
+
+func TestVaultTransitKeyProvider_Errors(t *testing.T) {
+	server := fakeTransit(t)
+	defer server.Close()
+
+	wrapped := wrapKey(t, server, []byte("0123456789abcdef"))
+
+	tests := []struct {
+		name       string
+		token      string
+		key        string
+		wrappedKey string
+		want       string
+	}{
+		{name: "forbidden", token: "wrong-token", key: testVaultKey, wrappedKey: wrapped, want: "status 403: permission denied"},
+		{name: "invalid ciphertext", token: testVaultToken, key: testVaultKey, wrappedKey: "garbage", want: "status 400: invalid ciphertext"},
+		{name: "unknown key", token: testVaultToken, key: "unknown", wrappedKey: wrapped, want: "status 404"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				provider := NewVaultTransitKeyProvider(server.URL, tt.token, "transit", tt.key, server.Client())
+
+				_, err := provider.UnwrapKey(context.Background(), tt.wrappedKey)
+				if !errors.Is(err, errVaultDecrypt) {
+					t.Fatalf("UnwrapKey() error = %v, want %v", err, errVaultDecrypt)
+				}
+
+				if !strings.Contains(err.Error(), tt.want) {
+					t.Fatalf("UnwrapKey() error = %q, want it to contain %q", err, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestVaultTransitKeyProvider_NonJSONResponse(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, "bad gateway", http.StatusBadGateway)
+			},
+		),
+	)
+	defer server.Close()
+
+	provider := NewVaultTransitKeyProvider(server.URL, testVaultToken, "transit", testVaultKey, server.Client())
+
+	_, err := provider.UnwrapKey(context.Background(), "vault:v1:abc")
+	if !errors.Is(err, errVaultDecrypt) || !strings.Contains(err.Error(), "status 502") {
+		t.Fatalf("UnwrapKey() error = %v, want vault decrypt error with status 502", err)
+	}
+}
@@ -0,0 +1,98 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"sort"
+	"strings"
+)
+
+// legacyKeyID marks ciphertexts in "nonce:ciphertext" format without key id.
+const legacyKeyID = ""
+
+type keyring struct {
+	primaryID string
+	ciphers   map[string]cipher.AEAD
+	// fallbackOrder lists key ids tried for legacy ciphertexts, legacy key first.
+	fallbackOrder []string
+}
+
+func newKeyring(config Config) (*keyring, error) {
+	ring := &keyring{
+		primaryID: config.HardwareIDEncryptionKeyID,
+		ciphers:   make(map[string]cipher.AEAD, len(config.HardwareIDEncryptionKeys)+1),
+	}
+
+	if config.HardwareIDEncryptionKey != "" {
+		if err := ring.add(legacyKeyID, []byte(config.HardwareIDEncryptionKey)); err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]string, 0, len(config.HardwareIDEncryptionKeys))
+	for id := range config.HardwareIDEncryptionKeys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if id == legacyKeyID || strings.Contains(id, ":") {
+			return nil, errInvalidKeyID
+		}
+
+		if err := ring.add(id, []byte(config.HardwareIDEncryptionKeys[id])); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(ring.ciphers) == 0 {
+		return nil, errNoEncryptionKey
+	}
+
+	if ring.primaryID == legacyKeyID && len(ids) == 1 {
+		ring.primaryID = ids[0]
+	}
+
+	if _, ok := ring.ciphers[ring.primaryID]; !ok {
+		return nil, errUnknownPrimaryKey
+	}
+
+	return ring, nil
+}
+
+func (k *keyring) add(id string, key []byte) error {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err // not 16/24/32 bytes
+	}
+
+	aesgcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+
+	k.ciphers[id] = aesgcm
+	k.fallbackOrder = append(k.fallbackOrder, id)
+
+	return nil
+}
+
+func (k *keyring) primary() (string, cipher.AEAD) {
+	return k.primaryID, k.ciphers[k.primaryID]
+}
+
+func (k *keyring) get(id string) (cipher.AEAD, bool) {
+	aesgcm, ok := k.ciphers[id]
+
+	return aesgcm, ok
+}
+
+// fallback returns all keys in order they are tried for ciphertexts without key id.
+func (k *keyring) fallback() []cipher.AEAD {
+	ciphers := make([]cipher.AEAD, 0, len(k.fallbackOrder))
+	for _, id := range k.fallbackOrder {
+		ciphers = append(ciphers, k.ciphers[id])
+	}
+
+	return ciphers
+}
@@ -0,0 +1,103 @@
+package geoip
+
+import (
+	"errors"
+	"fmt"
+	entity "github.com/intezya/auth_service/internal/domain/account"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"github.com/oschwald/maxminddb-golang/v2"
+	"net/netip"
+)
+
+type Config struct {
+	// CityDatabase is path to GeoLite2-City or GeoIP2-City MMDB file, location is not resolved if it is empty.
+	CityDatabase string `env:"GEOIP_CITY_DATABASE"`
+	// ASNDatabase is path to GeoLite2-ASN MMDB file, network is not resolved if it is empty.
+	ASNDatabase string `env:"GEOIP_ASN_DATABASE"`
+}
+
+type cityRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	Location struct {
+		Latitude  *float64 `maxminddb:"latitude"`
+		Longitude *float64 `maxminddb:"longitude"`
+	} `maxminddb:"location"`
+}
+
+type asnRecord struct {
+	Number uint `maxminddb:"autonomous_system_number"`
+}
+
+// Resolver resolves ip addresses with offline MaxMind databases.
+type Resolver struct {
+	city *maxminddb.Reader
+	asn  *maxminddb.Reader
+}
+
+var _ service.GeoIPResolver = (*Resolver)(nil)
+
+func NewResolver(config Config) (*Resolver, error) {
+	resolver := &Resolver{}
+
+	if config.CityDatabase != "" {
+		reader, err := maxminddb.Open(config.CityDatabase)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open city database: %w", err)
+		}
+
+		resolver.city = reader
+	}
+
+	if config.ASNDatabase != "" {
+		reader, err := maxminddb.Open(config.ASNDatabase)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to open asn database: %w", err), resolver.Close())
+		}
+
+		resolver.asn = reader
+	}
+
+	return resolver, nil
+}
+
+// Resolve returns location of ip, lookup errors are treated as unknown location.
+func (r *Resolver) Resolve(ip netip.Addr) entity.IPLocation {
+	var location entity.IPLocation
+
+	if r.city != nil {
+		var record cityRecord
+		if err := r.city.Lookup(ip).Decode(&record); err == nil {
+			if record.Country.ISOCode != "" {
+				location.Country = &record.Country.ISOCode
+			}
+
+			location.Latitude = record.Location.Latitude
+			location.Longitude = record.Location.Longitude
+		}
+	}
+
+	if r.asn != nil {
+		var record asnRecord
+		if err := r.asn.Lookup(ip).Decode(&record); err == nil && record.Number != 0 {
+			location.ASN = &record.Number
+		}
+	}
+
+	return location
+}
+
+func (r *Resolver) Close() error {
+	var errs []error
+
+	if r.city != nil {
+		errs = append(errs, r.city.Close())
+	}
+
+	if r.asn != nil {
+		errs = append(errs, r.asn.Close())
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,62 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type fileMailer struct {
+	directory string
+	from      string
+	sequence  atomic.Uint64
+}
+
+// NewFileMailer writes every mail to "<directory>/<unix nanos>-<seq>-<recipient>.eml", so tests can read them.
+func NewFileMailer(directory, from string) (service.Mailer, error) {
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	return &fileMailer{directory: directory, from: from}, nil
+}
+
+func (m *fileMailer) Send(_ context.Context, mail service.Mail) error {
+	now := time.Now()
+
+	message, err := buildMessage(m.from, mail, now)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d-%d-%s.eml", now.UnixNano(), m.sequence.Add(1), sanitizeFileName(envelopeAddress(mail.To)))
+
+	return os.WriteFile(filepath.Join(m.directory, name), message, 0o600)
+}
+
+// envelopeAddress returns bare address of "Name <address>" form.
+func envelopeAddress(address string) string {
+	if parsed, err := mail.ParseAddress(address); err == nil {
+		return parsed.Address
+	}
+
+	return address
+}
+
+func sanitizeFileName(name string) string {
+	return strings.Map(
+		func(r rune) rune {
+			if r == '/' || r == '\\' || r == os.PathSeparator || r < ' ' {
+				return '_'
+			}
+
+			return r
+		}, name,
+	)
+}
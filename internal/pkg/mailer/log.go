@@ -0,0 +1,21 @@
+package mailer
+
+import (
+	"context"
+	"github.com/intezya/auth_service/internal/domain/service"
+)
+
+type logMailer struct {
+	logger Logger
+}
+
+// NewLogMailer writes mails to log instead of sending them, for development only: mails may contain secrets.
+func NewLogMailer(logger Logger) service.Mailer {
+	return &logMailer{logger: logger}
+}
+
+func (m *logMailer) Send(_ context.Context, mail service.Mail) error {
+	m.logger.Infof("Mail to %s: %s\n%s", mail.To, mail.Subject, mail.Body)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package mailer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"mime"
+	"net/mail"
+	"time"
+)
+
+const (
+	DriverSMTP = "smtp"
+	DriverLog  = "log"
+	DriverFile = "file"
+)
+
+var (
+	errUnknownDriver         = errors.New("unknown mailer driver")
+	errLogDriverInProduction = errors.New(`mailer driver "log" would write verification codes to production logs`)
+)
+
+type Config struct {
+	// Driver is one of "smtp", "log" (development, mails are written to log) and "file" (tests).
+	Driver string `env:"MAILER_DRIVER" env-default:"log"`
+	From   string `env:"MAILER_FROM" env-default:"no-reply@localhost"`
+	// Environment is "dev" or "prod", "log" driver is refused in production.
+	Environment string `env:"ENV" env-default:"dev"`
+
+	SMTPHost     string        `env:"SMTP_HOST" env-default:"localhost"`
+	SMTPPort     int           `env:"SMTP_PORT" env-default:"587"`
+	SMTPUsername string        `env:"SMTP_USERNAME"`
+	SMTPPassword string        `env:"SMTP_PASSWORD"`
+	SMTPTimeout  time.Duration `env:"SMTP_TIMEOUT" env-default:"10s"`
+
+	// FileDirectory is directory where "file" driver writes mails as .eml files.
+	FileDirectory string `env:"MAILER_FILE_DIRECTORY" env-default:"mail"`
+}
+
+type Logger interface {
+	Infof(template string, args ...interface{})
+}
+
+func NewMailer(config Config, logger Logger) (service.Mailer, error) {
+	switch config.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(config), nil
+	case DriverLog, "":
+		if config.Environment == "prod" {
+			return nil, errLogDriverInProduction
+		}
+
+		return NewLogMailer(logger), nil
+	case DriverFile:
+		return NewFileMailer(config.FileDirectory, config.From)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownDriver, config.Driver)
+	}
+}
+
+// buildMessage renders mail as RFC 5322 message with plain text body.
+func buildMessage(from string, m service.Mail, date time.Time) ([]byte, error) {
+	if _, err := mail.ParseAddress(m.To); err != nil {
+		return nil, fmt.Errorf("invalid recipient: %w", err)
+	}
+
+	var message bytes.Buffer
+
+	headers := []struct{ name, value string }{
+		{"From", from},
+		{"To", m.To},
+		{"Subject", mime.QEncoding.Encode("utf-8", m.Subject)},
+		{"Date", date.Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=utf-8"},
+		{"Content-Transfer-Encoding", "8bit"},
+	}
+
+	for _, header := range headers {
+		fmt.Fprintf(&message, "%s: %s\r\n", header.name, header.value)
+	}
+
+	message.WriteString("\r\n")
+	message.WriteString(m.Body)
+
+	return message.Bytes(), nil
+}
@@ -0,0 +1,99 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"github.com/intezya/auth_service/internal/domain/service"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+type smtpMailer struct {
+	address  string
+	host     string
+	username string
+	password string
+	from     string
+	timeout  time.Duration
+}
+
+// NewSMTPMailer sends mails through SMTP server, STARTTLS is used when server supports it.
+func NewSMTPMailer(config Config) service.Mailer {
+	return &smtpMailer{
+		address:  net.JoinHostPort(config.SMTPHost, strconv.Itoa(config.SMTPPort)),
+		host:     config.SMTPHost,
+		username: config.SMTPUsername,
+		password: config.SMTPPassword,
+		from:     config.From,
+		timeout:  config.SMTPTimeout,
+	}
+}
+
+func (m *smtpMailer) Send(ctx context.Context, mail service.Mail) error {
+	message, err := buildMessage(m.from, mail, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if m.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.timeout)
+		defer cancel()
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", m.address)
+	if err != nil {
+		return fmt.Errorf("smtp dial: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		_ = conn.Close()
+
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+
+	// PlainAuth refuses to send credentials over unencrypted connection to remote host
+	if m.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(envelopeAddress(m.from)); err != nil {
+		return fmt.Errorf("smtp mail: %w", err)
+	}
+
+	if err := client.Rcpt(envelopeAddress(mail.To)); err != nil {
+		return fmt.Errorf("smtp rcpt: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+
+	if _, err := writer.Write(message); err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+
+	return client.Quit()
+}
@@ -1,10 +1,36 @@
 package domainvalidator
 
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	usernameMinLength = 3
+	usernameMaxLength = 32
+)
+
+var (
+	errUsernameLength  = status.Errorf(codes.InvalidArgument, "username must be %d to %d characters long", usernameMinLength, usernameMaxLength)
+	errUsernameSymbols = status.Error(codes.InvalidArgument, "username may contain only letters, digits, '_', '-' and '.'")
+)
+
 type usernameValidator struct{}
 
 func (u usernameValidator) Validate(value string) error {
-	//TODO implement me
-	panic("implement me")
+	if length := utf8.RuneCountInString(value); length < usernameMinLength || length > usernameMaxLength {
+		return errUsernameLength
+	}
+
+	for _, r := range value {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != '.' {
+			return errUsernameSymbols
+		}
+	}
+
+	return nil
 }
 
 type passwordValidator struct{}
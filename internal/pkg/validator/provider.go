@@ -9,5 +9,7 @@ type Provider struct {
 }
 
 func NewProvider() *Provider {
-	return &Provider{}
+	return &Provider{
+		UsernameValidator: usernameValidator{},
+	}
 }
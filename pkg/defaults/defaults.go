@@ -0,0 +1,13 @@
+// Package defaults substitutes defaults for unset or invalid configuration values.
+package defaults
+
+import "time"
+
+// Positive returns value if it is positive, fallback otherwise.
+func Positive[T int | time.Duration](value T, fallback T) T {
+	if value <= 0 {
+		return fallback
+	}
+
+	return value
+}
@@ -7,6 +7,7 @@
 package authpb
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -59,7 +60,7 @@ func (*Empty) Descriptor() ([]byte, []int) {
 
 type AuthenticationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // Login also accepts verified email
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	HardwareId    string                 `protobuf:"bytes,3,opt,name=hardware_id,json=hardwareId,proto3" json:"hardware_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -122,7 +123,7 @@ type TokenResponse struct {
 	Token             string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	AccessLevel       int64                  `protobuf:"varint,2,opt,name=access_level,json=accessLevel,proto3" json:"access_level,omitempty"`
 	IsBanned          bool                   `protobuf:"varint,3,opt,name=is_banned,json=isBanned,proto3" json:"is_banned,omitempty"`
-	BannedUntilInUnix int64                  `protobuf:"varint,4,opt,name=banned_until_in_unix,json=bannedUntilInUnix,proto3" json:"banned_until_in_unix,omitempty"` // 0 = inf
+	BannedUntilInUnix int64                  `protobuf:"varint,4,opt,name=banned_until_in_unix,json=bannedUntilInUnix,proto3" json:"banned_until_in_unix,omitempty"` // 0 = not banned
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -284,7 +285,7 @@ func (x *VerifyTokenResponse) GetAccessLevel() int64 {
 type BanAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Subject       int64                  `protobuf:"varint,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	BanUntilUnix  int64                  `protobuf:"varint,2,opt,name=ban_until_unix,json=banUntilUnix,proto3" json:"ban_until_unix,omitempty"` // 0 = inf
+	BanUntilUnix  int64                  `protobuf:"varint,2,opt,name=ban_until_unix,json=banUntilUnix,proto3" json:"ban_until_unix,omitempty"` // 0 = unban
 	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -341,37 +342,1533 @@ func (x *BanAccountRequest) GetReason() string {
 	return ""
 }
 
-var File_auth_auth_proto protoreflect.FileDescriptor
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // confirmation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_auth_auth_proto_rawDesc = "" +
-	"\n" +
-	"\x0fauth/auth.proto\x12\x04auth\"\a\n" +
-	"\x05Empty\"p\n" +
-	"\x15AuthenticationRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1f\n" +
-	"\vhardware_id\x18\x03 \x01(\tR\n" +
-	"hardwareId\"\x96\x01\n" +
-	"\rTokenResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
-	"\faccess_level\x18\x02 \x01(\x03R\vaccessLevel\x12\x1b\n" +
-	"\tis_banned\x18\x03 \x01(\bR\bisBanned\x12/\n" +
-	"\x14banned_until_in_unix\x18\x04 \x01(\x03R\x11bannedUntilInUnix\"*\n" +
-	"\x12VerifyTokenRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"R\n" +
-	"\x13VerifyTokenResponse\x12\x18\n" +
-	"\asubject\x18\x01 \x01(\x03R\asubject\x12!\n" +
-	"\faccess_level\x18\x02 \x01(\x03R\vaccessLevel\"k\n" +
-	"\x11BanAccountRequest\x12\x18\n" +
-	"\asubject\x18\x01 \x01(\x03R\asubject\x12$\n" +
-	"\x0eban_until_unix\x18\x02 \x01(\x03R\fbanUntilUnix\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason2\xf6\x01\n" +
-	"\vAuthService\x124\n" +
-	"\bRegister\x12\x1b.auth.AuthenticationRequest\x1a\v.auth.Empty\x129\n" +
-	"\x05Login\x12\x1b.auth.AuthenticationRequest\x1a\x13.auth.TokenResponse\x12B\n" +
-	"\vVerifyToken\x12\x18.auth.VerifyTokenRequest\x1a\x19.auth.VerifyTokenResponse\x122\n" +
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_auth_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteAccountRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// restores deleted account until it is erased after grace period
+type RestoreAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAccountRequest) Reset() {
+	*x = RestoreAccountRequest{}
+	mi := &file_auth_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAccountRequest) ProtoMessage() {}
+
+func (x *RestoreAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAccountRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RestoreAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RestoreAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// collects personal data of account (subject access request), secrets are redacted
+type ExportAccountDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       int64                  `protobuf:"varint,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // "json" (default) / "zip"
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`   // token of the subject or of admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAccountDataRequest) Reset() {
+	*x = ExportAccountDataRequest{}
+	mi := &file_auth_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAccountDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountDataRequest) ProtoMessage() {}
+
+func (x *ExportAccountDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportAccountDataRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExportAccountDataRequest) GetSubject() int64 {
+	if x != nil {
+		return x.Subject
+	}
+	return 0
+}
+
+func (x *ExportAccountDataRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportAccountDataRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// json bundle holds payload and its HMAC-SHA256 signature,
+// zip bundle holds account_data.json and its detached signature account_data.json.sig
+type ExportAccountDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        []byte                 `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAccountDataResponse) Reset() {
+	*x = ExportAccountDataResponse{}
+	mi := &file_auth_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAccountDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountDataResponse) ProtoMessage() {}
+
+func (x *ExportAccountDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportAccountDataResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportAccountDataResponse) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ExportAccountDataResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportAccountDataResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type Account struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username        string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	AccessLevel     int64                  `protobuf:"varint,3,opt,name=access_level,json=accessLevel,proto3" json:"access_level,omitempty"`
+	CreatedAtUnix   int64                  `protobuf:"varint,4,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	BannedUntilUnix int64                  `protobuf:"varint,5,opt,name=banned_until_unix,json=bannedUntilUnix,proto3" json:"banned_until_unix,omitempty"` // 0 = not banned
+	BanReason       string                 `protobuf:"bytes,6,opt,name=ban_reason,json=banReason,proto3" json:"ban_reason,omitempty"`
+	LastLoginAtUnix int64                  `protobuf:"varint,7,opt,name=last_login_at_unix,json=lastLoginAtUnix,proto3" json:"last_login_at_unix,omitempty"` // 0 = never logged in
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_auth_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Account) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Account) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Account) GetAccessLevel() int64 {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return 0
+}
+
+func (x *Account) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *Account) GetBannedUntilUnix() int64 {
+	if x != nil {
+		return x.BannedUntilUnix
+	}
+	return 0
+}
+
+func (x *Account) GetBanReason() string {
+	if x != nil {
+		return x.BanReason
+	}
+	return ""
+}
+
+func (x *Account) GetLastLoginAtUnix() int64 {
+	if x != nil {
+		return x.LastLoginAtUnix
+	}
+	return 0
+}
+
+// lists active accounts, caller must have view all users access level
+type SearchAccountsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Token           string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UsernamePrefix  string                 `protobuf:"bytes,2,opt,name=username_prefix,json=usernamePrefix,proto3" json:"username_prefix,omitempty"` // case-insensitive
+	AccessLevel     *int64                 `protobuf:"varint,3,opt,name=access_level,json=accessLevel,proto3,oneof" json:"access_level,omitempty"`
+	Banned          *bool                  `protobuf:"varint,4,opt,name=banned,proto3,oneof" json:"banned,omitempty"`
+	CreatedFromUnix int64                  `protobuf:"varint,5,opt,name=created_from_unix,json=createdFromUnix,proto3" json:"created_from_unix,omitempty"` // inclusive, 0 = unbounded
+	CreatedToUnix   int64                  `protobuf:"varint,6,opt,name=created_to_unix,json=createdToUnix,proto3" json:"created_to_unix,omitempty"`       // exclusive, 0 = unbounded
+	SortBy          string                 `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                               // "id" (default) / "created_at" / "username"
+	Descending      bool                   `protobuf:"varint,8,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize        int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`    // default 50, max 100
+	PageToken       string                 `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of previous page, same sort is required
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchAccountsRequest) Reset() {
+	*x = SearchAccountsRequest{}
+	mi := &file_auth_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAccountsRequest) ProtoMessage() {}
+
+func (x *SearchAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAccountsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchAccountsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SearchAccountsRequest) GetUsernamePrefix() string {
+	if x != nil {
+		return x.UsernamePrefix
+	}
+	return ""
+}
+
+func (x *SearchAccountsRequest) GetAccessLevel() int64 {
+	if x != nil && x.AccessLevel != nil {
+		return *x.AccessLevel
+	}
+	return 0
+}
+
+func (x *SearchAccountsRequest) GetBanned() bool {
+	if x != nil && x.Banned != nil {
+		return *x.Banned
+	}
+	return false
+}
+
+func (x *SearchAccountsRequest) GetCreatedFromUnix() int64 {
+	if x != nil {
+		return x.CreatedFromUnix
+	}
+	return 0
+}
+
+func (x *SearchAccountsRequest) GetCreatedToUnix() int64 {
+	if x != nil {
+		return x.CreatedToUnix
+	}
+	return 0
+}
+
+func (x *SearchAccountsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *SearchAccountsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *SearchAccountsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchAccountsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAccountsResponse) Reset() {
+	*x = SearchAccountsResponse{}
+	mi := &file_auth_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAccountsResponse) ProtoMessage() {}
+
+func (x *SearchAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAccountsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *SearchAccountsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// account data shared with other services
+type PublicAccount struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username        string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	AccessLevel     int64                  `protobuf:"varint,3,opt,name=access_level,json=accessLevel,proto3" json:"access_level,omitempty"`
+	IsBanned        bool                   `protobuf:"varint,4,opt,name=is_banned,json=isBanned,proto3" json:"is_banned,omitempty"`
+	BannedUntilUnix int64                  `protobuf:"varint,5,opt,name=banned_until_unix,json=bannedUntilUnix,proto3" json:"banned_until_unix,omitempty"` // 0 = not banned
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PublicAccount) Reset() {
+	*x = PublicAccount{}
+	mi := &file_auth_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicAccount) ProtoMessage() {}
+
+func (x *PublicAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicAccount.ProtoReflect.Descriptor instead.
+func (*PublicAccount) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PublicAccount) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PublicAccount) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PublicAccount) GetAccessLevel() int64 {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return 0
+}
+
+func (x *PublicAccount) GetIsBanned() bool {
+	if x != nil {
+		return x.IsBanned
+	}
+	return false
+}
+
+func (x *PublicAccount) GetBannedUntilUnix() int64 {
+	if x != nil {
+		return x.BannedUntilUnix
+	}
+	return 0
+}
+
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       int64                  `protobuf:"varint,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_auth_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAccountRequest) GetSubject() int64 {
+	if x != nil {
+		return x.Subject
+	}
+	return 0
+}
+
+type GetAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subjects      []int64                `protobuf:"varint,1,rep,packed,name=subjects,proto3" json:"subjects,omitempty"` // at most ACCOUNT_LOOKUP_MAX_BATCH_SIZE
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountsRequest) Reset() {
+	*x = GetAccountsRequest{}
+	mi := &file_auth_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsRequest) ProtoMessage() {}
+
+func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAccountsRequest) GetSubjects() []int64 {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+type GetAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*PublicAccount       `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"` // in order of subjects, missing and deleted accounts are omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountsResponse) Reset() {
+	*x = GetAccountsResponse{}
+	mi := &file_auth_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsResponse) ProtoMessage() {}
+
+func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetAccountsResponse) GetAccounts() []*PublicAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// previous username stays reserved for the account, logins with it fail with NOT_FOUND "username was changed"
+type ChangeUsernameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUsernameRequest) Reset() {
+	*x = ChangeUsernameRequest{}
+	mi := &file_auth_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUsernameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUsernameRequest) ProtoMessage() {}
+
+func (x *ChangeUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUsernameRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChangeUsernameRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ChangeUsernameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type AddEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEmailRequest) Reset() {
+	*x = AddEmailRequest{}
+	mi := &file_auth_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEmailRequest) ProtoMessage() {}
+
+func (x *AddEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEmailRequest.ProtoReflect.Descriptor instead.
+func (*AddEmailRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AddEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_auth_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VerifyEmailRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// lists login attempts of account newest first, caller must have admin access level
+type ListLoginHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Subject       int64                  `protobuf:"varint,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 50, max 200
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginHistoryRequest) Reset() {
+	*x = ListLoginHistoryRequest{}
+	mi := &file_auth_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginHistoryRequest) ProtoMessage() {}
+
+func (x *ListLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListLoginHistoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListLoginHistoryRequest) GetSubject() int64 {
+	if x != nil {
+		return x.Subject
+	}
+	return 0
+}
+
+func (x *ListLoginHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLoginHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type LoginAttempt struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ip              string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`                                                    // empty if unknown
+	HardwareIdMatch string                 `protobuf:"bytes,3,opt,name=hardware_id_match,json=hardwareIdMatch,proto3" json:"hardware_id_match,omitempty"` // trusted / pending / conflict / limit_exceeded / not_checked
+	Success         bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	FailureReason   string                 `protobuf:"bytes,5,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"` // empty on success
+	CreatedAtUnix   int64                  `protobuf:"varint,6,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	Country         string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"` // ISO 3166-1 alpha-2, empty if unknown
+	Asn             uint32                 `protobuf:"varint,8,opt,name=asn,proto3" json:"asn,omitempty"`        // 0 if unknown
+	RiskScore       int32                  `protobuf:"varint,9,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	RiskDecision    string                 `protobuf:"bytes,10,opt,name=risk_decision,json=riskDecision,proto3" json:"risk_decision,omitempty"` // allow / step_up / deny, empty if login failed before assessment
+	RiskSignals     []string               `protobuf:"bytes,11,rep,name=risk_signals,json=riskSignals,proto3" json:"risk_signals,omitempty"`    // new_device / new_ip / new_asn / impossible_travel / ip_failures / incomplete
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LoginAttempt) Reset() {
+	*x = LoginAttempt{}
+	mi := &file_auth_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginAttempt) ProtoMessage() {}
+
+func (x *LoginAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginAttempt.ProtoReflect.Descriptor instead.
+func (*LoginAttempt) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LoginAttempt) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LoginAttempt) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LoginAttempt) GetHardwareIdMatch() string {
+	if x != nil {
+		return x.HardwareIdMatch
+	}
+	return ""
+}
+
+func (x *LoginAttempt) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LoginAttempt) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *LoginAttempt) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *LoginAttempt) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *LoginAttempt) GetAsn() uint32 {
+	if x != nil {
+		return x.Asn
+	}
+	return 0
+}
+
+func (x *LoginAttempt) GetRiskScore() int32 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *LoginAttempt) GetRiskDecision() string {
+	if x != nil {
+		return x.RiskDecision
+	}
+	return ""
+}
+
+func (x *LoginAttempt) GetRiskSignals() []string {
+	if x != nil {
+		return x.RiskSignals
+	}
+	return nil
+}
+
+type ListLoginHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attempts      []*LoginAttempt        `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginHistoryResponse) Reset() {
+	*x = ListLoginHistoryResponse{}
+	mi := &file_auth_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginHistoryResponse) ProtoMessage() {}
+
+func (x *ListLoginHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListLoginHistoryResponse) GetAttempts() []*LoginAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *ListLoginHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Device struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsTrusted      bool                   `protobuf:"varint,2,opt,name=is_trusted,json=isTrusted,proto3" json:"is_trusted,omitempty"`
+	CreatedAtUnix  int64                  `protobuf:"varint,3,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	ApprovedAtUnix int64                  `protobuf:"varint,4,opt,name=approved_at_unix,json=approvedAtUnix,proto3" json:"approved_at_unix,omitempty"`   // 0 = pending
+	LastSeenAtUnix int64                  `protobuf:"varint,5,opt,name=last_seen_at_unix,json=lastSeenAtUnix,proto3" json:"last_seen_at_unix,omitempty"` // 0 = never
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_auth_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Device) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Device) GetIsTrusted() bool {
+	if x != nil {
+		return x.IsTrusted
+	}
+	return false
+}
+
+func (x *Device) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *Device) GetApprovedAtUnix() int64 {
+	if x != nil {
+		return x.ApprovedAtUnix
+	}
+	return 0
+}
+
+func (x *Device) GetLastSeenAtUnix() int64 {
+	if x != nil {
+		return x.LastSeenAtUnix
+	}
+	return 0
+}
+
+type ListDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	mi := &file_auth_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesRequest) ProtoMessage() {}
+
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListDevicesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*Device              `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	mi := &file_auth_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListDevicesResponse) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type ApproveDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HardwareId    string                 `protobuf:"bytes,2,opt,name=hardware_id,json=hardwareId,proto3" json:"hardware_id,omitempty"` // hardware id of the trusted device confirming approval
+	DeviceId      int64                  `protobuf:"varint,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveDeviceRequest) Reset() {
+	*x = ApproveDeviceRequest{}
+	mi := &file_auth_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDeviceRequest) ProtoMessage() {}
+
+func (x *ApproveDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveDeviceRequest.ProtoReflect.Descriptor instead.
+func (*ApproveDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ApproveDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ApproveDeviceRequest) GetHardwareId() string {
+	if x != nil {
+		return x.HardwareId
+	}
+	return ""
+}
+
+func (x *ApproveDeviceRequest) GetDeviceId() int64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+type RemoveDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	DeviceId      int64                  `protobuf:"varint,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDeviceRequest) Reset() {
+	*x = RemoveDeviceRequest{}
+	mi := &file_auth_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDeviceRequest) ProtoMessage() {}
+
+func (x *RemoveDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RemoveDeviceRequest) GetDeviceId() int64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+var File_auth_auth_proto protoreflect.FileDescriptor
+
+const file_auth_auth_proto_rawDesc = "" +
+	"\n" +
+	"\x0fauth/auth.proto\x12\x04auth\x1a\x1cgoogle/api/annotations.proto\"\a\n" +
+	"\x05Empty\"p\n" +
+	"\x15AuthenticationRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vhardware_id\x18\x03 \x01(\tR\n" +
+	"hardwareId\"\x96\x01\n" +
+	"\rTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\faccess_level\x18\x02 \x01(\x03R\vaccessLevel\x12\x1b\n" +
+	"\tis_banned\x18\x03 \x01(\bR\bisBanned\x12/\n" +
+	"\x14banned_until_in_unix\x18\x04 \x01(\x03R\x11bannedUntilInUnix\"*\n" +
+	"\x12VerifyTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"R\n" +
+	"\x13VerifyTokenResponse\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\x03R\asubject\x12!\n" +
+	"\faccess_level\x18\x02 \x01(\x03R\vaccessLevel\"k\n" +
+	"\x11BanAccountRequest\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\x03R\asubject\x12$\n" +
+	"\x0eban_until_unix\x18\x02 \x01(\x03R\fbanUntilUnix\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"H\n" +
+	"\x14DeleteAccountRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"O\n" +
+	"\x15RestoreAccountRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"b\n" +
+	"\x18ExportAccountDataRequest\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\x03R\asubject\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"s\n" +
+	"\x19ExportAccountDataResponse\x12\x16\n" +
+	"\x06bundle\x18\x01 \x01(\fR\x06bundle\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\"\xf8\x01\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
+	"\faccess_level\x18\x03 \x01(\x03R\vaccessLevel\x12&\n" +
+	"\x0fcreated_at_unix\x18\x04 \x01(\x03R\rcreatedAtUnix\x12*\n" +
+	"\x11banned_until_unix\x18\x05 \x01(\x03R\x0fbannedUntilUnix\x12\x1d\n" +
+	"\n" +
+	"ban_reason\x18\x06 \x01(\tR\tbanReason\x12+\n" +
+	"\x12last_login_at_unix\x18\a \x01(\x03R\x0flastLoginAtUnix\"\x80\x03\n" +
+	"\x15SearchAccountsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12'\n" +
+	"\x0fusername_prefix\x18\x02 \x01(\tR\x0eusernamePrefix\x12&\n" +
+	"\faccess_level\x18\x03 \x01(\x03H\x00R\vaccessLevel\x88\x01\x01\x12\x1b\n" +
+	"\x06banned\x18\x04 \x01(\bH\x01R\x06banned\x88\x01\x01\x12*\n" +
+	"\x11created_from_unix\x18\x05 \x01(\x03R\x0fcreatedFromUnix\x12&\n" +
+	"\x0fcreated_to_unix\x18\x06 \x01(\x03R\rcreatedToUnix\x12\x17\n" +
+	"\asort_by\x18\a \x01(\tR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\b \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageTokenB\x0f\n" +
+	"\r_access_levelB\t\n" +
+	"\a_banned\"k\n" +
+	"\x16SearchAccountsResponse\x12)\n" +
+	"\baccounts\x18\x01 \x03(\v2\r.auth.AccountR\baccounts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa7\x01\n" +
+	"\rPublicAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
+	"\faccess_level\x18\x03 \x01(\x03R\vaccessLevel\x12\x1b\n" +
+	"\tis_banned\x18\x04 \x01(\bR\bisBanned\x12*\n" +
+	"\x11banned_until_unix\x18\x05 \x01(\x03R\x0fbannedUntilUnix\"-\n" +
+	"\x11GetAccountRequest\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\x03R\asubject\"0\n" +
+	"\x12GetAccountsRequest\x12\x1a\n" +
+	"\bsubjects\x18\x01 \x03(\x03R\bsubjects\"F\n" +
+	"\x13GetAccountsResponse\x12/\n" +
+	"\baccounts\x18\x01 \x03(\v2\x13.auth.PublicAccountR\baccounts\"I\n" +
+	"\x15ChangeUsernameRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"=\n" +
+	"\x0fAddEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\">\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x85\x01\n" +
+	"\x17ListLoginHistoryRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\x03R\asubject\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xd6\x02\n" +
+	"\fLoginAttempt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\x12*\n" +
+	"\x11hardware_id_match\x18\x03 \x01(\tR\x0fhardwareIdMatch\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12%\n" +
+	"\x0efailure_reason\x18\x05 \x01(\tR\rfailureReason\x12&\n" +
+	"\x0fcreated_at_unix\x18\x06 \x01(\x03R\rcreatedAtUnix\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12\x10\n" +
+	"\x03asn\x18\b \x01(\rR\x03asn\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\t \x01(\x05R\triskScore\x12#\n" +
+	"\rrisk_decision\x18\n" +
+	" \x01(\tR\friskDecision\x12!\n" +
+	"\frisk_signals\x18\v \x03(\tR\vriskSignals\"r\n" +
+	"\x18ListLoginHistoryResponse\x12.\n" +
+	"\battempts\x18\x01 \x03(\v2\x12.auth.LoginAttemptR\battempts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb4\x01\n" +
+	"\x06Device\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"is_trusted\x18\x02 \x01(\bR\tisTrusted\x12&\n" +
+	"\x0fcreated_at_unix\x18\x03 \x01(\x03R\rcreatedAtUnix\x12(\n" +
+	"\x10approved_at_unix\x18\x04 \x01(\x03R\x0eapprovedAtUnix\x12)\n" +
+	"\x11last_seen_at_unix\x18\x05 \x01(\x03R\x0elastSeenAtUnix\"*\n" +
+	"\x12ListDevicesRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"=\n" +
+	"\x13ListDevicesResponse\x12&\n" +
+	"\adevices\x18\x01 \x03(\v2\f.auth.DeviceR\adevices\"j\n" +
+	"\x14ApproveDeviceRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1f\n" +
+	"\vhardware_id\x18\x02 \x01(\tR\n" +
+	"hardwareId\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\x03R\bdeviceId\"H\n" +
+	"\x13RemoveDeviceRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\x03R\bdeviceId2\xbb\f\n" +
+	"\vAuthService\x12R\n" +
+	"\bRegister\x12\x1b.auth.AuthenticationRequest\x1a\v.auth.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12T\n" +
+	"\x05Login\x12\x1b.auth.AuthenticationRequest\x1a\x13.auth.TokenResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12^\n" +
+	"\vVerifyToken\x12\x18.auth.VerifyTokenRequest\x1a\x19.auth.VerifyTokenResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/verify\x124\n" +
+	"\n" +
+	"BanAccount\x12\x17.auth.BanAccountRequest\x1a\v.auth.Empty\"\x00\x12W\n" +
+	"\rDeleteAccount\x12\x1a.auth.DeleteAccountRequest\x1a\v.auth.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/account:delete\x12Z\n" +
+	"\x0eRestoreAccount\x12\x1b.auth.RestoreAccountRequest\x1a\v.auth.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/account:restore\x12~\n" +
+	"\x11ExportAccountData\x12\x1e.auth.ExportAccountDataRequest\x1a\x1f.auth.ExportAccountDataResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/accounts/{subject}:export\x12k\n" +
+	"\x0eSearchAccounts\x12\x1b.auth.SearchAccountsRequest\x1a\x1c.auth.SearchAccountsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/accounts:search\x12<\n" +
 	"\n" +
-	"BanAccount\x12\x17.auth.BanAccountRequest\x1a\v.auth.EmptyB7Z5github.com/intezya/auth-service/protos/go/auth;authpbb\x06proto3"
+	"GetAccount\x12\x17.auth.GetAccountRequest\x1a\x13.auth.PublicAccount\"\x00\x12D\n" +
+	"\vGetAccounts\x12\x18.auth.GetAccountsRequest\x1a\x19.auth.GetAccountsResponse\"\x00\x12[\n" +
+	"\x0eChangeUsername\x12\x1b.auth.ChangeUsernameRequest\x1a\v.auth.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/account/username\x12L\n" +
+	"\bAddEmail\x12\x15.auth.AddEmailRequest\x1a\v.auth.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/account/email\x12Y\n" +
+	"\vVerifyEmail\x12\x18.auth.VerifyEmailRequest\x1a\v.auth.Empty\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/account/email:verify\x12\x82\x01\n" +
+	"\x10ListLoginHistory\x12\x1d.auth.ListLoginHistoryRequest\x1a\x1e.auth.ListLoginHistoryResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/accounts/{subject}/login-history\x12b\n" +
+	"\vListDevices\x12\x18.auth.ListDevicesRequest\x1a\x19.auth.ListDevicesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/account/devices\x12l\n" +
+	"\rApproveDevice\x12\x1a.auth.ApproveDeviceRequest\x1a\v.auth.Empty\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/account/devices/{device_id}:approve\x12i\n" +
+	"\fRemoveDevice\x12\x19.auth.RemoveDeviceRequest\x1a\v.auth.Empty\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/account/devices/{device_id}:removeB7Z5github.com/intezya/auth-service/protos/go/auth;authpbb\x06proto3"
 
 var (
 	file_auth_auth_proto_rawDescOnce sync.Once
@@ -385,29 +1882,81 @@ func file_auth_auth_proto_rawDescGZIP() []byte {
 	return file_auth_auth_proto_rawDescData
 }
 
-var file_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_auth_auth_proto_goTypes = []any{
-	(*Empty)(nil),                 // 0: auth.Empty
-	(*AuthenticationRequest)(nil), // 1: auth.AuthenticationRequest
-	(*TokenResponse)(nil),         // 2: auth.TokenResponse
-	(*VerifyTokenRequest)(nil),    // 3: auth.VerifyTokenRequest
-	(*VerifyTokenResponse)(nil),   // 4: auth.VerifyTokenResponse
-	(*BanAccountRequest)(nil),     // 5: auth.BanAccountRequest
+	(*Empty)(nil),                     // 0: auth.Empty
+	(*AuthenticationRequest)(nil),     // 1: auth.AuthenticationRequest
+	(*TokenResponse)(nil),             // 2: auth.TokenResponse
+	(*VerifyTokenRequest)(nil),        // 3: auth.VerifyTokenRequest
+	(*VerifyTokenResponse)(nil),       // 4: auth.VerifyTokenResponse
+	(*BanAccountRequest)(nil),         // 5: auth.BanAccountRequest
+	(*DeleteAccountRequest)(nil),      // 6: auth.DeleteAccountRequest
+	(*RestoreAccountRequest)(nil),     // 7: auth.RestoreAccountRequest
+	(*ExportAccountDataRequest)(nil),  // 8: auth.ExportAccountDataRequest
+	(*ExportAccountDataResponse)(nil), // 9: auth.ExportAccountDataResponse
+	(*Account)(nil),                   // 10: auth.Account
+	(*SearchAccountsRequest)(nil),     // 11: auth.SearchAccountsRequest
+	(*SearchAccountsResponse)(nil),    // 12: auth.SearchAccountsResponse
+	(*PublicAccount)(nil),             // 13: auth.PublicAccount
+	(*GetAccountRequest)(nil),         // 14: auth.GetAccountRequest
+	(*GetAccountsRequest)(nil),        // 15: auth.GetAccountsRequest
+	(*GetAccountsResponse)(nil),       // 16: auth.GetAccountsResponse
+	(*ChangeUsernameRequest)(nil),     // 17: auth.ChangeUsernameRequest
+	(*AddEmailRequest)(nil),           // 18: auth.AddEmailRequest
+	(*VerifyEmailRequest)(nil),        // 19: auth.VerifyEmailRequest
+	(*ListLoginHistoryRequest)(nil),   // 20: auth.ListLoginHistoryRequest
+	(*LoginAttempt)(nil),              // 21: auth.LoginAttempt
+	(*ListLoginHistoryResponse)(nil),  // 22: auth.ListLoginHistoryResponse
+	(*Device)(nil),                    // 23: auth.Device
+	(*ListDevicesRequest)(nil),        // 24: auth.ListDevicesRequest
+	(*ListDevicesResponse)(nil),       // 25: auth.ListDevicesResponse
+	(*ApproveDeviceRequest)(nil),      // 26: auth.ApproveDeviceRequest
+	(*RemoveDeviceRequest)(nil),       // 27: auth.RemoveDeviceRequest
 }
 var file_auth_auth_proto_depIdxs = []int32{
-	1, // 0: auth.AuthService.Register:input_type -> auth.AuthenticationRequest
-	1, // 1: auth.AuthService.Login:input_type -> auth.AuthenticationRequest
-	3, // 2: auth.AuthService.VerifyToken:input_type -> auth.VerifyTokenRequest
-	5, // 3: auth.AuthService.BanAccount:input_type -> auth.BanAccountRequest
-	0, // 4: auth.AuthService.Register:output_type -> auth.Empty
-	2, // 5: auth.AuthService.Login:output_type -> auth.TokenResponse
-	4, // 6: auth.AuthService.VerifyToken:output_type -> auth.VerifyTokenResponse
-	0, // 7: auth.AuthService.BanAccount:output_type -> auth.Empty
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	10, // 0: auth.SearchAccountsResponse.accounts:type_name -> auth.Account
+	13, // 1: auth.GetAccountsResponse.accounts:type_name -> auth.PublicAccount
+	21, // 2: auth.ListLoginHistoryResponse.attempts:type_name -> auth.LoginAttempt
+	23, // 3: auth.ListDevicesResponse.devices:type_name -> auth.Device
+	1,  // 4: auth.AuthService.Register:input_type -> auth.AuthenticationRequest
+	1,  // 5: auth.AuthService.Login:input_type -> auth.AuthenticationRequest
+	3,  // 6: auth.AuthService.VerifyToken:input_type -> auth.VerifyTokenRequest
+	5,  // 7: auth.AuthService.BanAccount:input_type -> auth.BanAccountRequest
+	6,  // 8: auth.AuthService.DeleteAccount:input_type -> auth.DeleteAccountRequest
+	7,  // 9: auth.AuthService.RestoreAccount:input_type -> auth.RestoreAccountRequest
+	8,  // 10: auth.AuthService.ExportAccountData:input_type -> auth.ExportAccountDataRequest
+	11, // 11: auth.AuthService.SearchAccounts:input_type -> auth.SearchAccountsRequest
+	14, // 12: auth.AuthService.GetAccount:input_type -> auth.GetAccountRequest
+	15, // 13: auth.AuthService.GetAccounts:input_type -> auth.GetAccountsRequest
+	17, // 14: auth.AuthService.ChangeUsername:input_type -> auth.ChangeUsernameRequest
+	18, // 15: auth.AuthService.AddEmail:input_type -> auth.AddEmailRequest
+	19, // 16: auth.AuthService.VerifyEmail:input_type -> auth.VerifyEmailRequest
+	20, // 17: auth.AuthService.ListLoginHistory:input_type -> auth.ListLoginHistoryRequest
+	24, // 18: auth.AuthService.ListDevices:input_type -> auth.ListDevicesRequest
+	26, // 19: auth.AuthService.ApproveDevice:input_type -> auth.ApproveDeviceRequest
+	27, // 20: auth.AuthService.RemoveDevice:input_type -> auth.RemoveDeviceRequest
+	0,  // 21: auth.AuthService.Register:output_type -> auth.Empty
+	2,  // 22: auth.AuthService.Login:output_type -> auth.TokenResponse
+	4,  // 23: auth.AuthService.VerifyToken:output_type -> auth.VerifyTokenResponse
+	0,  // 24: auth.AuthService.BanAccount:output_type -> auth.Empty
+	0,  // 25: auth.AuthService.DeleteAccount:output_type -> auth.Empty
+	0,  // 26: auth.AuthService.RestoreAccount:output_type -> auth.Empty
+	9,  // 27: auth.AuthService.ExportAccountData:output_type -> auth.ExportAccountDataResponse
+	12, // 28: auth.AuthService.SearchAccounts:output_type -> auth.SearchAccountsResponse
+	13, // 29: auth.AuthService.GetAccount:output_type -> auth.PublicAccount
+	16, // 30: auth.AuthService.GetAccounts:output_type -> auth.GetAccountsResponse
+	0,  // 31: auth.AuthService.ChangeUsername:output_type -> auth.Empty
+	0,  // 32: auth.AuthService.AddEmail:output_type -> auth.Empty
+	0,  // 33: auth.AuthService.VerifyEmail:output_type -> auth.Empty
+	22, // 34: auth.AuthService.ListLoginHistory:output_type -> auth.ListLoginHistoryResponse
+	25, // 35: auth.AuthService.ListDevices:output_type -> auth.ListDevicesResponse
+	0,  // 36: auth.AuthService.ApproveDevice:output_type -> auth.Empty
+	0,  // 37: auth.AuthService.RemoveDevice:output_type -> auth.Empty
+	21, // [21:38] is the sub-list for method output_type
+	4,  // [4:21] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_auth_auth_proto_init() }
@@ -415,13 +1964,14 @@ func file_auth_auth_proto_init() {
 	if File_auth_auth_proto != nil {
 		return
 	}
+	file_auth_auth_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_auth_proto_rawDesc), len(file_auth_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
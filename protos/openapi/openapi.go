@@ -0,0 +1,11 @@
+// Package openapi publishes specs of HTTP gateway generated from auth.proto.
+package openapi
+
+import _ "embed"
+
+var (
+	//go:embed auth/auth.swagger.json
+	V2 []byte
+	//go:embed openapi.yaml
+	V3 []byte
+)